@@ -26,7 +26,25 @@ type Config struct {
 	AppPort string `mapstructure:"APP_PORT"`
 
 	// middlewares
-	SecretJWT string `mapstructure:"SECRET_JWT"`
+	SecretJWT          string `mapstructure:"SECRET_JWT"`
+	AccessTokenMinutes int    `mapstructure:"ACCESS_TOKEN_MINUTES"`
+	RefreshTokenDays   int    `mapstructure:"REFRESH_TOKEN_DAYS"`
+
+	// password reset
+	PasswordResetMinutes int    `mapstructure:"PASSWORD_RESET_MINUTES"`
+	PasswordResetKey     string `mapstructure:"PASSWORD_RESET_KEY"`
+
+	// scheduler
+	TransferMaxRetry      int `mapstructure:"TRANSFER_MAX_RETRY"`
+	TransferRetryInterval int `mapstructure:"TRANSFER_RETRY_INTERVAL"`
+	ReminderDaysBefore    int `mapstructure:"REMINDER_DAYS_BEFORE"`
+	InquiryTTLMinutes     int `mapstructure:"INQUIRY_TTL_MINUTES"`
+
+	// voucher
+	VoucherCodeKey string `mapstructure:"VOUCHER_CODE_KEY"`
+
+	// catalog sync
+	PriceListUrl string `mapstructure:"PRICE_LIST_URL"`
 
 	// oy
 	BaseUrl  string `mapstructure:"BASEURL"`
@@ -0,0 +1,271 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/autopay"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type AutoPayController interface {
+	CreateAutoPayController(c echo.Context) error
+	GetAutoPaysController(c echo.Context) error
+	GetAutoPayByIdController(c echo.Context) error
+	UpdateAutoPayController(c echo.Context) error
+	PauseAutoPayController(c echo.Context) error
+	ResumeAutoPayController(c echo.Context) error
+	CancelAutoPayController(c echo.Context) error
+	ApproveAutoPayController(c echo.Context) error
+}
+
+type autoPayController struct {
+	autoPayUseCase autopay.AutoPayUseCase
+}
+
+func NewAutoPayController(autoPayUseCase autopay.AutoPayUseCase) *autoPayController {
+	return &autoPayController{
+		autoPayUseCase: autoPayUseCase,
+	}
+}
+
+func (ctrl *autoPayController) CreateAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.AutoPayDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.CreateAutoPayUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "success create auto-pay subscription",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *autoPayController) GetAutoPaysController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	status := c.QueryParam("status")
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	result, err := ctrl.autoPayUseCase.GetAutoPaysByUserIdUseCase(userId, status, page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Auto-Pay Subscriptions",
+		},
+		Data: result,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *autoPayController) GetAutoPayByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.GetAutoPayByIdUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Auto-Pay Subscription",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *autoPayController) UpdateAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.AutoPayDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.UpdateAutoPayUseCase(userId, c.Param("id"), payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Auto-pay subscription updated successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *autoPayController) PauseAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.PauseAutoPayUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Auto-pay subscription paused successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *autoPayController) ResumeAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.ResumeAutoPayUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Auto-pay subscription resumed successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *autoPayController) CancelAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	err := ctrl.autoPayUseCase.CancelAutoPayUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Auto-pay subscription cancelled successfully",
+		},
+	})
+}
+
+func (ctrl *autoPayController) ApproveAutoPayController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.autoPayUseCase.ApproveAutoPayUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Auto-pay bill paid successfully",
+		},
+		Data: result,
+	})
+}
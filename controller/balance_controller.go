@@ -45,6 +45,9 @@ func (ctrl *balanceController) GenerateVaController(c echo.Context) error {
 
 	response, err := ctrl.BalanceUsecase.GenerateVaUseCase(userId, payload)
 	if err != nil {
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
 			StatusCode: http.StatusUnauthorized,
 			Message:    err.Error(),
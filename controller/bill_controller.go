@@ -0,0 +1,150 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/middlewares"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type BillController interface {
+	GetBillProductsController(c echo.Context) error
+	BillInquiryController(c echo.Context) error
+	PayBillController(c echo.Context) error
+	CancelInquiryController(c echo.Context) error
+}
+
+type billController struct {
+	billerUseCase biller.BillerUseCase
+}
+
+func NewBillController(billerUseCase biller.BillerUseCase) *billController {
+	return &billController{
+		billerUseCase: billerUseCase,
+	}
+}
+
+func (ctrl *billController) GetBillProductsController(c echo.Context) error {
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Bill Products",
+		},
+		Data: ctrl.billerUseCase.GetProductStatusesUseCase(),
+	})
+}
+
+func (ctrl *billController) BillInquiryController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload model.OyBillerApi
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.billerUseCase.BillInquiryUseCase(c.Param("code"), userId, &payload)
+	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Succesfully Get Bill",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *billController) PayBillController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload model.OyBillerApi
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.billerUseCase.PayBillUseCase(c.Param("code"), userId, &payload)
+	if err != nil {
+		if errors.Is(err, biller.ErrInquiryExpired) {
+			return c.JSON(http.StatusGone, model.ErrorResponse{
+				StatusCode: http.StatusGone,
+				Message:    err.Error(),
+			})
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusAccepted, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusAccepted,
+			Message:    "Succesfully pay bill",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *billController) CancelInquiryController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.billerUseCase.CancelInquiryUseCase(userId, c.Param("id"))
+	if err != nil {
+		if errors.Is(err, biller.ErrInquiryExpired) {
+			return c.JSON(http.StatusGone, model.ErrorResponse{
+				StatusCode: http.StatusGone,
+				Message:    err.Error(),
+			})
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Succesfully cancel bill inquiry",
+		},
+		Data: response,
+	})
+}
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	insurance "BE-Golang/usecase/Insurance"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type KetenagakerjaanController interface {
+	CreateKetenagakerjaanController(c echo.Context) error
+	GetAllKetenagakerjaanController(c echo.Context) error
+	GetKetenagakerjaanByIdController(c echo.Context) error
+	UpdateKetenagakerjaanController(c echo.Context) error
+	DeleteKetenagakerjaanByIdController(c echo.Context) error
+}
+
+type ketenagakerjaanController struct {
+	ketenagakerjaanUseCase insurance.KetenagakerjaanUseCase
+}
+
+func NewKetenagakerjaanController(ketenagakerjaanUseCase insurance.KetenagakerjaanUseCase) *ketenagakerjaanController {
+	return &ketenagakerjaanController{
+		ketenagakerjaanUseCase: ketenagakerjaanUseCase,
+	}
+}
+
+func (ctrl *ketenagakerjaanController) CreateKetenagakerjaanController(c echo.Context) error {
+	var payload model.BpjsKetenagakerjaan
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ketenagakerjaanUseCase.CreateKetenagakerjaanUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create bpjs ketenagakerjaan",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ketenagakerjaanController) GetAllKetenagakerjaanController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.ketenagakerjaanUseCase.GetAllKetenagakerjaanUseCase(page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get bpjs ketenagakerjaan",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *ketenagakerjaanController) GetKetenagakerjaanByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.ketenagakerjaanUseCase.GetKetenagakerjaanByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get bpjs ketenagakerjaan",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ketenagakerjaanController) UpdateKetenagakerjaanController(c echo.Context) error {
+	var payload model.BpjsKetenagakerjaan
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ketenagakerjaanUseCase.UpdateKetenagakerjaanByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update bpjs ketenagakerjaan",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ketenagakerjaanController) DeleteKetenagakerjaanByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.ketenagakerjaanUseCase.DeleteKetenagakerjaanByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete bpjs ketenagakerjaan",
+		},
+	})
+}
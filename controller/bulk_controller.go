@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/bulk"
+	"BE-Golang/usecase/middlewares"
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+var bulkContentTypes = map[string]string{
+	model.BULK_FORMAT_CSV:  "text/csv",
+	model.BULK_FORMAT_XLSX: "application/vnd.openxmlformats-officedocument.spreadsheetml.sheet",
+}
+
+type BulkController interface {
+	ExportCatalogController(c echo.Context) error
+	ImportCatalogController(c echo.Context) error
+	AdjustPriceController(c echo.Context) error
+}
+
+type bulkController struct {
+	bulkUseCase bulk.BulkUseCase
+}
+
+func NewBulkController(bulkUseCase bulk.BulkUseCase) *bulkController {
+	return &bulkController{
+		bulkUseCase: bulkUseCase,
+	}
+}
+
+func (ctrl *bulkController) ExportCatalogController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	format := c.QueryParam("format")
+	if format == "" {
+		format = model.BULK_FORMAT_CSV
+	}
+
+	var sheet bytes.Buffer
+	if err := ctrl.bulkUseCase.ExportCatalogUseCase(c.Param("catalog"), format, &sheet); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s.%s", c.Param("catalog"), format))
+	return c.Blob(http.StatusOK, bulkContentTypes[format], sheet.Bytes())
+}
+
+func (ctrl *bulkController) ImportCatalogController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "sheet file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	defer file.Close()
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+	response, err := ctrl.bulkUseCase.ImportCatalogUseCase(c.Param("catalog"), fileHeader.Filename, file, dryRun)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	if len(response.Errors) > 0 {
+		return c.JSON(http.StatusUnprocessableEntity, model.HttpResponse{
+			MetaData: model.MetaData{
+				StatusCode: http.StatusUnprocessableEntity,
+				Message:    "sheet has invalid rows, nothing was imported",
+			},
+			Data: response,
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully import catalog",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *bulkController) AdjustPriceController(c echo.Context) error {
+	var payload dto.BulkPriceAdjustmentDto
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.bulkUseCase.AdjustPriceUseCase(c.Param("catalog"), payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully adjust prices",
+		},
+		Data: response,
+	})
+}
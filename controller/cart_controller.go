@@ -0,0 +1,148 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/cart"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CartController interface {
+	GetCartController(c echo.Context) error
+	AddCartItemController(c echo.Context) error
+	RemoveCartItemController(c echo.Context) error
+	CheckoutCartController(c echo.Context) error
+}
+
+type cartController struct {
+	cartUseCase cart.CartUseCase
+}
+
+func NewCartController(cartUseCase cart.CartUseCase) *cartController {
+	return &cartController{
+		cartUseCase: cartUseCase,
+	}
+}
+
+func (ctrl *cartController) GetCartController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.cartUseCase.GetCartUseCase(userId)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Cart",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *cartController) AddCartItemController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.CartItemDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.cartUseCase.AddCartItemUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "success add item to cart",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *cartController) RemoveCartItemController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.cartUseCase.RemoveCartItemUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success remove item from cart",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *cartController) CheckoutCartController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.CartCheckoutDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.cartUseCase.CheckoutCartUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Cart checkout finished",
+		},
+		Data: result,
+	})
+}
@@ -0,0 +1,201 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/catalog"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CatalogSyncController interface {
+	SyncCatalogController(c echo.Context) error
+	ImportPriceListController(c echo.Context) error
+	GetAllPriceReviewController(c echo.Context) error
+	ApprovePriceReviewController(c echo.Context) error
+	RejectPriceReviewController(c echo.Context) error
+}
+
+type catalogSyncController struct {
+	catalogSyncUseCase catalog.CatalogSyncUseCase
+}
+
+func NewCatalogSyncController(catalogSyncUseCase catalog.CatalogSyncUseCase) *catalogSyncController {
+	return &catalogSyncController{
+		catalogSyncUseCase: catalogSyncUseCase,
+	}
+}
+
+func (ctrl *catalogSyncController) SyncCatalogController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+	response, err := ctrl.catalogSyncUseCase.SyncCatalogUseCase(c.Param("catalog"), dryRun)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully sync catalog",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *catalogSyncController) ImportPriceListController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	fileHeader, err := c.FormFile("file")
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    "price list file is required",
+		})
+	}
+
+	file, err := fileHeader.Open()
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	defer file.Close()
+
+	dryRun, _ := strconv.ParseBool(c.QueryParam("dry_run"))
+	response, err := ctrl.catalogSyncUseCase.ImportPriceListUseCase(c.Param("catalog"), fileHeader.Filename, file, dryRun)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully import price list",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *catalogSyncController) GetAllPriceReviewController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.catalogSyncUseCase.GetAllPriceReviewUseCase(c.QueryParam("catalog"), c.QueryParam("status"), page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get price reviews",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *catalogSyncController) ApprovePriceReviewController(c echo.Context) error {
+	var payload dto.PriceReviewApprovalDto
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.catalogSyncUseCase.ApprovePriceReviewUseCase(c.Param("id"), payload.SellPrice)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully approve price review",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *catalogSyncController) RejectPriceReviewController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.catalogSyncUseCase.RejectPriceReviewUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully reject price review",
+		},
+		Data: response,
+	})
+}
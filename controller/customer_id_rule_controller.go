@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type CustomerIdRuleController interface {
+	CreateCustomerIdRuleController(c echo.Context) error
+	GetAllCustomerIdRuleController(c echo.Context) error
+	GetCustomerIdRuleByIdController(c echo.Context) error
+	UpdateCustomerIdRuleController(c echo.Context) error
+	DeleteCustomerIdRuleByIdController(c echo.Context) error
+}
+
+type customerIdRuleController struct {
+	customerIdRuleUseCase biller.CustomerIdRuleUseCase
+}
+
+func NewCustomerIdRuleController(customerIdRuleUseCase biller.CustomerIdRuleUseCase) *customerIdRuleController {
+	return &customerIdRuleController{
+		customerIdRuleUseCase: customerIdRuleUseCase,
+	}
+}
+
+func (ctrl *customerIdRuleController) CreateCustomerIdRuleController(c echo.Context) error {
+	var payload model.CustomerIdRule
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create customer ID rule",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *customerIdRuleController) GetAllCustomerIdRuleController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.customerIdRuleUseCase.GetAllCustomerIdRuleUseCase(c.QueryParam("product"), page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get customer ID rules",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *customerIdRuleController) GetCustomerIdRuleByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.customerIdRuleUseCase.GetCustomerIdRuleByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get customer ID rule",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *customerIdRuleController) UpdateCustomerIdRuleController(c echo.Context) error {
+	var payload model.CustomerIdRule
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.customerIdRuleUseCase.UpdateCustomerIdRuleByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update customer ID rule",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *customerIdRuleController) DeleteCustomerIdRuleByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.customerIdRuleUseCase.DeleteCustomerIdRuleByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete customer ID rule",
+		},
+	})
+}
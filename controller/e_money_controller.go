@@ -0,0 +1,312 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/emoney"
+	"BE-Golang/usecase/middlewares"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type EMoneyController interface {
+	CreateEMoneyController(c echo.Context) error
+	GetEMoneyByAdminController(c echo.Context) error
+	GetEMoneyByUserController(c echo.Context) error
+	GetEMoneyByIdController(c echo.Context) error
+	UpdateEMoneyByIdController(c echo.Context) error
+	DeleteEMoneyByIdController(c echo.Context) error
+	AccountInquiryController(c echo.Context) error
+	CreateTransactionEMoneyController(c echo.Context) error
+	GetTransactionEMoneyController(c echo.Context) error
+}
+
+type eMoneyController struct {
+	eMoneyUseCase emoney.EMoneyUseCase
+}
+
+func NewEMoneyController(eMoneyUseCase emoney.EMoneyUseCase) *eMoneyController {
+	return &eMoneyController{
+		eMoneyUseCase: eMoneyUseCase,
+	}
+}
+
+func (ctrl *eMoneyController) CreateEMoneyController(c echo.Context) error {
+	var payload model.EMoney
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.CreateEMoneyUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "e-money created successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *eMoneyController) GetEMoneyByAdminController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return ctrl.getAllEMoney(c, nil)
+}
+
+func (ctrl *eMoneyController) GetEMoneyByUserController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	isUser := true
+	return ctrl.getAllEMoney(c, &isUser)
+}
+
+func (ctrl *eMoneyController) getAllEMoney(c echo.Context, isUser *bool) error {
+	var payload dto.EMoneyDto
+	payload.Type = c.QueryParam("type")
+	payload.Provider = c.QueryParam("provider")
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	payload.Page = page
+	payload.Limit = limit
+
+	response, err := ctrl.eMoneyUseCase.GetAllEMoneyUseCase(payload, isUser)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get all e-money",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Limit: limit,
+			Page:  page,
+		},
+	})
+}
+
+func (ctrl *eMoneyController) GetEMoneyByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.GetEMoneyByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get e-money by id",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *eMoneyController) UpdateEMoneyByIdController(c echo.Context) error {
+	var payload model.EMoney
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.UpdateEMoneyByIdUseCase(c.Param("id"), payload)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "e-money updated successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *eMoneyController) DeleteEMoneyByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := ctrl.eMoneyUseCase.DeleteEMoneyByIdUseCase(c.Param("id")); err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "e-money deleted successfully",
+		},
+	})
+}
+
+func (ctrl *eMoneyController) AccountInquiryController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.EMoneyInquiryDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.AccountInquiryUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get e-money account",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *eMoneyController) CreateTransactionEMoneyController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.TransactionEMoneyDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.CreateTransactionEMoneyUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusAccepted, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusAccepted,
+			Message:    "e-money top up is being processed",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *eMoneyController) GetTransactionEMoneyController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.eMoneyUseCase.GetTransactionEMoneyUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get e-money transaction",
+		},
+		Data: response,
+	})
+}
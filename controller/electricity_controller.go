@@ -19,6 +19,10 @@ type ElectricityController interface {
 	BillInquiryElectricityController(c echo.Context) error
 	PayBillInquiryElectricityController(c echo.Context) error
 	BuyBillInquiryElectricityController(c echo.Context) error
+	PayBillPrePaidElectricityController(c echo.Context) error
+	GetTokenDenominationsController(c echo.Context) error
+	PrepaidMeterInquiryController(c echo.Context) error
+	ResendTokenElectricityController(c echo.Context) error
 }
 
 type electricityController struct {
@@ -207,6 +211,12 @@ func (ctrl *electricityController) BillInquiryPostPaidElectricityController(c ec
 
 	response, err := ctrl.electricityUseCase.PostBillInquiryElectricityUseCase(userId, &payload)
 	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, model.HttpResponse{
@@ -264,6 +274,12 @@ func (ctrl *electricityController) BillInquiryPrePaidElectricityController(c ech
 
 	response, err := ctrl.electricityUseCase.PreBillInquiryElectricityUseCase(userId, &payload)
 	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, model.HttpResponse{
@@ -274,3 +290,98 @@ func (ctrl *electricityController) BillInquiryPrePaidElectricityController(c ech
 		Data: response,
 	})
 }
+
+func (ctrl *electricityController) PayBillPrePaidElectricityController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload model.OyBillerApi
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+
+	response, err := ctrl.electricityUseCase.PrePayBillElectricityUseCase(userId, &payload)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusAccepted, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusAccepted,
+			Message:    "Succesfully buy token",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *electricityController) GetTokenDenominationsController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get token denominations",
+		},
+		Data: ctrl.electricityUseCase.GetTokenDenominationsUseCase(),
+	})
+}
+
+func (ctrl *electricityController) PrepaidMeterInquiryController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.electricityUseCase.PrepaidMeterInquiryUseCase(c.Param("meter_number"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get meter",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *electricityController) ResendTokenElectricityController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	err := ctrl.electricityUseCase.ResendTokenElectricityUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully resend token",
+		},
+	})
+}
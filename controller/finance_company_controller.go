@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/multifinance"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type FinanceCompanyController interface {
+	CreateFinanceCompanyController(c echo.Context) error
+	GetAllFinanceCompanyController(c echo.Context) error
+	GetFinanceCompanyByIdController(c echo.Context) error
+	UpdateFinanceCompanyController(c echo.Context) error
+	DeleteFinanceCompanyByIdController(c echo.Context) error
+}
+
+type financeCompanyController struct {
+	financeCompanyUseCase multifinance.FinanceCompanyUseCase
+}
+
+func NewFinanceCompanyController(financeCompanyUseCase multifinance.FinanceCompanyUseCase) *financeCompanyController {
+	return &financeCompanyController{
+		financeCompanyUseCase: financeCompanyUseCase,
+	}
+}
+
+func (ctrl *financeCompanyController) CreateFinanceCompanyController(c echo.Context) error {
+	var payload model.FinanceCompany
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.financeCompanyUseCase.CreateFinanceCompanyUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create finance company",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *financeCompanyController) GetAllFinanceCompanyController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.financeCompanyUseCase.GetAllFinanceCompanyUseCase(page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get finance companies",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *financeCompanyController) GetFinanceCompanyByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.financeCompanyUseCase.GetFinanceCompanyByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get finance company",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *financeCompanyController) UpdateFinanceCompanyController(c echo.Context) error {
+	var payload model.FinanceCompany
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.financeCompanyUseCase.UpdateFinanceCompanyByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update finance company",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *financeCompanyController) DeleteFinanceCompanyByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.financeCompanyUseCase.DeleteFinanceCompanyByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete finance company",
+		},
+	})
+}
@@ -207,6 +207,12 @@ func (ctrl *insuranceController) BillInquiryInsuranceController(c echo.Context)
 	response, err := ctrl.insuranceUseCase.BillInquiryInsuranceUseCase(userId, &payload)
 
 	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, model.HttpResponse{
@@ -0,0 +1,318 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/wifi"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type IspController interface {
+	CreateIspController(c echo.Context) error
+	GetAllIspByAdminController(c echo.Context) error
+	GetAllIspController(c echo.Context) error
+	GetIspByIdAdminController(c echo.Context) error
+	GetIspByIdController(c echo.Context) error
+	UpdateIspController(c echo.Context) error
+	DeleteIspByIdController(c echo.Context) error
+	CreateIspPlanController(c echo.Context) error
+	UpdateIspPlanController(c echo.Context) error
+	DeleteIspPlanByIdController(c echo.Context) error
+	GetWifiSubscriptionController(c echo.Context) error
+}
+
+type ispController struct {
+	ispUseCase wifi.IspUseCase
+}
+
+func NewIspController(ispUseCase wifi.IspUseCase) *ispController {
+	return &ispController{
+		ispUseCase: ispUseCase,
+	}
+}
+
+func (ctrl *ispController) CreateIspController(c echo.Context) error {
+	var payload model.Isp
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ispUseCase.CreateIspUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create ISP",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ispController) GetAllIspByAdminController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return ctrl.getAllIsp(c, nil)
+}
+
+func (ctrl *ispController) GetAllIspController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	isUser := true
+	return ctrl.getAllIsp(c, &isUser)
+}
+
+func (ctrl *ispController) getAllIsp(c echo.Context, isUser *bool) error {
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.ispUseCase.GetAllIspUseCase(page, limit, isUser)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get ISPs",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *ispController) GetIspByIdAdminController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return ctrl.getIspById(c, nil)
+}
+
+func (ctrl *ispController) GetIspByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	isUser := true
+	return ctrl.getIspById(c, &isUser)
+}
+
+func (ctrl *ispController) getIspById(c echo.Context, isUser *bool) error {
+	response, err := ctrl.ispUseCase.GetIspByIdUseCase(c.Param("id"), isUser)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get ISP",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ispController) UpdateIspController(c echo.Context) error {
+	var payload model.Isp
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ispUseCase.UpdateIspByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update ISP",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ispController) DeleteIspByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.ispUseCase.DeleteIspByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete ISP",
+		},
+	})
+}
+
+func (ctrl *ispController) CreateIspPlanController(c echo.Context) error {
+	var payload model.IspPlan
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ispUseCase.CreateIspPlanUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create ISP plan",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ispController) UpdateIspPlanController(c echo.Context) error {
+	var payload model.IspPlan
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.ispUseCase.UpdateIspPlanByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update ISP plan",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *ispController) DeleteIspPlanByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.ispUseCase.DeleteIspPlanByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete ISP plan",
+		},
+	})
+}
+
+func (ctrl *ispController) GetWifiSubscriptionController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.ispUseCase.GetWifiSubscriptionUseCase(c.QueryParam("isp"), c.QueryParam("customer_id"))
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get subscription",
+		},
+		Data: response,
+	})
+}
@@ -0,0 +1,154 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/notification"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type NotificationController interface {
+	GetNotificationsController(c echo.Context) error
+	ReadNotificationController(c echo.Context) error
+	GetNotificationPreferenceController(c echo.Context) error
+	UpdateNotificationPreferenceController(c echo.Context) error
+}
+
+type notificationController struct {
+	notificationUseCase notification.NotificationUseCase
+}
+
+func NewNotificationController(notificationUseCase notification.NotificationUseCase) *notificationController {
+	return &notificationController{
+		notificationUseCase: notificationUseCase,
+	}
+}
+
+func (ctrl *notificationController) GetNotificationsController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	result, err := ctrl.notificationUseCase.GetNotificationsByUserIdUseCase(userId, page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Notifications",
+		},
+		Data: result,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *notificationController) ReadNotificationController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	err := ctrl.notificationUseCase.ReadNotificationUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Notification marked as read",
+		},
+	})
+}
+
+func (ctrl *notificationController) GetNotificationPreferenceController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.notificationUseCase.GetNotificationPreferenceUseCase(userId)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Notification Preference",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *notificationController) UpdateNotificationPreferenceController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.NotificationPreferenceDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.notificationUseCase.UpdateNotificationPreferenceUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Notification preference updated successfully",
+		},
+		Data: result,
+	})
+}
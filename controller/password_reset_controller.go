@@ -0,0 +1,75 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/auth"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PasswordResetController interface {
+	ForgotPasswordController(c echo.Context) error
+	ResetPasswordController(c echo.Context) error
+}
+
+type passwordResetController struct {
+	passwordResetUseCase auth.PasswordResetUseCase
+}
+
+func NewPasswordResetController(passwordResetUseCase auth.PasswordResetUseCase) *passwordResetController {
+	return &passwordResetController{
+		passwordResetUseCase: passwordResetUseCase,
+	}
+}
+
+func (ctrl *passwordResetController) ForgotPasswordController(c echo.Context) error {
+	var payload dto.ForgotPasswordDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	if err := ctrl.passwordResetUseCase.RequestPasswordResetUseCase(payload.Email); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "If the email is registered, a reset code has been sent to it",
+		},
+	})
+}
+
+func (ctrl *passwordResetController) ResetPasswordController(c echo.Context) error {
+	var payload dto.ResetPasswordDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.passwordResetUseCase.ResetPasswordUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Password updated successfully",
+		},
+		Data: response,
+	})
+}
@@ -206,6 +206,12 @@ func (ctrl *pdamController) BillInquiryPdamController(c echo.Context) error {
 
 	response, err := ctrl.pdamUseCase.BillInquiryPdamUseCase(userId, &payload)
 	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, model.HttpResponse{
@@ -0,0 +1,172 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/pdam"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type PdamRegionController interface {
+	CreatePdamRegionController(c echo.Context) error
+	GetAllPdamRegionController(c echo.Context) error
+	GetPdamRegionByIdController(c echo.Context) error
+	UpdatePdamRegionController(c echo.Context) error
+	DeletePdamRegionByIdController(c echo.Context) error
+}
+
+type pdamRegionController struct {
+	pdamRegionUseCase pdam.PdamRegionUseCase
+}
+
+func NewPdamRegionController(pdamRegionUseCase pdam.PdamRegionUseCase) *pdamRegionController {
+	return &pdamRegionController{
+		pdamRegionUseCase: pdamRegionUseCase,
+	}
+}
+
+func (ctrl *pdamRegionController) CreatePdamRegionController(c echo.Context) error {
+	var payload model.PdamRegion
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.pdamRegionUseCase.CreatePdamRegionUseCase(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Create PDAM region",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *pdamRegionController) GetAllPdamRegionController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	response, err := ctrl.pdamRegionUseCase.GetAllPdamRegionUseCase(c.QueryParam("name"), page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get PDAM regions",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *pdamRegionController) GetPdamRegionByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.pdamRegionUseCase.GetPdamRegionByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully get PDAM region",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *pdamRegionController) UpdatePdamRegionController(c echo.Context) error {
+	var payload model.PdamRegion
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := c.Bind(&payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, map[string]string{"error": err.Error()})
+	}
+	response, err := ctrl.pdamRegionUseCase.UpdatePdamRegionByIdUseCase(c.Param("id"), &payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Update PDAM region",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *pdamRegionController) DeletePdamRegionByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+	err := ctrl.pdamRegionUseCase.DeletePdamRegionByIdUseCase(c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Delete PDAM region",
+		},
+	})
+}
@@ -19,6 +19,7 @@ type PulsaPaketDataController interface {
 	UpdatePPDById(c echo.Context) error
 	DeletePPDById(c echo.Context) error
 	CreateTransactionPPDUser(c echo.Context) error
+	GetTransactionPPDUser(c echo.Context) error
 }
 
 type pulsaPaketDataController struct {
@@ -149,6 +150,9 @@ func (ctrl *pulsaPaketDataController) GetPPDByUser(c echo.Context) error {
 	ppd, err := ctrl.PPDUsecase.GetAllPulsaPaketData(payload, &isUser)
 
 	if err != nil {
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusNotFound, model.ErrorResponse{
 			StatusCode: http.StatusNotFound,
 			Message:    err.Error(),
@@ -289,6 +293,9 @@ func (ctrl *pulsaPaketDataController) CreateTransactionPPDUser(c echo.Context) e
 	result, err := ctrl.PPDUsecase.CreateTransactionPPD(user, payload)
 
 	if err != nil {
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusNotFound, model.ErrorResponse{
 			StatusCode: http.StatusNotFound,
 			Message:    err.Error(),
@@ -298,7 +305,34 @@ func (ctrl *pulsaPaketDataController) CreateTransactionPPDUser(c echo.Context) e
 	return c.JSON(http.StatusCreated, model.HttpResponse{
 		MetaData: model.MetaData{
 			StatusCode: http.StatusCreated,
-			Message:    "success Transfer Amount",
+			Message:    "pulsa or paket data purchase is being processed",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *pulsaPaketDataController) GetTransactionPPDUser(c echo.Context) error {
+	user := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if user == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.PPDUsecase.GetTransactionPPD(user, c.Param("id"))
+
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get pulsa or paket data transaction",
 		},
 		Data: result,
 	})
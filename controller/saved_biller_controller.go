@@ -0,0 +1,217 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/savedbiller"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SavedBillerController interface {
+	CreateSavedBillerController(c echo.Context) error
+	GetSavedBillersController(c echo.Context) error
+	GetSavedBillerByIdController(c echo.Context) error
+	UpdateSavedBillerController(c echo.Context) error
+	DeleteSavedBillerController(c echo.Context) error
+	GetDueBillsController(c echo.Context) error
+}
+
+type savedBillerController struct {
+	savedBillerUseCase savedbiller.SavedBillerUseCase
+}
+
+func NewSavedBillerController(savedBillerUseCase savedbiller.SavedBillerUseCase) *savedBillerController {
+	return &savedBillerController{
+		savedBillerUseCase: savedBillerUseCase,
+	}
+}
+
+func (ctrl *savedBillerController) CreateSavedBillerController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.SavedBillerDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.savedBillerUseCase.CreateSavedBillerUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "success create saved biller",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *savedBillerController) GetSavedBillersController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	category := c.QueryParam("category")
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	result, err := ctrl.savedBillerUseCase.GetSavedBillersByUserIdUseCase(userId, category, page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Saved Billers",
+		},
+		Data: result,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *savedBillerController) GetSavedBillerByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.savedBillerUseCase.GetSavedBillerByIdUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Saved Biller",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *savedBillerController) UpdateSavedBillerController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.SavedBillerDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.savedBillerUseCase.UpdateSavedBillerUseCase(userId, c.Param("id"), payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Saved biller updated successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *savedBillerController) DeleteSavedBillerController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	err := ctrl.savedBillerUseCase.DeleteSavedBillerUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Saved biller deleted successfully",
+		},
+	})
+}
+
+func (ctrl *savedBillerController) GetDueBillsController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.savedBillerUseCase.GetDueBillsUseCase(userId)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Due Bills",
+		},
+		Data: result,
+	})
+}
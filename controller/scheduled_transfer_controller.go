@@ -0,0 +1,209 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	transfer "BE-Golang/usecase/scheduled_transfer"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type ScheduledTransferController interface {
+	CreateScheduledTransferController(c echo.Context) error
+	GetScheduledTransfersController(c echo.Context) error
+	GetScheduledTransferByIdController(c echo.Context) error
+	PauseScheduledTransferController(c echo.Context) error
+	ResumeScheduledTransferController(c echo.Context) error
+	CancelScheduledTransferController(c echo.Context) error
+}
+
+type scheduledTransferController struct {
+	scheduledTransferUseCase transfer.ScheduledTransferUseCase
+}
+
+func NewScheduledTransferController(scheduledTransferUseCase transfer.ScheduledTransferUseCase) *scheduledTransferController {
+	return &scheduledTransferController{
+		scheduledTransferUseCase: scheduledTransferUseCase,
+	}
+}
+
+func (ctrl *scheduledTransferController) CreateScheduledTransferController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.ScheduledTransferDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	result, err := ctrl.scheduledTransferUseCase.CreateScheduledTransferUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "success create scheduled transfer",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *scheduledTransferController) GetScheduledTransfersController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	status := c.QueryParam("status")
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	result, err := ctrl.scheduledTransferUseCase.GetScheduledTransfersByUserIdUseCase(userId, status, page, limit)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Scheduled Transfers",
+		},
+		Data: result,
+		Pagination: &model.Pagination{
+			Page:  page,
+			Limit: limit,
+		},
+	})
+}
+
+func (ctrl *scheduledTransferController) GetScheduledTransferByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.scheduledTransferUseCase.GetScheduledTransferByIdUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully Get Scheduled Transfer",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *scheduledTransferController) PauseScheduledTransferController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.scheduledTransferUseCase.PauseScheduledTransferUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Scheduled transfer paused successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *scheduledTransferController) ResumeScheduledTransferController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	result, err := ctrl.scheduledTransferUseCase.ResumeScheduledTransferUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Scheduled transfer resumed successfully",
+		},
+		Data: result,
+	})
+}
+
+func (ctrl *scheduledTransferController) CancelScheduledTransferController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	err := ctrl.scheduledTransferUseCase.CancelScheduledTransferUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Scheduled transfer cancelled successfully",
+		},
+	})
+}
@@ -0,0 +1,108 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/auth"
+	"BE-Golang/usecase/middlewares"
+	"errors"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+)
+
+type SessionController interface {
+	RefreshTokenController(c echo.Context) error
+	LogoutController(c echo.Context) error
+	LogoutAllController(c echo.Context) error
+}
+
+type sessionController struct {
+	sessionUseCase auth.SessionUseCase
+}
+
+func NewSessionController(sessionUseCase auth.SessionUseCase) *sessionController {
+	return &sessionController{
+		sessionUseCase: sessionUseCase,
+	}
+}
+
+func (ctrl *sessionController) RefreshTokenController(c echo.Context) error {
+	var payload dto.RefreshTokenDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.sessionUseCase.RefreshSessionUseCase(payload.RefreshToken)
+	if err != nil {
+		if errors.Is(err, auth.ErrInvalidRefreshToken) {
+			return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+				StatusCode: http.StatusUnauthorized,
+				Message:    err.Error(),
+			})
+		}
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully refresh token",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *sessionController) LogoutController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := ctrl.sessionUseCase.LogoutUseCase(userId, middlewares.ExtractTokenSessionId(c)); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully logout",
+		},
+	})
+}
+
+func (ctrl *sessionController) LogoutAllController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ALL_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := ctrl.sessionUseCase.LogoutAllUseCase(userId); err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "Successfully logout from all devices",
+		},
+	})
+}
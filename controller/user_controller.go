@@ -25,9 +25,6 @@ type UserController interface {
 	UpdatePINController(c echo.Context) error
 	CheckPINController(c echo.Context) error
 	TransferAmountController(c echo.Context) error
-	GetUserByEmailController(c echo.Context) error
-	CheckPinForgotPasswordController(c echo.Context) error
-	UpdateForgotPasswordController(c echo.Context) error
 	GetUsersByQueryController(c echo.Context) error
 }
 
@@ -270,7 +267,7 @@ func (ctrl *userController) UpdatePasswordController(c echo.Context) error {
 		})
 	}
 
-	err := ctrl.UserUsecase.ChangePasswordUseCase(userId, payload)
+	response, err := ctrl.UserUsecase.ChangePasswordUseCase(userId, payload)
 	if err != nil {
 		return c.JSON(http.StatusNotFound, model.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -283,6 +280,7 @@ func (ctrl *userController) UpdatePasswordController(c echo.Context) error {
 			StatusCode: http.StatusOK,
 			Message:    "Password updated successfully",
 		},
+		Data: response,
 	})
 }
 
@@ -339,7 +337,7 @@ func (ctrl *userController) UpdatePINController(c echo.Context) error {
 		})
 	}
 
-	err := ctrl.UserUsecase.ChangePINUseCase(userId, payload)
+	response, err := ctrl.UserUsecase.ChangePINUseCase(userId, payload)
 	if err != nil {
 		return c.JSON(http.StatusNotFound, model.ErrorResponse{
 			StatusCode: http.StatusNotFound,
@@ -352,6 +350,7 @@ func (ctrl *userController) UpdatePINController(c echo.Context) error {
 			StatusCode: http.StatusOK,
 			Message:    "PIN updated successfully",
 		},
+		Data: response,
 	})
 }
 
@@ -423,85 +422,6 @@ func (ctrl *userController) TransferAmountController(c echo.Context) error {
 	})
 }
 
-func (ctrl *userController) GetUserByEmailController(c echo.Context) error {
-	email := c.QueryParam("email")
-	if email == "" {
-		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
-			StatusCode: http.StatusBadRequest,
-			Message:    "email empty",
-		})
-	}
-
-	result, err := ctrl.UserUsecase.GetUserByEmailUsecase(email)
-	if err != nil {
-		return c.JSON(http.StatusNotFound, model.ErrorResponse{
-			StatusCode: http.StatusNotFound,
-			Message:    err.Error(),
-		})
-	}
-
-	return c.JSON(http.StatusOK, model.HttpResponse{
-		MetaData: model.MetaData{
-			StatusCode: http.StatusOK,
-			Message:    "Successfully Get User by email",
-		},
-		Data: result,
-	})
-}
-func (ctrl *userController) CheckPinForgotPasswordController(c echo.Context) error {
-	var payload dto.PIN
-	if err := c.Bind(&payload); err != nil {
-		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
-			StatusCode: http.StatusBadRequest,
-			Message:    err.Error(),
-		})
-	}
-	userID := c.Param("id")
-
-	err := ctrl.UserUsecase.CheckPINUseCase(userID, payload)
-	if err != nil {
-		return c.JSON(http.StatusNotFound, model.ErrorResponse{
-			StatusCode: http.StatusNotFound,
-			Message:    err.Error(),
-		})
-	}
-
-	return c.JSON(http.StatusOK, model.HttpResponse{
-		MetaData: model.MetaData{
-			StatusCode: http.StatusOK,
-			Message:    "Correct",
-		},
-	})
-}
-func (ctrl *userController) UpdateForgotPasswordController(c echo.Context) error {
-	userID := c.Param("id")
-
-	var payload dto.Password
-
-	if err := c.Bind(&payload); err != nil {
-		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
-			StatusCode: http.StatusBadRequest,
-			Message:    err.Error(),
-		})
-	}
-
-	user, err := ctrl.UserUsecase.UpdateForgotPasswordUsecase(userID, payload)
-	if err != nil {
-		return c.JSON(http.StatusNotFound, model.ErrorResponse{
-			StatusCode: http.StatusNotFound,
-			Message:    err.Error(),
-		})
-	}
-
-	return c.JSON(http.StatusOK, model.HttpResponse{
-		MetaData: model.MetaData{
-			StatusCode: http.StatusOK,
-			Message:    "Password updated successfully",
-		},
-		Data: user,
-	})
-}
-
 func (ctrl *userController) GetUsersByQueryController(c echo.Context) error {
 
 	user := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
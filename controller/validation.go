@@ -0,0 +1,44 @@
+package controller
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"net/http"
+)
+
+// validationErrors returns the field errors of a request that failed
+// validation, or nil for any other error.
+func validationErrors(err error) *model.ValidationError {
+	var validation *model.ValidationError
+	if errors.As(err, &validation) {
+		return validation
+	}
+
+	return nil
+}
+
+func validationErrorResponse(validation *model.ValidationError) model.ValidationErrorResponse {
+	return model.ValidationErrorResponse{
+		StatusCode: http.StatusUnprocessableEntity,
+		Message:    validation.Error(),
+		Errors:     validation.Errors,
+	}
+}
+
+// unavailableError returns the error of a product that cannot be sold right
+// now, or nil for any other error.
+func unavailableError(err error) *model.UnavailableError {
+	var unavailable *model.UnavailableError
+	if errors.As(err, &unavailable) {
+		return unavailable
+	}
+
+	return nil
+}
+
+func unavailableErrorResponse(unavailable *model.UnavailableError) model.ErrorResponse {
+	return model.ErrorResponse{
+		StatusCode: http.StatusServiceUnavailable,
+		Message:    unavailable.Error(),
+	}
+}
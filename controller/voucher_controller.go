@@ -0,0 +1,427 @@
+package controller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/voucher"
+	"net/http"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+)
+
+type VoucherController interface {
+	CreateVoucherBrandController(c echo.Context) error
+	GetVoucherBrandByAdminController(c echo.Context) error
+	GetVoucherBrandByUserController(c echo.Context) error
+	GetVoucherBrandByIdAdminController(c echo.Context) error
+	GetVoucherBrandByIdUserController(c echo.Context) error
+	UpdateVoucherBrandByIdController(c echo.Context) error
+	DeleteVoucherBrandByIdController(c echo.Context) error
+	CreateVoucherController(c echo.Context) error
+	UpdateVoucherByIdController(c echo.Context) error
+	DeleteVoucherByIdController(c echo.Context) error
+	NicknameInquiryController(c echo.Context) error
+	CreateTransactionVoucherController(c echo.Context) error
+	GetTransactionVoucherController(c echo.Context) error
+}
+
+type voucherController struct {
+	voucherUseCase voucher.VoucherUseCase
+}
+
+func NewVoucherController(voucherUseCase voucher.VoucherUseCase) *voucherController {
+	return &voucherController{
+		voucherUseCase: voucherUseCase,
+	}
+}
+
+func (ctrl *voucherController) CreateVoucherBrandController(c echo.Context) error {
+	var payload model.VoucherBrand
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.CreateVoucherBrandUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "voucher brand created successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) GetVoucherBrandByAdminController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return ctrl.getAllVoucherBrand(c, nil)
+}
+
+func (ctrl *voucherController) GetVoucherBrandByUserController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	isUser := true
+	return ctrl.getAllVoucherBrand(c, &isUser)
+}
+
+func (ctrl *voucherController) getAllVoucherBrand(c echo.Context, isUser *bool) error {
+	var payload dto.VoucherBrandDto
+	payload.Type = c.QueryParam("type")
+	payload.Name = c.QueryParam("name")
+
+	page, err := strconv.Atoi(c.QueryParam("page"))
+	if err != nil {
+		page = 1
+	}
+
+	limit, err := strconv.Atoi(c.QueryParam("limit"))
+	if err != nil {
+		limit = 10
+	}
+
+	payload.Page = page
+	payload.Limit = limit
+
+	response, err := ctrl.voucherUseCase.GetAllVoucherBrandUseCase(payload, isUser)
+	if err != nil {
+		return c.JSON(http.StatusInternalServerError, model.ErrorResponse{
+			StatusCode: http.StatusInternalServerError,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get all voucher brands",
+		},
+		Data: response,
+		Pagination: &model.Pagination{
+			Limit: limit,
+			Page:  page,
+		},
+	})
+}
+
+func (ctrl *voucherController) GetVoucherBrandByIdAdminController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	return ctrl.getVoucherBrandById(c, nil)
+}
+
+func (ctrl *voucherController) GetVoucherBrandByIdUserController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	isUser := true
+	return ctrl.getVoucherBrandById(c, &isUser)
+}
+
+func (ctrl *voucherController) getVoucherBrandById(c echo.Context, isUser *bool) error {
+	response, err := ctrl.voucherUseCase.GetVoucherBrandByIdUseCase(c.Param("id"), isUser)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get voucher brand by id",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) UpdateVoucherBrandByIdController(c echo.Context) error {
+	var payload model.VoucherBrand
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.UpdateVoucherBrandByIdUseCase(c.Param("id"), payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "voucher brand updated successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) DeleteVoucherBrandByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := ctrl.voucherUseCase.DeleteVoucherBrandByIdUseCase(c.Param("id")); err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "voucher brand deleted successfully",
+		},
+	})
+}
+
+func (ctrl *voucherController) CreateVoucherController(c echo.Context) error {
+	var payload model.Voucher
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.CreateVoucherUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "voucher created successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) UpdateVoucherByIdController(c echo.Context) error {
+	var payload model.Voucher
+
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.UpdateVoucherByIdUseCase(c.Param("id"), payload)
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "voucher updated successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) DeleteVoucherByIdController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.ADMIN_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	if err := ctrl.voucherUseCase.DeleteVoucherByIdUseCase(c.Param("id")); err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "voucher deleted successfully",
+		},
+	})
+}
+
+func (ctrl *voucherController) NicknameInquiryController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.VoucherInquiryDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.NicknameInquiryUseCase(payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get player nickname",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) CreateTransactionVoucherController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	var payload dto.TransactionVoucherDto
+	if err := c.Bind(&payload); err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.CreateTransactionVoucherUseCase(userId, payload)
+	if err != nil {
+		return c.JSON(http.StatusBadRequest, model.ErrorResponse{
+			StatusCode: http.StatusBadRequest,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusCreated, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusCreated,
+			Message:    "voucher purchased successfully",
+		},
+		Data: response,
+	})
+}
+
+func (ctrl *voucherController) GetTransactionVoucherController(c echo.Context) error {
+	userId := middlewares.ExtractTokenUserId(model.USER_TYPE, c)
+	if userId == "" {
+		return c.JSON(http.StatusUnauthorized, model.ErrorResponse{
+			StatusCode: http.StatusUnauthorized,
+			Message:    "token unauthorized",
+		})
+	}
+
+	response, err := ctrl.voucherUseCase.GetTransactionVoucherUseCase(userId, c.Param("id"))
+	if err != nil {
+		return c.JSON(http.StatusNotFound, model.ErrorResponse{
+			StatusCode: http.StatusNotFound,
+			Message:    err.Error(),
+		})
+	}
+
+	return c.JSON(http.StatusOK, model.HttpResponse{
+		MetaData: model.MetaData{
+			StatusCode: http.StatusOK,
+			Message:    "success get voucher transaction",
+		},
+		Data: response,
+	})
+}
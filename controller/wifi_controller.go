@@ -207,6 +207,12 @@ func (ctrl *wifiController) BillInquiryWifiController(c echo.Context) error {
 
 	response, err := ctrl.WifiUsecase.BillInquiryWifiUseCase(userId, &payload)
 	if err != nil {
+		if validation := validationErrors(err); validation != nil {
+			return c.JSON(http.StatusUnprocessableEntity, validationErrorResponse(validation))
+		}
+		if unavailable := unavailableError(err); unavailable != nil {
+			return c.JSON(http.StatusServiceUnavailable, unavailableErrorResponse(unavailable))
+		}
 		return c.JSON(http.StatusInternalServerError, map[string]string{"error": err.Error()})
 	}
 	return c.JSON(http.StatusOK, model.HttpResponse{
@@ -13,17 +13,57 @@ func Migrate(db *gorm.DB) {
 		&model.VaNumber{},
 		&model.Bank{},
 		&model.Insurance{},
+		&model.BpjsKetenagakerjaan{},
+		&model.TaxRegion{},
+		&model.CustomerIdRule{},
+		&model.PhonePrefix{},
+		&model.CatalogPriceReview{},
+		&model.ProductAvailability{},
+		&model.Session{},
+		&model.RevokedToken{},
+		&model.PasswordReset{},
+		&model.FinanceCompany{},
 		&model.Electricity{},
+		&model.PlnTariff{},
+		&model.PdamRegion{},
+		&model.PdamTariff{},
 		&model.Pdam{},
 		&model.Discount{},
 		&model.Transaction{},
 		&model.PulsaPaketData{},
+		&model.EMoney{},
+		&model.VoucherBrand{},
+		&model.Voucher{},
 		&model.Wifi{},
+		&model.Isp{},
+		&model.IspPlan{},
+		&model.Notification{},
+		&model.ScheduledTransfer{},
+		&model.AutoPaySubscription{},
+		&model.SavedBiller{},
+		&model.NotificationPreference{},
+		&model.BillReminder{},
+		&model.Cart{},
+		&model.CartItem{},
 	)
 
 	if err != nil {
 		panic(err)
 	}
+
+	renameSmartfren(db)
+}
+
+// renameSmartfren fixes the provider of pulsa and data products saved while
+// providers were detected as "Smatfren", so they match the Smartfren phone
+// prefixes again. It does nothing once they are renamed.
+func renameSmartfren(db *gorm.DB) {
+	err := db.Model(&model.PulsaPaketData{}).
+		Where("provider = ?", "Smatfren").
+		Update("provider", "Smartfren").Error
+	if err != nil {
+		panic(err)
+	}
 }
 
 func Drop(db *gorm.DB) {
@@ -33,12 +73,38 @@ func Drop(db *gorm.DB) {
 		&model.VaNumber{},
 		&model.Bank{},
 		&model.Insurance{},
+		&model.BpjsKetenagakerjaan{},
+		&model.TaxRegion{},
+		&model.CustomerIdRule{},
+		&model.PhonePrefix{},
+		&model.CatalogPriceReview{},
+		&model.ProductAvailability{},
+		&model.Session{},
+		&model.RevokedToken{},
+		&model.PasswordReset{},
+		&model.FinanceCompany{},
 		&model.Electricity{},
+		&model.PlnTariff{},
+		&model.PdamRegion{},
+		&model.PdamTariff{},
 		&model.Pdam{},
 		&model.Discount{},
 		&model.Transaction{},
 		&model.PulsaPaketData{},
+		&model.EMoney{},
+		&model.VoucherBrand{},
+		&model.Voucher{},
 		&model.Wifi{},
+		&model.Isp{},
+		&model.IspPlan{},
+		&model.Notification{},
+		&model.ScheduledTransfer{},
+		&model.AutoPaySubscription{},
+		&model.SavedBiller{},
+		&model.NotificationPreference{},
+		&model.BillReminder{},
+		&model.Cart{},
+		&model.CartItem{},
 	)
 	if err != nil {
 		panic(err)
@@ -0,0 +1,186 @@
+package database
+
+import (
+	"BE-Golang/model"
+
+	"gorm.io/gorm"
+)
+
+// plnTariffs is the PLN tariff adjustment table admins start from.
+var plnTariffs = []model.PlnTariff{
+	{Class: "R1", MinPower: 450, MaxPower: 450, RatePerKwh: 415, Description: "Rumah tangga subsidi 450 VA"},
+	{Class: "R1", MinPower: 900, MaxPower: 900, RatePerKwh: 605, Description: "Rumah tangga subsidi 900 VA"},
+	{Class: "R1M", MinPower: 900, MaxPower: 900, RatePerKwh: 1352, Description: "Rumah tangga mampu 900 VA"},
+	{Class: "R1", MinPower: 1300, MaxPower: 2200, RatePerKwh: 1444.70, Description: "Rumah tangga 1.300 - 2.200 VA"},
+	{Class: "R2", MinPower: 3500, MaxPower: 5500, RatePerKwh: 1699.53, Description: "Rumah tangga 3.500 - 5.500 VA"},
+	{Class: "R3", MinPower: 6600, MaxPower: 0, RatePerKwh: 1699.53, Description: "Rumah tangga 6.600 VA ke atas"},
+	{Class: "B1", MinPower: 450, MaxPower: 5500, RatePerKwh: 1100, Description: "Bisnis kecil 450 - 5.500 VA"},
+	{Class: "B2", MinPower: 6600, MaxPower: 200000, RatePerKwh: 1444.70, Description: "Bisnis menengah 6.600 VA - 200 kVA"},
+	{Class: "B3", MinPower: 200001, MaxPower: 0, RatePerKwh: 1114.74, Description: "Bisnis besar di atas 200 kVA"},
+	{Class: "I1", MinPower: 450, MaxPower: 14000, RatePerKwh: 1100, Description: "Industri kecil 450 VA - 14 kVA"},
+	{Class: "I2", MinPower: 14001, MaxPower: 200000, RatePerKwh: 1444.70, Description: "Industri menengah 14 - 200 kVA"},
+	{Class: "I3", MinPower: 200001, MaxPower: 0, RatePerKwh: 1114.74, Description: "Industri di atas 200 kVA"},
+}
+
+// pdamRegions are the PDAMs available out of the box, each with the block
+// tariffs of its residential (R1, R2) and commercial (N) customers.
+var pdamRegions = []model.PdamRegion{
+	{
+		Code:              "pdam_jakarta",
+		Name:              "PAM Jaya DKI Jakarta",
+		BillerCode:        "PAMJAYA",
+		CustomerIdPattern: `^[0-9]{8}$`,
+		FixedFee:          7450,
+		Tariffs: []model.PdamTariff{
+			{CustomerClass: "R1", UpTo: 10, RatePerM3: 1050},
+			{CustomerClass: "R1", UpTo: 20, RatePerM3: 1050},
+			{CustomerClass: "R1", UpTo: 0, RatePerM3: 1575},
+			{CustomerClass: "R2", UpTo: 10, RatePerM3: 3550},
+			{CustomerClass: "R2", UpTo: 20, RatePerM3: 4700},
+			{CustomerClass: "R2", UpTo: 0, RatePerM3: 5500},
+			{CustomerClass: "N", UpTo: 10, RatePerM3: 6825},
+			{CustomerClass: "N", UpTo: 20, RatePerM3: 8150},
+			{CustomerClass: "N", UpTo: 0, RatePerM3: 9800},
+		},
+	},
+	{
+		Code:              "pdam_bandung",
+		Name:              "PDAM Tirtawening Kota Bandung",
+		BillerCode:        "PDAMBDG",
+		CustomerIdPattern: `^[0-9]{10}$`,
+		FixedFee:          10000,
+		Tariffs: []model.PdamTariff{
+			{CustomerClass: "R1", UpTo: 10, RatePerM3: 1300},
+			{CustomerClass: "R1", UpTo: 0, RatePerM3: 2700},
+			{CustomerClass: "R2", UpTo: 10, RatePerM3: 3600},
+			{CustomerClass: "R2", UpTo: 20, RatePerM3: 4300},
+			{CustomerClass: "R2", UpTo: 0, RatePerM3: 5600},
+			{CustomerClass: "N", UpTo: 10, RatePerM3: 6700},
+			{CustomerClass: "N", UpTo: 0, RatePerM3: 8800},
+		},
+	},
+	{
+		Code:              "pdam_surabaya",
+		Name:              "PDAM Surya Sembada Kota Surabaya",
+		BillerCode:        "PDAMSBY",
+		CustomerIdPattern: `^[0-9]{7,9}$`,
+		FixedFee:          5000,
+		Tariffs: []model.PdamTariff{
+			{CustomerClass: "R1", UpTo: 20, RatePerM3: 600},
+			{CustomerClass: "R1", UpTo: 0, RatePerM3: 1200},
+			{CustomerClass: "R2", UpTo: 10, RatePerM3: 2600},
+			{CustomerClass: "R2", UpTo: 20, RatePerM3: 3300},
+			{CustomerClass: "R2", UpTo: 0, RatePerM3: 4400},
+			{CustomerClass: "N", UpTo: 0, RatePerM3: 6500},
+		},
+	},
+}
+
+// isps are the home internet providers available out of the box.
+var isps = []model.Isp{
+	{
+		Code:              "indihome",
+		Name:              "IndiHome",
+		CustomerIdPattern: `^[0-9]{12}$`,
+		Plans: []model.IspPlan{
+			{Code: "indihome_30", Name: "IndiHome 30 Mbps", Bandwidth: 30, MonthlyPrice: 315000},
+			{Code: "indihome_50", Name: "IndiHome 50 Mbps", Bandwidth: 50, MonthlyPrice: 390000},
+			{Code: "indihome_100", Name: "IndiHome 100 Mbps", Bandwidth: 100, MonthlyPrice: 565000},
+		},
+	},
+	{
+		Code:              "biznet",
+		Name:              "Biznet Home",
+		CustomerIdPattern: `^[0-9]{10}$`,
+		Plans: []model.IspPlan{
+			{Code: "biznet_50", Name: "Biznet Home 50 Mbps", Bandwidth: 50, MonthlyPrice: 250000},
+			{Code: "biznet_100", Name: "Biznet Home 100 Mbps", Bandwidth: 100, MonthlyPrice: 375000},
+			{Code: "biznet_150", Name: "Biznet Home 150 Mbps", Bandwidth: 150, MonthlyPrice: 450000},
+		},
+	},
+	{
+		Code:              "firstmedia",
+		Name:              "First Media",
+		CustomerIdPattern: `^[0-9]{9,11}$`,
+		Plans: []model.IspPlan{
+			{Code: "firstmedia_30", Name: "First Media 30 Mbps", Bandwidth: 30, MonthlyPrice: 299000},
+			{Code: "firstmedia_75", Name: "First Media 75 Mbps", Bandwidth: 75, MonthlyPrice: 429000},
+		},
+	},
+	{
+		Code:              "myrepublic",
+		Name:              "MyRepublic",
+		CustomerIdPattern: `^[0-9]{8,10}$`,
+		Plans: []model.IspPlan{
+			{Code: "myrepublic_30", Name: "MyRepublic 30 Mbps", Bandwidth: 30, MonthlyPrice: 284900},
+			{Code: "myrepublic_50", Name: "MyRepublic 50 Mbps", Bandwidth: 50, MonthlyPrice: 339900},
+			{Code: "myrepublic_100", Name: "MyRepublic 100 Mbps", Bandwidth: 100, MonthlyPrice: 399900},
+		},
+	},
+}
+
+// phonePrefixes are the mobile number prefixes of the providers pulsa and
+// data products are sold under.
+var phonePrefixes = []model.PhonePrefix{
+	{Prefix: "0851", Provider: "AS"},
+	{Prefix: "0852", Provider: "AS"},
+	{Prefix: "0853", Provider: "AS"},
+	{Prefix: "0823", Provider: "AS"},
+	{Prefix: "0811", Provider: "Halo"},
+	{Prefix: "0812", Provider: "Telkomsel"},
+	{Prefix: "0813", Provider: "Telkomsel"},
+	{Prefix: "0821", Provider: "Telkomsel"},
+	{Prefix: "0822", Provider: "Telkomsel"},
+	{Prefix: "0814", Provider: "Indosat"},
+	{Prefix: "0815", Provider: "Indosat"},
+	{Prefix: "0816", Provider: "Indosat"},
+	{Prefix: "0855", Provider: "Indosat"},
+	{Prefix: "0856", Provider: "Indosat"},
+	{Prefix: "0857", Provider: "Indosat"},
+	{Prefix: "0858", Provider: "Indosat"},
+	{Prefix: "0817", Provider: "XL"},
+	{Prefix: "0818", Provider: "XL"},
+	{Prefix: "0819", Provider: "XL"},
+	{Prefix: "0859", Provider: "XL"},
+	{Prefix: "0877", Provider: "XL"},
+	{Prefix: "0878", Provider: "XL"},
+	{Prefix: "0831", Provider: "Axis"},
+	{Prefix: "0832", Provider: "Axis"},
+	{Prefix: "0833", Provider: "Axis"},
+	{Prefix: "0838", Provider: "Axis"},
+	{Prefix: "0895", Provider: "Three"},
+	{Prefix: "0896", Provider: "Three"},
+	{Prefix: "0897", Provider: "Three"},
+	{Prefix: "0898", Provider: "Three"},
+	{Prefix: "0899", Provider: "Three"},
+	{Prefix: "0881", Provider: "Smartfren"},
+	{Prefix: "0882", Provider: "Smartfren"},
+	{Prefix: "0883", Provider: "Smartfren"},
+	{Prefix: "0884", Provider: "Smartfren"},
+	{Prefix: "0885", Provider: "Smartfren"},
+	{Prefix: "0886", Provider: "Smartfren"},
+	{Prefix: "0887", Provider: "Smartfren"},
+	{Prefix: "0888", Provider: "Smartfren"},
+	{Prefix: "0889", Provider: "Smartfren"},
+}
+
+// Seed fills reference tables that are still empty. Rows admins have edited
+// are never overwritten.
+func Seed(db *gorm.DB) {
+	seed(db, &model.PlnTariff{}, &plnTariffs)
+	seed(db, &model.PdamRegion{}, &pdamRegions)
+	seed(db, &model.Isp{}, &isps)
+	seed(db, &model.PhonePrefix{}, &phonePrefixes)
+}
+
+func seed(db *gorm.DB, table interface{}, rows interface{}) {
+	var count int64
+	if err := db.Model(table).Count(&count).Error; err != nil {
+		panic(err)
+	}
+	if count == 0 {
+		if err := db.Create(rows).Error; err != nil {
+			panic(err)
+		}
+	}
+}
@@ -0,0 +1,10 @@
+package dto
+
+type AutoPayDto struct {
+	Category   string  `json:"category"`
+	ProductId  string  `json:"product_id"`
+	CustomerId string  `json:"customer_id"`
+	DiscountId string  `json:"discount_id"`
+	PayDay     int     `json:"pay_day"`
+	MaxAmount  float64 `json:"max_amount"`
+}
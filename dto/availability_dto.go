@@ -0,0 +1,11 @@
+package dto
+
+import "time"
+
+// MaintenanceDto switches manual maintenance on or off. Until is when the
+// product is expected back, shown to users when set.
+type MaintenanceDto struct {
+	Maintenance bool       `json:"maintenance"`
+	Until       *time.Time `json:"until"`
+	Message     string     `json:"message"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+// BulkPriceAdjustmentDto changes the sell price of every product of a
+// provider, or of the whole catalog when Provider is empty, either by
+// Percentage or by a fixed Amount. New prices are rounded to RoundTo.
+type BulkPriceAdjustmentDto struct {
+	Provider   string  `json:"provider"`
+	Percentage float64 `json:"percentage"`
+	Amount     float64 `json:"amount"`
+	RoundTo    float64 `json:"round_to"`
+	DryRun     bool    `json:"dry_run"`
+}
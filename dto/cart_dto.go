@@ -0,0 +1,9 @@
+package dto
+
+type CartItemDto struct {
+	TransactionID string `json:"transaction_id"`
+}
+
+type CartCheckoutDto struct {
+	Pin string `json:"pin"`
+}
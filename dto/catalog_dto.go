@@ -0,0 +1,5 @@
+package dto
+
+type PriceReviewApprovalDto struct {
+	SellPrice float64 `json:"sell_price"`
+}
@@ -0,0 +1,19 @@
+package dto
+
+type EMoneyDto struct {
+	Type     string `json:"type"`
+	Provider string `json:"provider"`
+	Limit    int    `json:"limit"`
+	Page     int    `json:"page"`
+}
+
+type EMoneyInquiryDto struct {
+	ProductID     string `json:"product_id"`
+	AccountNumber string `json:"account_number"`
+}
+
+type TransactionEMoneyDto struct {
+	ProductID     string `json:"product_id"`
+	AccountNumber string `json:"account_number"`
+	DiscountID    string `json:"discount_id"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type NotificationPreferenceDto struct {
+	InApp              *bool  `json:"in_app"`
+	Email              *bool  `json:"email"`
+	QuietHoursStart    string `json:"quiet_hours_start"`
+	QuietHoursEnd      string `json:"quiet_hours_end"`
+	ReminderDaysBefore *int   `json:"reminder_days_before"`
+}
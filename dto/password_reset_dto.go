@@ -0,0 +1,11 @@
+package dto
+
+type ForgotPasswordDto struct {
+	Email string `json:"email"`
+}
+
+type ResetPasswordDto struct {
+	Email       string `json:"email"`
+	Code        string `json:"code"`
+	NewPassword string `json:"new_password"`
+}
@@ -0,0 +1,9 @@
+package dto
+
+type SavedBillerDto struct {
+	Category     string `json:"category"`
+	ProductId    string `json:"product_id"`
+	CustomerId   string `json:"customer_id"`
+	ProviderName string `json:"provider_name"`
+	Nickname     string `json:"nickname"`
+}
@@ -0,0 +1,12 @@
+package dto
+
+import "time"
+
+type ScheduledTransferDto struct {
+	PhoneNumber    string    `json:"phone_number"`
+	Amount         float64   `json:"amount"`
+	Note           string    `json:"note"`
+	StartAt        time.Time `json:"start_at"`
+	CronExpression string    `json:"cron_expression"`
+	MaxRetry       *int      `json:"max_retry"`
+}
@@ -0,0 +1,5 @@
+package dto
+
+type RefreshTokenDto struct {
+	RefreshToken string `json:"refresh_token"`
+}
@@ -0,0 +1,21 @@
+package dto
+
+type VoucherBrandDto struct {
+	Type  string `json:"type"`
+	Name  string `json:"name"`
+	Limit int    `json:"limit"`
+	Page  int    `json:"page"`
+}
+
+type VoucherInquiryDto struct {
+	VoucherID string `json:"voucher_id"`
+	UserId    string `json:"user_id"`
+	ZoneId    string `json:"zone_id"`
+}
+
+type TransactionVoucherDto struct {
+	VoucherID  string `json:"voucher_id"`
+	UserId     string `json:"user_id"`
+	ZoneId     string `json:"zone_id"`
+	DiscountID string `json:"discount_id"`
+}
@@ -5,6 +5,12 @@ import (
 	"BE-Golang/database"
 	"BE-Golang/routes"
 	m "BE-Golang/usecase/middlewares"
+	"context"
+	"net/http"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
@@ -21,6 +27,7 @@ func main() {
 	// database.Drop(db)
 
 	database.Migrate(db)
+	database.Seed(db)
 
 	e := echo.New()
 	e.Use(middleware.CORSWithConfig(middleware.CORSConfig{
@@ -28,8 +35,9 @@ func main() {
 		AllowHeaders: []string{"*"},
 	}))
 
-	routes.Routes(e, db)
+	jobs := routes.Routes(e, db)
 	m.LogMiddlewares(e)
+	jobs.Start()
 
 	// ====== HTTPS ========
 	// httpsServer := &http.Server{
@@ -43,5 +51,21 @@ func main() {
 	// }
 
 	// ====== HTTP ========
-	e.Logger.Fatal(e.Start(":" + config.AppConfig.AppPort))
+	go func() {
+		if err := e.Start(":" + config.AppConfig.AppPort); err != nil && err != http.ErrServerClosed {
+			e.Logger.Fatal(err)
+		}
+	}()
+
+	// Stop taking requests and let running jobs finish before exiting.
+	quit := make(chan os.Signal, 1)
+	signal.Notify(quit, os.Interrupt, syscall.SIGTERM)
+	<-quit
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := e.Shutdown(ctx); err != nil {
+		e.Logger.Error(err)
+	}
+	jobs.Stop()
 }
@@ -0,0 +1,27 @@
+package model
+
+import "time"
+
+const AUTO_PAY_RESULT_PAID = "paid"
+const AUTO_PAY_RESULT_ALREADY_PAID = "already_paid"
+const AUTO_PAY_RESULT_AWAITING_APPROVAL = "awaiting_approval"
+const AUTO_PAY_RESULT_FAILED = "failed"
+
+type AutoPaySubscription struct {
+	UUIDPrimaryKey
+	UserID            string     `gorm:"index" json:"user_id"`
+	Category          string     `gorm:"type:varchar(50)" json:"category"`
+	ProductId         string     `gorm:"type:varchar(100)" json:"product_id"`
+	CustomerId        string     `gorm:"type:varchar(100)" json:"customer_id"`
+	DiscountId        string     `gorm:"type:varchar(100)" json:"discount_id"`
+	PayDay            int        `gorm:"type:int" json:"pay_day"`
+	MaxAmount         float64    `gorm:"type:decimal(12)" json:"max_amount"`
+	Status            string     `gorm:"type:varchar(50);index" json:"status"`
+	NextRunAt         time.Time  `gorm:"index" json:"next_run_at"`
+	LastRunAt         *time.Time `json:"last_run_at"`
+	LastPeriod        string     `gorm:"type:varchar(50)" json:"last_period"`
+	LastResult        string     `gorm:"type:varchar(50)" json:"last_result"`
+	LastTransactionID string     `gorm:"type:varchar(100)" json:"last_transaction_id"`
+	LastAmount        float64    `gorm:"type:decimal(12)" json:"last_amount"`
+	LastError         string     `gorm:"type:text" json:"last_error"`
+}
@@ -0,0 +1,12 @@
+package model
+
+const REMINDER_BEFORE_DUE = "before_due"
+const REMINDER_OVERDUE = "overdue"
+
+type BillReminder struct {
+	UUIDPrimaryKey
+	UserID        string `gorm:"index" json:"user_id"`
+	SavedBillerID string `gorm:"index" json:"saved_biller_id"`
+	Period        string `gorm:"type:varchar(50)" json:"period"`
+	Kind          string `gorm:"type:varchar(50)" json:"kind"`
+}
@@ -0,0 +1,70 @@
+package model
+
+const (
+	BPJS_PROGRAM_JKK = "jkk"
+	BPJS_PROGRAM_JKM = "jkm"
+	BPJS_PROGRAM_JHT = "jht"
+)
+
+// BPJS_MAX_MONTHS caps how many months can be paid in a single bill.
+const BPJS_MAX_MONTHS = 12
+
+// BpjsKetenagakerjaan is a self-employed contribution package. Price is the
+// monthly contribution and is derived from Programs and Income.
+type BpjsKetenagakerjaan struct {
+	UUIDPrimaryKey
+	ProviderName string  `gorm:"type:varchar(100)" json:"provider_name"`
+	Type         string  `gorm:"type:varchar(100);index" json:"product_type"`
+	Name         string  `gorm:"type:varchar(100)" json:"name"`
+	Programs     string  `gorm:"type:varchar(100)" json:"programs"`
+	Income       float64 `gorm:"type:decimal(12)" json:"income"`
+	Price        float64 `gorm:"type:decimal(12)" json:"price"`
+}
+
+// BpjsMonth is one month of a multi-month BPJS bill.
+type BpjsMonth struct {
+	Period       string  `json:"period"`
+	Contribution float64 `json:"contribution"`
+	Penalty      float64 `json:"penalty"`
+	Total        float64 `json:"total"`
+}
+
+type BpjsMember struct {
+	CardNumber string `json:"card_number"`
+	Name       string `json:"name,omitempty"`
+	Relation   string `json:"relation"`
+}
+
+// BpjsKesehatanBill is the product detail of a BPJS Kesehatan transaction.
+// It keeps the json fields of Insurance so older transactions still decode.
+type BpjsKesehatanBill struct {
+	CustomerID     string       `json:"customer_id"`
+	ProviderName   string       `json:"provider_name"`
+	Type           string       `json:"product_type"`
+	Name           string       `json:"name"`
+	Period         string       `json:"period"`
+	Class          string       `json:"class"`
+	NumberOffamily int          `json:"number_of_family"`
+	Members        []BpjsMember `json:"members"`
+	Months         int          `json:"months"`
+	Breakdown      []BpjsMonth  `json:"breakdown"`
+	Penalty        float64      `json:"penalty"`
+	DiscountId     string       `json:"discount_id"`
+	Price          float64      `json:"price"`
+}
+
+// BpjsKetenagakerjaanBill is the product detail of a BPJS Ketenagakerjaan
+// transaction.
+type BpjsKetenagakerjaanBill struct {
+	CustomerID   string      `json:"customer_id"`
+	ProviderName string      `json:"provider_name"`
+	Type         string      `json:"product_type"`
+	Name         string      `json:"name"`
+	Period       string      `json:"period"`
+	Programs     string      `json:"programs"`
+	Income       float64     `json:"income"`
+	Months       int         `json:"months"`
+	Breakdown    []BpjsMonth `json:"breakdown"`
+	DiscountId   string      `json:"discount_id"`
+	Price        float64     `json:"price"`
+}
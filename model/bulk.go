@@ -0,0 +1,36 @@
+package model
+
+const (
+	BULK_FORMAT_CSV  = "csv"
+	BULK_FORMAT_XLSX = "xlsx"
+)
+
+// BulkRowError is a problem with one row of an uploaded sheet. Row is the
+// line number in the sheet, counting the header as line 1.
+type BulkRowError struct {
+	Row     int    `json:"row"`
+	Message string `json:"message"`
+}
+
+// BulkImportResult reports an upload. Nothing is saved when it has errors.
+type BulkImportResult struct {
+	Catalog string         `json:"catalog"`
+	DryRun  bool           `json:"dry_run"`
+	Rows    int            `json:"rows"`
+	Created int            `json:"created"`
+	Updated int            `json:"updated"`
+	Errors  []BulkRowError `json:"errors"`
+}
+
+type BulkPriceChange struct {
+	Code     string  `json:"code"`
+	Name     string  `json:"name"`
+	OldPrice float64 `json:"old_price"`
+	NewPrice float64 `json:"new_price"`
+}
+
+type BulkPriceAdjustmentResult struct {
+	Catalog string            `json:"catalog"`
+	DryRun  bool              `json:"dry_run"`
+	Changes []BulkPriceChange `json:"changes"`
+}
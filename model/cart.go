@@ -0,0 +1,36 @@
+package model
+
+import "time"
+
+const CART_STATUS_OPEN = "open"
+const CART_STATUS_CHECKING_OUT = "checking_out"
+const CART_STATUS_COMPLETED = "completed"
+const CART_STATUS_PARTIALLY_COMPLETED = "partially_completed"
+const CART_STATUS_FAILED = "failed"
+
+type Cart struct {
+	UUIDPrimaryKey
+	UserID        string     `gorm:"index" json:"user_id"`
+	Status        string     `gorm:"type:varchar(50);index" json:"status"`
+	Price         float64    `gorm:"type:decimal(12)" json:"price"`
+	DiscountPrice float64    `gorm:"type:decimal(12)" json:"discount_price"`
+	AdminFee      float64    `gorm:"type:decimal(12)" json:"admin_fee"`
+	TotalPrice    float64    `gorm:"type:decimal(12)" json:"total_price"`
+	RefundAmount  float64    `gorm:"type:decimal(12)" json:"refund_amount"`
+	PaidAt        *time.Time `json:"paid_at"`
+	Items         []CartItem `gorm:"foreignKey:CartID" json:"items"`
+}
+
+type CartItem struct {
+	UUIDPrimaryKey
+	CartID        string  `gorm:"index" json:"cart_id"`
+	TransactionID string  `gorm:"type:varchar(100)" json:"transaction_id"`
+	Category      string  `gorm:"type:varchar(50)" json:"category"`
+	Description   string  `gorm:"type:text" json:"description"`
+	Price         float64 `gorm:"type:decimal(12)" json:"price"`
+	DiscountPrice float64 `gorm:"type:decimal(12)" json:"discount_price"`
+	AdminFee      float64 `gorm:"type:decimal(12)" json:"admin_fee"`
+	TotalPrice    float64 `gorm:"type:decimal(12)" json:"total_price"`
+	Status        string  `gorm:"type:varchar(50)" json:"status"`
+	Error         string  `gorm:"type:text" json:"error"`
+}
@@ -0,0 +1,66 @@
+package model
+
+const (
+	CATALOG_PPD                  = "ppd"
+	CATALOG_ISP_PLAN             = "isp_plan"
+	CATALOG_INSURANCE            = "insurance"
+	CATALOG_ELECTRICITY          = "electricity"
+	CATALOG_DISCOUNT             = "discount"
+	CATALOG_BANK                 = "bank"
+	CATALOG_PDAM_REGION          = "pdam_region"
+	CATALOG_BPJS_KETENAGAKERJAAN = "bpjs_ketenagakerjaan"
+	CATALOG_PLN_TARIFF           = "pln_tariff"
+)
+
+const (
+	PRICE_REVIEW_PENDING  = "pending"
+	PRICE_REVIEW_APPROVED = "approved"
+	PRICE_REVIEW_REJECTED = "rejected"
+)
+
+// ProviderPrice is one product on a provider's price list. Products without
+// an availability flag are available.
+type ProviderPrice struct {
+	Code      string  `json:"code"`
+	Name      string  `json:"name"`
+	CostPrice float64 `json:"cost_price"`
+	Available *bool   `json:"available"`
+}
+
+// CatalogItem is a catalog row as the catalog sync sees it.
+type CatalogItem struct {
+	ID        string
+	Code      string
+	Name      string
+	CostPrice float64
+	SellPrice float64
+	IsActive  bool
+}
+
+// CatalogPriceReview is a provider cost price change waiting for an admin to
+// set the sell price that goes with it.
+type CatalogPriceReview struct {
+	UUIDPrimaryKey
+	Catalog           string  `gorm:"type:varchar(20);index" json:"catalog"`
+	ProductID         string  `gorm:"type:varchar(100)" json:"product_id"`
+	Code              string  `gorm:"type:varchar(100);index" json:"code"`
+	Name              string  `gorm:"type:varchar(255)" json:"name"`
+	OldCostPrice      float64 `gorm:"type:decimal(12)" json:"old_cost_price"`
+	NewCostPrice      float64 `gorm:"type:decimal(12)" json:"new_cost_price"`
+	SellPrice         float64 `gorm:"type:decimal(12)" json:"sell_price"`
+	ApprovedSellPrice float64 `gorm:"type:decimal(12)" json:"approved_sell_price"`
+	Status            string  `gorm:"type:varchar(20);index" json:"status"`
+}
+
+// CatalogSyncResult is the difference between a catalog and a provider price
+// list, and what the sync did about it.
+type CatalogSyncResult struct {
+	Catalog      string                `json:"catalog"`
+	Source       string                `json:"source"`
+	DryRun       bool                  `json:"dry_run"`
+	Unchanged    int                   `json:"unchanged"`
+	Deactivated  []string              `json:"deactivated"`
+	CostPriced   []string              `json:"cost_priced"`
+	PriceReviews []*CatalogPriceReview `json:"price_reviews"`
+	New          []ProviderPrice       `json:"new"`
+}
@@ -0,0 +1,51 @@
+package model
+
+import "strings"
+
+const (
+	CHECKSUM_LUHN  = "luhn"
+	CHECKSUM_MOD11 = "mod11"
+)
+
+// CustomerIdRule is an admin-defined check on the customer IDs of a bill
+// product. Provider narrows the rule to one provider of the product, e.g. a
+// PDAM region or an ISP; an empty Provider applies to all of them. Zero
+// lengths and an empty Prefixes or Checksum are not checked.
+type CustomerIdRule struct {
+	UUIDPrimaryKey
+	Product     string `gorm:"type:varchar(50);uniqueIndex:idx_customer_id_rule" json:"product"`
+	Provider    string `gorm:"type:varchar(50);uniqueIndex:idx_customer_id_rule" json:"provider"`
+	MinLength   int    `json:"min_length"`
+	MaxLength   int    `json:"max_length"`
+	NumericOnly bool   `json:"numeric_only"`
+	Prefixes    string `gorm:"type:varchar(255)" json:"prefixes"`
+	Checksum    string `gorm:"type:varchar(20)" json:"checksum"`
+	Description string `gorm:"type:varchar(255)" json:"description"`
+}
+
+// FieldError is one failed check on a request field.
+type FieldError struct {
+	Field   string `json:"field"`
+	Rule    string `json:"rule"`
+	Message string `json:"message"`
+}
+
+// ValidationError is returned when a request fails one or more field checks.
+type ValidationError struct {
+	Errors []FieldError
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, 0, len(e.Errors))
+	for _, field := range e.Errors {
+		messages = append(messages, field.Message)
+	}
+
+	return strings.Join(messages, "; ")
+}
+
+type ValidationErrorResponse struct {
+	StatusCode int          `json:"status"`
+	Message    string       `json:"message"`
+	Errors     []FieldError `json:"errors"`
+}
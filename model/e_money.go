@@ -0,0 +1,63 @@
+package model
+
+const EMONEY_TYPE_WALLET = "wallet"
+const EMONEY_TYPE_ETOLL = "etoll"
+
+// PRODUCT_EMONEY is the transaction product type of e-money top-ups.
+const PRODUCT_EMONEY = "emoney"
+
+const NOTIFICATION_EMONEY = "emoney"
+
+// EMoney is a top-up denomination. Nominal is the balance credited to the
+// account and Price is what the user pays for it.
+type EMoney struct {
+	UUIDPrimaryKey
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Code        string  `json:"code"`
+	Provider    string  `json:"provider"`
+	Nominal     float64 `json:"nominal"`
+	Price       float64 `json:"price"`
+	IsActive    *bool   `json:"is_active" gorm:"default:true"`
+	Description string  `json:"description"`
+}
+
+type EMoneyResponse struct {
+	ID          string  `json:"id"`
+	Name        string  `json:"name"`
+	Type        string  `json:"type"`
+	Code        string  `json:"code"`
+	Provider    string  `json:"provider"`
+	Nominal     float64 `json:"nominal"`
+	Price       float64 `json:"price"`
+	IsActive    *bool   `json:"is_active"`
+	Description string  `json:"description"`
+}
+
+// EMoneyAccount is the account holder returned by a provider lookup.
+type EMoneyAccount struct {
+	Provider      string `json:"provider"`
+	AccountNumber string `json:"account_number"`
+	AccountName   string `json:"account_name"`
+}
+
+// EMoneyTopUpResult is a provider's answer to a top-up request. Status is one
+// of STATUS_PROCESSING, STATUS_SUCCESSFUL or STATUS_FAIL.
+type EMoneyTopUpResult struct {
+	Status            string `json:"status"`
+	ProviderReference string `json:"provider_reference"`
+	ReferenceNumber   string `json:"reference_number"`
+	Message           string `json:"message"`
+}
+
+type TransactionEMoney struct {
+	Provider        string  `json:"provider"`
+	AccountNumber   string  `json:"account_number"`
+	AccountName     string  `json:"account_name"`
+	Name            string  `json:"name"`
+	Code            string  `json:"code"`
+	Nominal         float64 `json:"nominal"`
+	DiscountID      string  `json:"discount_id"`
+	ReferenceNumber string  `json:"reference_number"`
+	Fulfilment
+}
@@ -1,14 +1,42 @@
 package model
 
+// Electricity is an electricity product. Code is the provider's product code;
+// CostPrice is what the provider charges for it and is only changed by the
+// catalog sync.
 type Electricity struct {
 	UUIDPrimaryKey
+	Code            string  `gorm:"type:varchar(100);index" json:"code"`
 	CustomerId      string  `gorm:"type:varchar(100)" json:"customer_id"`
+	MeterNumber     string  `gorm:"type:varchar(20)" json:"meter_number"`
 	ProviderName    string  `gorm:"type:varchar(100)" json:"provider_name"`
 	Type            string  `gorm:"type:varchar(100)" json:"product_type"`
 	Name            string  `gorm:"type:varchar(100)" json:"name"`
 	Period          string  `gorm:"type:varchar(100)" json:"period"`
 	Token           string  `gorm:"type:varchar(100)" json:"token"`
 	ElectricalPower int     `gorm:"type:int" json:"electrical_power"`
+	TariffClass     string  `gorm:"type:varchar(10)" json:"tariff_class"`
+	RatePerKwh      float64 `gorm:"type:decimal(10,2)" json:"rate_per_kwh"`
+	Kwh             float64 `gorm:"type:decimal(12,2)" json:"kwh"`
+	EnergyCharge    float64 `gorm:"type:decimal(12)" json:"energy_charge"`
+	Ppj             float64 `gorm:"type:decimal(12)" json:"ppj"`
+	Ppn             float64 `gorm:"type:decimal(12)" json:"ppn"`
+	StampDuty       float64 `gorm:"type:decimal(12)" json:"stamp_duty"`
 	DiscountId      string  `gorm:"type:varchar(100)" json:"discount_id"`
 	Price           float64 `gorm:"type:decimal(12)" json:"price"`
+	CostPrice       float64 `gorm:"type:decimal(12)" json:"-"`
+	IsActive        *bool   `gorm:"default:true" json:"is_active"`
+}
+
+// PlnMeter is the connection behind a prepaid meter number, shown to the user
+// before they buy a token.
+type PlnMeter struct {
+	MeterNumber     string `json:"meter_number"`
+	Name            string `json:"name"`
+	TariffClass     string `json:"tariff_class"`
+	ElectricalPower int    `json:"electrical_power"`
+}
+
+// TokenDenomination is a prepaid token amount users can buy.
+type TokenDenomination struct {
+	Nominal float64 `json:"nominal"`
 }
@@ -1,7 +1,11 @@
 package model
 
+// Insurance is an insurance product. Code is the provider's product code;
+// CostPrice is what the provider charges for it and is only changed by the
+// catalog sync.
 type Insurance struct {
 	UUIDPrimaryKey
+	Code           string  `gorm:"type:varchar(100);index" json:"code"`
 	CustomerID     string  `gorm:"type:varchar(100)" json:"customer_id"`
 	ProviderName   string  `gorm:"type:varchar(100)" json:"provider_name"`
 	Type           string  `gorm:"type:varchar(100)" json:"product_type"`
@@ -11,4 +15,6 @@ type Insurance struct {
 	NumberOffamily int     `gorm:"type:int" json:"number_of_family"`
 	DiscountId     string  `gorm:"type:varchar(100)" json:"discount_id"`
 	Price          float64 `gorm:"type:decimal(12)" json:"price"`
+	CostPrice      float64 `gorm:"type:decimal(12)" json:"-"`
+	IsActive       *bool   `gorm:"default:true" json:"is_active"`
 }
@@ -0,0 +1,33 @@
+package model
+
+// Isp is a home internet provider. Code selects the provider in
+// /wifi/inquiry and customer IDs of the provider must match
+// CustomerIdPattern.
+type Isp struct {
+	UUIDPrimaryKey
+	Code              string    `gorm:"type:varchar(50);uniqueIndex" json:"code"`
+	Name              string    `gorm:"type:varchar(100)" json:"name"`
+	CustomerIdPattern string    `gorm:"type:varchar(100)" json:"customer_id_pattern"`
+	IsActive          *bool     `gorm:"default:true" json:"is_active"`
+	Plans             []IspPlan `gorm:"foreignKey:IspID;constraint:OnDelete:CASCADE" json:"plans,omitempty"`
+}
+
+// IspPlan is a monthly subscription plan of an ISP.
+type IspPlan struct {
+	UUIDPrimaryKey
+	IspID        string  `gorm:"type:uuid;index" json:"isp_id"`
+	Code         string  `gorm:"type:varchar(50);uniqueIndex" json:"code"`
+	Name         string  `gorm:"type:varchar(100)" json:"name"`
+	Bandwidth    int     `gorm:"type:int" json:"bandwidth"`
+	MonthlyPrice float64 `gorm:"type:decimal(12)" json:"monthly_price"`
+	CostPrice    float64 `gorm:"type:decimal(12)" json:"-"`
+	IsActive     *bool   `gorm:"default:true" json:"is_active"`
+}
+
+// WifiSubscription is the plan a customer of an ISP is subscribed to.
+type WifiSubscription struct {
+	IspCode    string  `json:"isp_code"`
+	IspName    string  `json:"isp_name"`
+	CustomerID string  `json:"customer_id"`
+	Plan       IspPlan `json:"plan"`
+}
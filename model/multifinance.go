@@ -0,0 +1,32 @@
+package model
+
+// FinanceCompany is a multifinance company users pay installments to. Code is
+// the product ID users pick on inquiry. Installments fall due on DueDay of
+// each month and LateFeeRate of the installment is charged per day late.
+type FinanceCompany struct {
+	UUIDPrimaryKey
+	Code           string  `gorm:"type:varchar(100);uniqueIndex" json:"code"`
+	Name           string  `gorm:"type:varchar(100)" json:"name"`
+	ContractLength int     `json:"contract_length"`
+	DueDay         int     `json:"due_day"`
+	LateFeeRate    float64 `gorm:"type:decimal(6,4)" json:"late_fee_rate"`
+}
+
+// MultifinanceBill is the product detail of an installment payment.
+// CustomerID holds the contract number.
+type MultifinanceBill struct {
+	CustomerID        string  `json:"customer_id"`
+	ProviderName      string  `json:"provider_name"`
+	Type              string  `json:"product_type"`
+	Name              string  `json:"name"`
+	Period            string  `json:"period"`
+	InstallmentNumber int     `json:"installment_number"`
+	Tenor             int     `json:"tenor"`
+	DueDate           string  `json:"due_date"`
+	DaysLate          int     `json:"days_late"`
+	InstallmentAmount float64 `json:"installment_amount"`
+	LateFee           float64 `json:"late_fee"`
+	DiscountId        string  `json:"discount_id"`
+	Price             float64 `json:"price"`
+	ReferenceNumber   string  `json:"reference_number"`
+}
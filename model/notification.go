@@ -0,0 +1,25 @@
+package model
+
+const NOTIFICATION_TRANSFER = "transfer"
+const NOTIFICATION_AUTO_PAY = "auto_pay"
+const NOTIFICATION_BILL_REMINDER = "bill_reminder"
+const NOTIFICATION_CART = "cart"
+
+type Notification struct {
+	UUIDPrimaryKey
+	UserID   string `gorm:"index" json:"user_id"`
+	Category string `gorm:"type:varchar(50)" json:"category"`
+	Title    string `gorm:"type:varchar(255)" json:"title"`
+	Message  string `gorm:"type:text" json:"message"`
+	IsRead   bool   `gorm:"default:false" json:"is_read"`
+}
+
+type NotificationPreference struct {
+	UUIDPrimaryKey
+	UserID             string `gorm:"uniqueIndex" json:"user_id"`
+	InApp              bool   `json:"in_app"`
+	Email              bool   `json:"email"`
+	QuietHoursStart    string `gorm:"type:varchar(5)" json:"quiet_hours_start"`
+	QuietHoursEnd      string `gorm:"type:varchar(5)" json:"quiet_hours_end"`
+	ReminderDaysBefore int    `gorm:"type:int" json:"reminder_days_before"`
+}
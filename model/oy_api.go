@@ -7,6 +7,7 @@ type OyBillerApi struct {
 	Period      string  `json:"additional_data"`
 	DiscountId  string  `json:"discount_id"`
 	Amount      float64 `json:"amount"`
+	Months      int     `json:"months,omitempty"`
 }
 
 type OyBillerStatus struct {
@@ -0,0 +1,15 @@
+package model
+
+import "time"
+
+// PasswordReset is a one-time code emailed to a user who forgot their
+// password. Only a keyed hash of the code is stored, and only the latest
+// code of a user can be used.
+type PasswordReset struct {
+	UUIDPrimaryKey
+	UserID    string     `gorm:"type:varchar(100);index" json:"user_id"`
+	CodeHash  string     `gorm:"type:varchar(64)" json:"-"`
+	Attempts  int        `json:"attempts"`
+	ExpiresAt time.Time  `gorm:"index" json:"expires_at"`
+	UsedAt    *time.Time `json:"used_at"`
+}
@@ -2,13 +2,41 @@ package model
 
 type Pdam struct {
 	UUIDPrimaryKey
-	PartnerId    string  `gorm:"type:varchar(100)" json:"partner_id"`
-	CustomerID   string  `gorm:"type:varchar(100)" json:"customer_id"`
-	ProviderName string  `gorm:"type:varchar(100)" json:"provider_name"`
-	Type         string  `gorm:"type:varchar(100)" json:"product_type"`
-	Name         string  `gorm:"type:varchar(100)" json:"name"`
-	Address      string  `gorm:"type:text" json:"address"`
-	Period       string  `gorm:"type:varchar(100)" json:"period"`
-	DiscountId   string  `gorm:"type:varchar(100)" json:"discount_id"`
-	Price        float64 `gorm:"type:decimal(12)" json:"price"`
+	PartnerId     string  `gorm:"type:varchar(100)" json:"partner_id"`
+	CustomerID    string  `gorm:"type:varchar(100)" json:"customer_id"`
+	ProviderName  string  `gorm:"type:varchar(100)" json:"provider_name"`
+	Type          string  `gorm:"type:varchar(100)" json:"product_type"`
+	Name          string  `gorm:"type:varchar(100)" json:"name"`
+	Address       string  `gorm:"type:text" json:"address"`
+	Period        string  `gorm:"type:varchar(100)" json:"period"`
+	CustomerClass string  `gorm:"type:varchar(10)" json:"customer_class"`
+	Usage         int     `gorm:"type:int" json:"usage"`
+	UsageCharge   float64 `gorm:"type:decimal(12)" json:"usage_charge"`
+	FixedFee      float64 `gorm:"type:decimal(12)" json:"fixed_fee"`
+	DiscountId    string  `gorm:"type:varchar(100)" json:"discount_id"`
+	Price         float64 `gorm:"type:decimal(12)" json:"price"`
+}
+
+// PdamRegion is a regency's water utility. Code selects the region in
+// /pdam/inquiry and BillerCode is the partner's code for the same PDAM.
+// Customer IDs of the region must match CustomerIdPattern.
+type PdamRegion struct {
+	UUIDPrimaryKey
+	Code              string       `gorm:"type:varchar(50);uniqueIndex" json:"code"`
+	Name              string       `gorm:"type:varchar(100)" json:"name"`
+	BillerCode        string       `gorm:"type:varchar(50)" json:"biller_code"`
+	CustomerIdPattern string       `gorm:"type:varchar(100)" json:"customer_id_pattern"`
+	FixedFee          float64      `gorm:"type:decimal(12)" json:"fixed_fee"`
+	Tariffs           []PdamTariff `gorm:"foreignKey:RegionID;constraint:OnDelete:CASCADE" json:"tariffs"`
+}
+
+// PdamTariff is one usage block of a customer class. Usage above the previous
+// block and up to UpTo m³ is charged at RatePerM3; UpTo 0 is the open-ended
+// last block.
+type PdamTariff struct {
+	UUIDPrimaryKey
+	RegionID      string  `gorm:"type:uuid;index" json:"region_id"`
+	CustomerClass string  `gorm:"type:varchar(10)" json:"customer_class"`
+	UpTo          int     `json:"up_to"`
+	RatePerM3     float64 `gorm:"type:decimal(10,2)" json:"rate_per_m3"`
 }
@@ -0,0 +1,18 @@
+package model
+
+// PhonePrefix maps the leading digits of a mobile number, in its local 08xx
+// form, to the provider its pulsa and data products are sold under. When
+// prefixes overlap the longest one wins, so 0895 and 08951 can point to
+// different providers.
+type PhonePrefix struct {
+	UUIDPrimaryKey
+	Prefix   string `gorm:"type:varchar(10);uniqueIndex" json:"prefix"`
+	Provider string `gorm:"type:varchar(50);index" json:"provider"`
+}
+
+// PhoneProvider is a normalized mobile number and the provider it belongs to.
+type PhoneProvider struct {
+	PhoneNumber string `json:"phone_number"`
+	E164        string `json:"e164"`
+	Provider    string `json:"provider"`
+}
@@ -0,0 +1,13 @@
+package model
+
+// PlnTariff is a PLN tariff class and the power band it covers, e.g. R1 from
+// 1300 VA to 2200 VA. MaxPower 0 means the band has no upper limit. The
+// first letter of the class is the customer segment: R, B or I.
+type PlnTariff struct {
+	UUIDPrimaryKey
+	Class       string  `gorm:"type:varchar(10);uniqueIndex:idx_pln_tariff_band" json:"class"`
+	MinPower    int     `gorm:"uniqueIndex:idx_pln_tariff_band" json:"min_power"`
+	MaxPower    int     `json:"max_power"`
+	RatePerKwh  float64 `gorm:"type:decimal(10,2)" json:"rate_per_kwh"`
+	Description string  `gorm:"type:varchar(255)" json:"description"`
+}
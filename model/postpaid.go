@@ -0,0 +1,33 @@
+package model
+
+// PostpaidMobileBill is the product detail of a postpaid mobile bill.
+// CustomerID holds the subscriber's phone number.
+type PostpaidMobileBill struct {
+	CustomerID   string  `json:"customer_id"`
+	ProviderName string  `json:"provider_name"`
+	Type         string  `json:"product_type"`
+	Name         string  `json:"name"`
+	Period       string  `json:"period"`
+	PlanName     string  `json:"plan_name"`
+	PlanFee      float64 `json:"plan_fee"`
+	ExtraMinutes int     `json:"extra_minutes"`
+	ExtraDataMB  int     `json:"extra_data_mb"`
+	UsageFee     float64 `json:"usage_fee"`
+	Tax          float64 `json:"tax"`
+	DiscountId   string  `json:"discount_id"`
+	Price        float64 `json:"price"`
+}
+
+// CableTvBill is the product detail of a cable or satellite TV bill.
+type CableTvBill struct {
+	CustomerID   string  `json:"customer_id"`
+	ProviderName string  `json:"provider_name"`
+	Type         string  `json:"product_type"`
+	Name         string  `json:"name"`
+	Period       string  `json:"period"`
+	PackageName  string  `json:"package_name"`
+	PackageFee   float64 `json:"package_fee"`
+	Tax          float64 `json:"tax"`
+	DiscountId   string  `json:"discount_id"`
+	Price        float64 `json:"price"`
+}
@@ -0,0 +1,62 @@
+package model
+
+import (
+	"fmt"
+	"time"
+)
+
+const (
+	AVAILABILITY_BANK  = "bank"
+	AVAILABILITY_PPD   = "ppd"
+	MAINTENANCE_MANUAL = "manual"
+	// MAINTENANCE_CIRCUIT_BREAKER is maintenance switched on because the
+	// provider kept failing. It ends by itself.
+	MAINTENANCE_CIRCUIT_BREAKER = "circuit_breaker"
+)
+
+// WIB is the time zone availability windows are written in.
+var WIB = time.FixedZone("WIB", 7*60*60)
+
+// ProductAvailability says when a product, or one provider of it, cannot be
+// sold. Product is a bill product code, "ppd" or "bank"; an empty Provider
+// covers every provider of the product. Windows are daily WIB downtimes,
+// e.g. "23:00-01:00,12:00-12:15".
+type ProductAvailability struct {
+	UUIDPrimaryKey
+	Product           string     `gorm:"type:varchar(50);uniqueIndex:idx_product_availability" json:"product"`
+	Provider          string     `gorm:"type:varchar(100);uniqueIndex:idx_product_availability" json:"provider"`
+	Windows           string     `gorm:"type:varchar(255)" json:"windows"`
+	Maintenance       bool       `json:"maintenance"`
+	MaintenanceSource string     `gorm:"type:varchar(20)" json:"maintenance_source"`
+	MaintenanceUntil  *time.Time `json:"maintenance_until"`
+	Message           string     `gorm:"type:varchar(255)" json:"message"`
+	Failures          int        `json:"failures"`
+}
+
+// Availability is whether a product can be sold right now. Until is when it
+// is expected back, when known.
+type Availability struct {
+	Available bool       `json:"available"`
+	Until     *time.Time `json:"until,omitempty"`
+	Message   string     `json:"message,omitempty"`
+}
+
+// UnavailableError is returned instead of calling a provider that is in
+// maintenance or outside its availability window.
+type UnavailableError struct {
+	Name    string
+	Until   *time.Time
+	Message string
+}
+
+func (e *UnavailableError) Error() string {
+	message := fmt.Sprintf("%s is temporarily unavailable", e.Name)
+	if e.Until != nil {
+		message += " until " + e.Until.In(WIB).Format("02 Jan 2006 15:04 MST")
+	}
+	if e.Message != "" {
+		message += ": " + e.Message
+	}
+
+	return message
+}
@@ -3,6 +3,10 @@ package model
 const PULSA_TYPE = "pulsa"
 const PAKET_DATA_TYPE = "data"
 
+const NOTIFICATION_PPD = "ppd"
+
+// PulsaPaketData is a pulsa or data product. CostPrice is what the provider
+// charges for it and is only changed by the catalog sync.
 type PulsaPaketData struct {
 	UUIDPrimaryKey
 	Name        string  `json:"name"`
@@ -10,6 +14,7 @@ type PulsaPaketData struct {
 	Code        string  `json:"code"`
 	Provider    string  `json:"provider"`
 	Price       float64 `json:"price"`
+	CostPrice   float64 `json:"-"`
 	IsActive    *bool   `json:"is_active" gorm:"default:true"`
 	Description string  `json:"description"`
 }
@@ -24,3 +29,12 @@ type PPDResponse struct {
 	IsActive    *bool   `json:"is_active"`
 	Description string  `json:"description"`
 }
+
+// PPDPurchaseResult is a provider's answer to a pulsa or data purchase. Status
+// is one of STATUS_PROCESSING, STATUS_SUCCESSFUL or STATUS_FAIL.
+type PPDPurchaseResult struct {
+	Status            string `json:"status"`
+	ProviderReference string `json:"provider_reference"`
+	SerialNumber      string `json:"serial_number"`
+	Message           string `json:"message"`
+}
@@ -0,0 +1,23 @@
+package model
+
+import "time"
+
+type SavedBiller struct {
+	UUIDPrimaryKey
+	UserID         string     `gorm:"index" json:"user_id"`
+	Category       string     `gorm:"type:varchar(50)" json:"category"`
+	ProductId      string     `gorm:"type:varchar(100)" json:"product_id"`
+	CustomerId     string     `gorm:"type:varchar(100)" json:"customer_id"`
+	ProviderName   string     `gorm:"type:varchar(100)" json:"provider_name"`
+	Nickname       string     `gorm:"type:varchar(100)" json:"nickname"`
+	LastPaidPeriod string     `gorm:"type:varchar(50)" json:"last_paid_period"`
+	LastPaidAmount float64    `gorm:"type:decimal(12)" json:"last_paid_amount"`
+	LastPaidAt     *time.Time `json:"last_paid_at"`
+}
+
+type DueBill struct {
+	Biller      *SavedBiller `json:"biller"`
+	Transaction *Transaction `json:"transaction"`
+	IsPaid      bool         `json:"is_paid"`
+	Error       string       `json:"error,omitempty"`
+}
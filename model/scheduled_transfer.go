@@ -0,0 +1,25 @@
+package model
+
+import "time"
+
+const SCHEDULE_STATUS_ACTIVE = "active"
+const SCHEDULE_STATUS_PAUSED = "paused"
+const SCHEDULE_STATUS_CANCELLED = "cancelled"
+const SCHEDULE_STATUS_COMPLETED = "completed"
+const SCHEDULE_STATUS_FAILED = "failed"
+
+type ScheduledTransfer struct {
+	UUIDPrimaryKey
+	UserID            string     `gorm:"index" json:"user_id"`
+	PhoneNumber       string     `gorm:"type:varchar(20)" json:"phone_number"`
+	Amount            float64    `gorm:"type:decimal(12)" json:"amount"`
+	Note              string     `gorm:"type:text" json:"note"`
+	CronExpression    string     `gorm:"type:varchar(100)" json:"cron_expression"`
+	Status            string     `gorm:"type:varchar(50);index" json:"status"`
+	NextRunAt         time.Time  `gorm:"index" json:"next_run_at"`
+	LastRunAt         *time.Time `json:"last_run_at"`
+	LastTransactionID string     `gorm:"type:varchar(100)" json:"last_transaction_id"`
+	LastError         string     `gorm:"type:text" json:"last_error"`
+	RetryCount        int        `gorm:"type:int" json:"retry_count"`
+	MaxRetry          int        `gorm:"type:int" json:"max_retry"`
+}
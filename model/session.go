@@ -0,0 +1,22 @@
+package model
+
+import "time"
+
+// Session is a login on one device. Its refresh token is stored hashed and
+// replaced on every refresh, along with the access token it was issued with.
+type Session struct {
+	UUIDPrimaryKey
+	UserID           string     `gorm:"type:varchar(100);index" json:"user_id"`
+	RefreshTokenHash string     `gorm:"type:varchar(64);uniqueIndex" json:"-"`
+	AccessTokenID    string     `gorm:"type:varchar(100)" json:"-"`
+	AccessExpiresAt  time.Time  `json:"-"`
+	ExpiresAt        time.Time  `json:"expires_at"`
+	RevokedAt        *time.Time `json:"revoked_at"`
+}
+
+// RevokedToken is the ID (jti) of an access token rejected before it
+// expires. It can be deleted once ExpiresAt has passed.
+type RevokedToken struct {
+	ID        string    `gorm:"type:varchar(100);primaryKey" json:"id"`
+	ExpiresAt time.Time `gorm:"index" json:"expires_at"`
+}
@@ -0,0 +1,46 @@
+package model
+
+// TaxRegion is a municipality collecting PBB or a province collecting vehicle
+// tax. Code is the product ID users pick on inquiry. Prefixes lists the tax
+// object number prefixes (PBB) or plate letters (Samsat) the region owns.
+type TaxRegion struct {
+	UUIDPrimaryKey
+	Category string  `gorm:"type:varchar(50);index" json:"category"`
+	Code     string  `gorm:"type:varchar(100);uniqueIndex" json:"code"`
+	Name     string  `gorm:"type:varchar(100)" json:"name"`
+	Prefixes string  `gorm:"type:varchar(255)" json:"prefixes"`
+	TaxRate  float64 `gorm:"type:decimal(6,4)" json:"tax_rate"`
+}
+
+// PbbBill is the product detail of a land and building tax transaction.
+// CustomerID holds the 18 digit tax object number (NOP).
+type PbbBill struct {
+	CustomerID      string  `json:"customer_id"`
+	ProviderName    string  `json:"provider_name"`
+	Type            string  `json:"product_type"`
+	Name            string  `json:"name"`
+	Period          string  `json:"period"`
+	LandArea        int     `json:"land_area"`
+	BuildingArea    int     `json:"building_area"`
+	Njop            float64 `json:"njop"`
+	DiscountId      string  `json:"discount_id"`
+	Price           float64 `json:"price"`
+	ReferenceNumber string  `json:"reference_number"`
+}
+
+// SamsatBill is the product detail of a vehicle tax transaction. CustomerID
+// holds the plate number.
+type SamsatBill struct {
+	CustomerID      string  `json:"customer_id"`
+	ProviderName    string  `json:"provider_name"`
+	Type            string  `json:"product_type"`
+	Name            string  `json:"name"`
+	Period          string  `json:"period"`
+	VehicleType     string  `json:"vehicle_type"`
+	VehicleValue    float64 `json:"vehicle_value"`
+	Pkb             float64 `json:"pkb"`
+	Swdkllj         float64 `json:"swdkllj"`
+	DiscountId      string  `json:"discount_id"`
+	Price           float64 `json:"price"`
+	ReferenceNumber string  `json:"reference_number"`
+}
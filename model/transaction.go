@@ -10,11 +10,24 @@ const STATUS_UNPAID = "unpaid"
 const STATUS_FAIL = "fail"
 const STATUS_PROCESSING = "processing"
 const STATUS_SUCCESSFUL = "successful"
+const STATUS_EXPIRED = "expired"
+const STATUS_CANCELLED = "cancelled"
 
 const ADMIN_FEE = 2500
 
 // ======== Product Type=========
 
+const PRODUCT_PDAM = "pdam"
+const PRODUCT_WIFI = "wifi"
+const PRODUCT_INSURANCE = "insurance"
+const PRODUCT_ELECTRICITY = "electricity"
+const PRODUCT_BPJS_KETENAGAKERJAAN = "bpjs_ketenagakerjaan"
+const PRODUCT_PBB = "pbb"
+const PRODUCT_SAMSAT = "samsat"
+const PRODUCT_POSTPAID_MOBILE = "postpaid_mobile"
+const PRODUCT_CABLE_TV = "cable_tv"
+const PRODUCT_MULTIFINANCE = "multifinance"
+
 type Transaction struct {
 	ID            string         `gorm:"primaryKey" json:"id"`
 	UserID        string         `gorm:"index" json:"user_id"`
@@ -26,6 +39,7 @@ type Transaction struct {
 	AdminFee      float64        `gorm:"type:decimal(12)" json:"admin_fee"`
 	Price         float64        `gorm:"type:decimal(12)" json:"price"`
 	TotalPrice    float64        `gorm:"type:decimal(12)" json:"total_price"`
+	ExpiresAt     *time.Time     `json:"expires_at,omitempty"`
 	CreatedAt     time.Time      `json:"created_at"`
 	UpdatedAt     time.Time      `json:"updated_at"`
 	DeletedAt     gorm.DeletedAt `json:"deleted_at"`
@@ -38,12 +52,29 @@ type GetProductDetail struct {
 }
 
 type TransactionPPD struct {
-	Phone       string `json:"phone_number"`
-	Name        string `json:"name"`
-	Code        string `json:"code"`
-	Provider    string `json:"provider"`
-	Description string `json:"description"`
-	DiscountID  string `json:"discount_id"`
+	Phone        string `json:"phone_number"`
+	Name         string `json:"name"`
+	Code         string `json:"code"`
+	Provider     string `json:"provider"`
+	Description  string `json:"description"`
+	DiscountID   string `json:"discount_id"`
+	SerialNumber string `json:"serial_number"`
+	Fulfilment
+}
+
+// Fulfilment tracks a paid purchase while its provider fulfils it.
+// SubmittedAt is set before the purchase is sent to the provider. From then
+// on the provider is only asked for its status, never sent it again.
+type Fulfilment struct {
+	SubmittedAt       *time.Time `json:"submitted_at,omitempty"`
+	ProviderReference string     `json:"provider_reference,omitempty"`
+	Attempts          int        `json:"attempts"`
+	Error             string     `json:"error,omitempty"`
+}
+
+// Tracking gives the fulfilment of the product detail that embeds it.
+func (f *Fulfilment) Tracking() *Fulfilment {
+	return f
 }
 
 type TransactionWifi struct {
@@ -69,10 +100,15 @@ type PayloadMail struct {
 	Amount          string `json:"amount"`
 	Token           string `json:"token"`
 	ElectricalPower int    `json:"electrical_power"`
+	TariffClass     string `json:"tariff_class"`
+	Kwh             string `json:"kwh"`
 	// WIFI
 	WifiBandwith int `json:"wifi_bandwith"`
 	// PPD
-	Phone string `json:"phone"`
+	Phone        string `json:"phone"`
+	SerialNumber string `json:"serial_number"`
+	// PBB & SAMSAT
+	ReferenceNumber string `json:"reference_number"`
 	// =========
 
 	CustomerName  string    `json:"name"`
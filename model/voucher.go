@@ -0,0 +1,92 @@
+package model
+
+const VOUCHER_TYPE_GAME = "game"
+const VOUCHER_TYPE_STREAMING = "streaming"
+
+// Direct vouchers are credited straight to the player's account; code
+// vouchers hand the user a code to redeem themselves.
+const VOUCHER_DELIVERY_DIRECT = "direct"
+const VOUCHER_DELIVERY_CODE = "code"
+
+// PRODUCT_VOUCHER is the transaction product type of game and streaming vouchers.
+const PRODUCT_VOUCHER = "voucher"
+
+const NOTIFICATION_VOUCHER = "voucher"
+
+// VoucherBrand is a game or streaming service. UserIdPattern and
+// ZoneIdPattern describe the account input the brand expects; brands without
+// a ZoneIdLabel take no zone ID.
+type VoucherBrand struct {
+	UUIDPrimaryKey
+	Name          string    `json:"name"`
+	Code          string    `json:"code" gorm:"uniqueIndex"`
+	Type          string    `json:"type"`
+	Delivery      string    `json:"delivery"`
+	UserIdLabel   string    `json:"user_id_label"`
+	UserIdPattern string    `json:"user_id_pattern"`
+	ZoneIdLabel   string    `json:"zone_id_label"`
+	ZoneIdPattern string    `json:"zone_id_pattern"`
+	IsActive      *bool     `json:"is_active" gorm:"default:true"`
+	Vouchers      []Voucher `json:"vouchers,omitempty" gorm:"foreignKey:BrandID;constraint:OnDelete:CASCADE"`
+}
+
+// Voucher is a denomination of a brand, e.g. 86 Diamonds or a one month plan.
+type Voucher struct {
+	UUIDPrimaryKey
+	BrandID     string  `json:"brand_id" gorm:"type:uuid;index"`
+	Name        string  `json:"name"`
+	Code        string  `json:"code" gorm:"uniqueIndex"`
+	Price       float64 `json:"price"`
+	IsActive    *bool   `json:"is_active" gorm:"default:true"`
+	Description string  `json:"description"`
+}
+
+type VoucherInputField struct {
+	Name    string `json:"name"`
+	Label   string `json:"label"`
+	Pattern string `json:"pattern"`
+}
+
+type VoucherBrandResponse struct {
+	ID       string              `json:"id"`
+	Name     string              `json:"name"`
+	Code     string              `json:"code"`
+	Type     string              `json:"type"`
+	Delivery string              `json:"delivery"`
+	Inputs   []VoucherInputField `json:"inputs"`
+	IsActive *bool               `json:"is_active"`
+	Vouchers []Voucher           `json:"vouchers,omitempty"`
+}
+
+// VoucherAccount is the player returned by a brand's nickname lookup.
+type VoucherAccount struct {
+	UserId   string `json:"user_id"`
+	ZoneId   string `json:"zone_id,omitempty"`
+	Nickname string `json:"nickname"`
+}
+
+// VoucherPurchaseResult is a provider's answer to a voucher purchase. Code
+// is only set for code vouchers.
+type VoucherPurchaseResult struct {
+	Status          string `json:"status"`
+	ReferenceNumber string `json:"reference_number"`
+	Code            string `json:"code"`
+	Message         string `json:"message"`
+}
+
+// TransactionVoucher is stored with VoucherCode encrypted; it is only
+// decrypted when the owner reads the transaction.
+type TransactionVoucher struct {
+	Brand           string `json:"brand"`
+	BrandCode       string `json:"brand_code"`
+	Name            string `json:"name"`
+	Code            string `json:"code"`
+	Delivery        string `json:"delivery"`
+	UserId          string `json:"user_id,omitempty"`
+	ZoneId          string `json:"zone_id,omitempty"`
+	Nickname        string `json:"nickname,omitempty"`
+	DiscountID      string `json:"discount_id"`
+	ReferenceNumber string `json:"reference_number"`
+	VoucherCode     string `json:"voucher_code,omitempty"`
+	Fulfilment
+}
@@ -10,5 +10,7 @@ type Wifi struct {
 	Code         string  `gorm:"type:varchar(100)" json:"code"`
 	DiscountId   string  `gorm:"type:varchar(100)" json:"discount_id"`
 	WifiBandwith int     `gorm:"type:int" json:"wifi_bandwith"`
+	PlanCode     string  `gorm:"type:varchar(50)" json:"plan_code"`
+	PlanName     string  `gorm:"type:varchar(100)" json:"plan_name"`
 	Price        float64 `gorm:"type:decimal(12)" json:"price"`
 }
@@ -13,6 +13,7 @@ type InsuranceRepository interface {
 	GetInsuranceyIdRepository(insuranceId string) (*model.Insurance, error)
 	GetInsuranceByPeriodRepository(customerID, period string) (*model.Insurance, error)
 	GetAllInsuranceRepository(page, limit int) ([]*model.Insurance, error)
+	GetInsuranceCatalogRepository() ([]*model.Insurance, error)
 	UpdateInsuranceByIdRepository(insuranceId string, insurance *model.Insurance) (*model.Insurance, error)
 	DeleteInsuranceByIdRepository(insuranceId string) error
 }
@@ -53,7 +54,7 @@ func (r *insuranceRepository) GetInsuranceyIdRepository(insuranceId string) (*mo
 func (r *insuranceRepository) GetAllInsuranceRepository(page, limit int) ([]*model.Insurance, error) {
 	var insurance []*model.Insurance
 	offSet := (page - 1) * limit
-	result := r.db.Offset(offSet).Limit(limit).Find(&insurance)
+	result := r.db.Where("is_active = ?", true).Offset(offSet).Limit(limit).Find(&insurance)
 	if result.Error != nil {
 		return nil, errors.New("failed to get insurance")
 	}
@@ -61,6 +62,18 @@ func (r *insuranceRepository) GetAllInsuranceRepository(page, limit int) ([]*mod
 	return insurance, nil
 }
 
+// GetInsuranceCatalogRepository returns the insurance products the catalog sync manages,
+// which are the ones with a provider code.
+func (r *insuranceRepository) GetInsuranceCatalogRepository() ([]*model.Insurance, error) {
+	var insurance []*model.Insurance
+
+	if err := r.db.Where("code <> ''").Order("code ASC").Find(&insurance).Error; err != nil {
+		return nil, fmt.Errorf("error getting insurance catalog: %s", err)
+	}
+
+	return insurance, nil
+}
+
 func (r *insuranceRepository) GetInsuranceByPeriodRepository(customerID, period string) (*model.Insurance, error) {
 	var insurance model.Insurance
 
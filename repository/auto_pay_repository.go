@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type AutoPayRepository interface {
+	CreateAutoPayRepository(subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error)
+	GetAutoPayByIdRepository(id string) (*model.AutoPaySubscription, error)
+	GetAutoPayByCustomerIdRepository(userID, productID, customerID string) (*model.AutoPaySubscription, error)
+	GetAutoPaysByUserIdRepository(userID, status string, page, limit int) ([]*model.AutoPaySubscription, error)
+	GetDueAutoPaysRepository(now time.Time) ([]*model.AutoPaySubscription, error)
+	UpdateAutoPayByIdRepository(id string, subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error)
+}
+
+type autoPayRepository struct {
+	db *gorm.DB
+}
+
+func NewAutoPayRepository(db *gorm.DB) *autoPayRepository {
+	return &autoPayRepository{db}
+}
+
+func (r *autoPayRepository) CreateAutoPayRepository(subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error) {
+	result := r.db.Create(subscription)
+	if result.Error != nil {
+		return nil, errors.New("failed to create auto-pay subscription")
+	}
+
+	return subscription, nil
+}
+
+func (r *autoPayRepository) GetAutoPayByIdRepository(id string) (*model.AutoPaySubscription, error) {
+	var subscription model.AutoPaySubscription
+
+	result := r.db.First(&subscription, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("auto-pay subscription with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting auto-pay subscription with ID %s: %s", id, result.Error)
+	}
+
+	return &subscription, nil
+}
+
+func (r *autoPayRepository) GetAutoPayByCustomerIdRepository(userID, productID, customerID string) (*model.AutoPaySubscription, error) {
+	var subscription model.AutoPaySubscription
+
+	result := r.db.Where("user_id = ? AND product_id = ? AND customer_id = ? AND status <> ?", userID, productID, customerID, model.SCHEDULE_STATUS_CANCELLED).First(&subscription)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting auto-pay subscription: %s", result.Error)
+	}
+
+	return &subscription, nil
+}
+
+func (r *autoPayRepository) GetAutoPaysByUserIdRepository(userID, status string, page, limit int) ([]*model.AutoPaySubscription, error) {
+	var subscriptions []*model.AutoPaySubscription
+
+	offset := (page - 1) * limit
+
+	query := r.db.Where("user_id = ?", userID).Offset(offset).Limit(limit)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	result := query.Order("created_at DESC").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting auto-pay subscriptions: %s", result.Error)
+	}
+
+	return subscriptions, nil
+}
+
+func (r *autoPayRepository) GetDueAutoPaysRepository(now time.Time) ([]*model.AutoPaySubscription, error) {
+	var subscriptions []*model.AutoPaySubscription
+
+	result := r.db.Where("status = ? AND next_run_at <= ?", model.SCHEDULE_STATUS_ACTIVE, now).Order("next_run_at ASC").Find(&subscriptions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting due auto-pay subscriptions: %s", result.Error)
+	}
+
+	return subscriptions, nil
+}
+
+func (r *autoPayRepository) UpdateAutoPayByIdRepository(id string, subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error) {
+	result := r.db.Model(&model.AutoPaySubscription{}).Where("id = ?", id).Select("*").Omit("id", "created_at").Updates(subscription)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("auto-pay subscription not found")
+	}
+
+	return subscription, nil
+}
@@ -0,0 +1,42 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type BillReminderRepository interface {
+	CreateBillReminderRepository(reminder *model.BillReminder) (*model.BillReminder, error)
+	IsBillReminderSentRepository(savedBillerID, period, kind string) (bool, error)
+}
+
+type billReminderRepository struct {
+	db *gorm.DB
+}
+
+func NewBillReminderRepository(db *gorm.DB) *billReminderRepository {
+	return &billReminderRepository{db}
+}
+
+func (r *billReminderRepository) CreateBillReminderRepository(reminder *model.BillReminder) (*model.BillReminder, error) {
+	result := r.db.Create(reminder)
+	if result.Error != nil {
+		return nil, errors.New("failed to create bill reminder")
+	}
+
+	return reminder, nil
+}
+
+func (r *billReminderRepository) IsBillReminderSentRepository(savedBillerID, period, kind string) (bool, error) {
+	var count int64
+
+	result := r.db.Model(&model.BillReminder{}).Where("saved_biller_id = ? AND period = ? AND kind = ?", savedBillerID, period, kind).Count(&count)
+	if result.Error != nil {
+		return false, fmt.Errorf("error checking bill reminder: %s", result.Error)
+	}
+
+	return count > 0, nil
+}
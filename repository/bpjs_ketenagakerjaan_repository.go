@@ -0,0 +1,99 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type BpjsKetenagakerjaanRepository interface {
+	CreateBpjsKetenagakerjaanRepository(bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)
+	GetBpjsKetenagakerjaanByIdRepository(id string) (*model.BpjsKetenagakerjaan, error)
+	GetBpjsKetenagakerjaanByTypeRepository(productType string) (*model.BpjsKetenagakerjaan, error)
+	GetAllBpjsKetenagakerjaanRepository(page, limit int) ([]*model.BpjsKetenagakerjaan, error)
+	UpdateBpjsKetenagakerjaanByIdRepository(id string, bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)
+	DeleteBpjsKetenagakerjaanByIdRepository(id string) error
+}
+
+type bpjsKetenagakerjaanRepository struct {
+	db *gorm.DB
+}
+
+func NewBpjsKetenagakerjaanRepository(db *gorm.DB) *bpjsKetenagakerjaanRepository {
+	return &bpjsKetenagakerjaanRepository{db}
+}
+
+func (r *bpjsKetenagakerjaanRepository) CreateBpjsKetenagakerjaanRepository(bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	result := r.db.Create(bpjs)
+	if result.Error != nil {
+		return nil, errors.New("failed to create bpjs ketenagakerjaan")
+	}
+
+	return bpjs, nil
+}
+
+func (r *bpjsKetenagakerjaanRepository) GetBpjsKetenagakerjaanByIdRepository(id string) (*model.BpjsKetenagakerjaan, error) {
+	var bpjs model.BpjsKetenagakerjaan
+
+	result := r.db.First(&bpjs, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("bpjs ketenagakerjaan with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting bpjs ketenagakerjaan with ID %s: %s", id, result.Error)
+	}
+
+	return &bpjs, nil
+}
+
+func (r *bpjsKetenagakerjaanRepository) GetBpjsKetenagakerjaanByTypeRepository(productType string) (*model.BpjsKetenagakerjaan, error) {
+	var bpjs model.BpjsKetenagakerjaan
+
+	result := r.db.First(&bpjs, "type = ?", productType)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting bpjs ketenagakerjaan %s: %s", productType, result.Error)
+	}
+
+	return &bpjs, nil
+}
+
+func (r *bpjsKetenagakerjaanRepository) GetAllBpjsKetenagakerjaanRepository(page, limit int) ([]*model.BpjsKetenagakerjaan, error) {
+	var bpjs []*model.BpjsKetenagakerjaan
+
+	offset := (page - 1) * limit
+	result := r.db.Offset(offset).Limit(limit).Order("price ASC").Find(&bpjs)
+	if result.Error != nil {
+		return nil, errors.New("failed to get bpjs ketenagakerjaan")
+	}
+
+	return bpjs, nil
+}
+
+func (r *bpjsKetenagakerjaanRepository) UpdateBpjsKetenagakerjaanByIdRepository(id string, bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	result := r.db.Model(&model.BpjsKetenagakerjaan{}).Where("id = ?", id).Updates(bpjs)
+	if result.Error != nil {
+		return nil, errors.New("failed to update bpjs ketenagakerjaan")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("bpjs ketenagakerjaan not found")
+	}
+
+	return bpjs, nil
+}
+
+func (r *bpjsKetenagakerjaanRepository) DeleteBpjsKetenagakerjaanByIdRepository(id string) error {
+	result := r.db.Delete(&model.BpjsKetenagakerjaan{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("bpjs ketenagakerjaan not found")
+	}
+
+	return nil
+}
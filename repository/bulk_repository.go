@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+// BulkRepository reads and writes whole catalog tables for admin uploads.
+type BulkRepository interface {
+	FindAllRepository(records interface{}) error
+	SaveAllRepository(records []interface{}) error
+}
+
+type bulkRepository struct {
+	db *gorm.DB
+}
+
+func NewBulkRepository(db *gorm.DB) *bulkRepository {
+	return &bulkRepository{db}
+}
+
+// FindAllRepository loads every row of the table behind records, a pointer
+// to a slice of models.
+func (r *bulkRepository) FindAllRepository(records interface{}) error {
+	if err := r.db.Order("created_at ASC").Find(records).Error; err != nil {
+		return fmt.Errorf("error getting catalog: %s", err)
+	}
+
+	return nil
+}
+
+// SaveAllRepository saves model pointers in one transaction. Records with an
+// ID are updated and the rest are created; associations are left alone.
+func (r *bulkRepository) SaveAllRepository(records []interface{}) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		for i, record := range records {
+			if err := tx.Omit(clause.Associations).Save(record).Error; err != nil {
+				return fmt.Errorf("failed to save record %d: %s", i+1, err)
+			}
+		}
+		return nil
+	})
+}
@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type CartRepository interface {
+	CreateCartRepository(cart *model.Cart) (*model.Cart, error)
+	GetOpenCartByUserIdRepository(userID string) (*model.Cart, error)
+	GetCartByIdRepository(id string) (*model.Cart, error)
+	UpdateCartRepository(cart *model.Cart) (*model.Cart, error)
+	UpdateCartStatusRepository(id, from, to string) (bool, error)
+	CreateCartItemRepository(item *model.CartItem) (*model.CartItem, error)
+	UpdateCartItemRepository(item *model.CartItem) (*model.CartItem, error)
+	DeleteCartItemRepository(cartID, itemID string) error
+}
+
+type cartRepository struct {
+	db *gorm.DB
+}
+
+func NewCartRepository(db *gorm.DB) *cartRepository {
+	return &cartRepository{db}
+}
+
+func (r *cartRepository) CreateCartRepository(cart *model.Cart) (*model.Cart, error) {
+	result := r.db.Create(cart)
+	if result.Error != nil {
+		return nil, errors.New("failed to create cart")
+	}
+
+	return cart, nil
+}
+
+func (r *cartRepository) GetOpenCartByUserIdRepository(userID string) (*model.Cart, error) {
+	var cart model.Cart
+
+	result := r.db.Preload("Items").Where("user_id = ? AND status = ?", userID, model.CART_STATUS_OPEN).First(&cart)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting cart: %s", result.Error)
+	}
+
+	return &cart, nil
+}
+
+func (r *cartRepository) GetCartByIdRepository(id string) (*model.Cart, error) {
+	var cart model.Cart
+
+	result := r.db.Preload("Items").First(&cart, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("cart with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting cart with ID %s: %s", id, result.Error)
+	}
+
+	return &cart, nil
+}
+
+func (r *cartRepository) UpdateCartRepository(cart *model.Cart) (*model.Cart, error) {
+	result := r.db.Model(&model.Cart{}).Where("id = ?", cart.ID).Select("*").Omit("id", "created_at", "Items").Updates(cart)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("cart not found")
+	}
+
+	return cart, nil
+}
+
+// UpdateCartStatusRepository moves a cart from status from to status to. It
+// reports false when the cart is no longer in status from.
+func (r *cartRepository) UpdateCartStatusRepository(id, from, to string) (bool, error) {
+	result := r.db.Model(&model.Cart{}).Where("id = ? AND status = ?", id, from).Update("status", to)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+func (r *cartRepository) CreateCartItemRepository(item *model.CartItem) (*model.CartItem, error) {
+	result := r.db.Create(item)
+	if result.Error != nil {
+		return nil, errors.New("failed to add cart item")
+	}
+
+	return item, nil
+}
+
+func (r *cartRepository) UpdateCartItemRepository(item *model.CartItem) (*model.CartItem, error) {
+	result := r.db.Model(&model.CartItem{}).Where("id = ?", item.ID).Select("*").Omit("id", "created_at").Updates(item)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("cart item not found")
+	}
+
+	return item, nil
+}
+
+func (r *cartRepository) DeleteCartItemRepository(cartID, itemID string) error {
+	result := r.db.Delete(&model.CartItem{}, "id = ? AND cart_id = ?", itemID, cartID)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("cart item not found")
+	}
+
+	return nil
+}
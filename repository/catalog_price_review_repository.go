@@ -0,0 +1,97 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type CatalogPriceReviewRepository interface {
+	CreatePriceReviewRepository(review *model.CatalogPriceReview) (*model.CatalogPriceReview, error)
+	GetPriceReviewByIdRepository(id string) (*model.CatalogPriceReview, error)
+	GetLatestPriceReviewRepository(catalog, code string) (*model.CatalogPriceReview, error)
+	GetAllPriceReviewRepository(catalog, status string, page, limit int) ([]*model.CatalogPriceReview, error)
+	UpdatePriceReviewByIdRepository(id string, review *model.CatalogPriceReview) (*model.CatalogPriceReview, error)
+}
+
+type catalogPriceReviewRepository struct {
+	db *gorm.DB
+}
+
+func NewCatalogPriceReviewRepository(db *gorm.DB) *catalogPriceReviewRepository {
+	return &catalogPriceReviewRepository{db}
+}
+
+func (r *catalogPriceReviewRepository) CreatePriceReviewRepository(review *model.CatalogPriceReview) (*model.CatalogPriceReview, error) {
+	result := r.db.Create(review)
+	if result.Error != nil {
+		return nil, errors.New("failed to create price review")
+	}
+
+	return review, nil
+}
+
+func (r *catalogPriceReviewRepository) GetPriceReviewByIdRepository(id string) (*model.CatalogPriceReview, error) {
+	var review model.CatalogPriceReview
+
+	result := r.db.First(&review, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("price review with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting price review with ID %s: %s", id, result.Error)
+	}
+
+	return &review, nil
+}
+
+// GetLatestPriceReviewRepository returns the most recent review of a product,
+// or nil when its cost price has never changed.
+func (r *catalogPriceReviewRepository) GetLatestPriceReviewRepository(catalog, code string) (*model.CatalogPriceReview, error) {
+	var review model.CatalogPriceReview
+
+	result := r.db.Where("catalog = ? AND code = ?", catalog, code).Order("created_at DESC").First(&review)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting price review of %s: %s", code, result.Error)
+	}
+
+	return &review, nil
+}
+
+func (r *catalogPriceReviewRepository) GetAllPriceReviewRepository(catalog, status string, page, limit int) ([]*model.CatalogPriceReview, error) {
+	var reviews []*model.CatalogPriceReview
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if catalog != "" {
+		query = query.Where("catalog = ?", catalog)
+	}
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	result := query.Order("created_at DESC").Find(&reviews)
+	if result.Error != nil {
+		return nil, errors.New("failed to get price reviews")
+	}
+
+	return reviews, nil
+}
+
+func (r *catalogPriceReviewRepository) UpdatePriceReviewByIdRepository(id string, review *model.CatalogPriceReview) (*model.CatalogPriceReview, error) {
+	result := r.db.Model(&model.CatalogPriceReview{}).Where("id = ?", id).Updates(review)
+	if result.Error != nil {
+		return nil, errors.New("failed to update price review")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("price review not found")
+	}
+
+	return review, nil
+}
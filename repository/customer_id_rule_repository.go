@@ -0,0 +1,121 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type CustomerIdRuleRepository interface {
+	CreateCustomerIdRuleRepository(rule *model.CustomerIdRule) (*model.CustomerIdRule, error)
+	GetCustomerIdRuleByIdRepository(id string) (*model.CustomerIdRule, error)
+	GetCustomerIdRuleRepository(product, provider string) (*model.CustomerIdRule, error)
+	GetCustomerIdRulesByProductRepository(product string) ([]model.CustomerIdRule, error)
+	GetAllCustomerIdRuleRepository(product string, page, limit int) ([]*model.CustomerIdRule, error)
+	UpdateCustomerIdRuleByIdRepository(id string, rule *model.CustomerIdRule) (*model.CustomerIdRule, error)
+	DeleteCustomerIdRuleByIdRepository(id string) error
+}
+
+type customerIdRuleRepository struct {
+	db *gorm.DB
+}
+
+func NewCustomerIdRuleRepository(db *gorm.DB) *customerIdRuleRepository {
+	return &customerIdRuleRepository{db}
+}
+
+func (r *customerIdRuleRepository) CreateCustomerIdRuleRepository(rule *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	result := r.db.Create(rule)
+	if result.Error != nil {
+		return nil, errors.New("failed to create customer ID rule")
+	}
+
+	return rule, nil
+}
+
+func (r *customerIdRuleRepository) GetCustomerIdRuleByIdRepository(id string) (*model.CustomerIdRule, error) {
+	var rule model.CustomerIdRule
+
+	result := r.db.First(&rule, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("customer ID rule with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting customer ID rule with ID %s: %s", id, result.Error)
+	}
+
+	return &rule, nil
+}
+
+func (r *customerIdRuleRepository) GetCustomerIdRuleRepository(product, provider string) (*model.CustomerIdRule, error) {
+	var rule model.CustomerIdRule
+
+	result := r.db.First(&rule, "product = ? AND provider = ?", product, provider)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting customer ID rule for %s %s: %s", product, provider, result.Error)
+	}
+
+	return &rule, nil
+}
+
+func (r *customerIdRuleRepository) GetCustomerIdRulesByProductRepository(product string) ([]model.CustomerIdRule, error) {
+	var rules []model.CustomerIdRule
+
+	result := r.db.Where("product = ?", product).Order("provider ASC").Find(&rules)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting customer ID rules for %s: %s", product, result.Error)
+	}
+
+	return rules, nil
+}
+
+func (r *customerIdRuleRepository) GetAllCustomerIdRuleRepository(product string, page, limit int) ([]*model.CustomerIdRule, error) {
+	var rules []*model.CustomerIdRule
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+
+	result := query.Order("product ASC").Order("provider ASC").Find(&rules)
+	if result.Error != nil {
+		return nil, errors.New("failed to get customer ID rules")
+	}
+
+	return rules, nil
+}
+
+// UpdateCustomerIdRuleByIdRepository saves every field of the rule, so checks
+// can be switched off by zeroing them.
+func (r *customerIdRuleRepository) UpdateCustomerIdRuleByIdRepository(id string, rule *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	result := r.db.Model(&model.CustomerIdRule{}).Where("id = ?", id).
+		Select("min_length", "max_length", "numeric_only", "prefixes", "checksum", "description", "updated_at").
+		Updates(rule)
+	if result.Error != nil {
+		return nil, errors.New("failed to update customer ID rule")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("customer ID rule not found")
+	}
+
+	return rule, nil
+}
+
+func (r *customerIdRuleRepository) DeleteCustomerIdRuleByIdRepository(id string) error {
+	result := r.db.Delete(&model.CustomerIdRule{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("customer ID rule not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,65 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type EMoneyProviderRepository interface {
+	AccountInquiryRepository(provider, accountNumber string) (*model.EMoneyAccount, error)
+	TopUpRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error)
+	TopUpStatusRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error)
+}
+
+// eMoneySandboxRepository stands in for the wallet providers until they are
+// integrated. Like the Oy sandbox, account numbers ending in 9 do not exist;
+// top-ups are settled by sandboxStatus.
+type eMoneySandboxRepository struct{}
+
+var sandboxAccountNames = []string{"Budi Santoso", "Siti Rahayu", "Agus Setiawan", "Dewi Lestari", "Rizky Pratama", "Nur Aini"}
+
+func NewEMoneySandboxRepository() EMoneyProviderRepository {
+	return &eMoneySandboxRepository{}
+}
+
+func (*eMoneySandboxRepository) AccountInquiryRepository(provider, accountNumber string) (*model.EMoneyAccount, error) {
+	if strings.HasSuffix(accountNumber, "9") {
+		return nil, fmt.Errorf("%s account %s not found", provider, accountNumber)
+	}
+
+	return &model.EMoneyAccount{
+		Provider:      provider,
+		AccountNumber: accountNumber,
+		AccountName:   sandboxPick(sandboxAccountNames, provider+accountNumber),
+	}, nil
+}
+
+func (r *eMoneySandboxRepository) TopUpRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error) {
+	if transactionID == "" {
+		return nil, errors.New("transaction ID is required")
+	}
+
+	return r.TopUpStatusRepository(transactionID, detail)
+}
+
+// TopUpStatusRepository looks a top-up up by the transaction ID it was sent
+// with.
+func (*eMoneySandboxRepository) TopUpStatusRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error) {
+	result := &model.EMoneyTopUpResult{
+		Status:            sandboxStatus(detail.AccountNumber),
+		ProviderReference: sandboxNumber(transactionID, "emoney", 16),
+	}
+
+	switch result.Status {
+	case model.STATUS_PROCESSING:
+		result.Message = "top-up is pending at the provider"
+	case model.STATUS_FAIL:
+		result.Message = "top-up rejected by the provider"
+	default:
+		result.ReferenceNumber = sandboxNumber(transactionID, "reference", 12)
+	}
+
+	return result, nil
+}
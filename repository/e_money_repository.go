@@ -0,0 +1,95 @@
+package repository
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type EMoneyRepository interface {
+	CreateEMoney(data model.EMoney) (model.EMoney, error)
+	GetAllEMoney(data dto.EMoneyDto, isUser *bool) ([]model.EMoney, error)
+	GetEMoneyById(id string) (model.EMoney, error)
+	UpdateEMoneyById(id string, data model.EMoney) error
+	DeleteEMoneyById(id string) error
+}
+
+type eMoneyRepository struct {
+	db *gorm.DB
+}
+
+func NewEMoneyRepository(db *gorm.DB) *eMoneyRepository {
+	return &eMoneyRepository{db}
+}
+
+func (r *eMoneyRepository) CreateEMoney(data model.EMoney) (model.EMoney, error) {
+	var count int64
+
+	r.db.Model(&model.EMoney{}).Where("code = ?", data.Code).Count(&count)
+	if count > 0 {
+		return model.EMoney{}, errors.New("code already exists")
+	}
+
+	if err := r.db.Create(&data).Error; err != nil {
+		return model.EMoney{}, err
+	}
+
+	return data, nil
+}
+
+func (r *eMoneyRepository) GetAllEMoney(data dto.EMoneyDto, isUser *bool) ([]model.EMoney, error) {
+	var emoney []model.EMoney
+	offset := (data.Page - 1) * data.Limit
+
+	query := r.db.Where("type LIKE ? AND provider LIKE ?", "%"+data.Type+"%", "%"+data.Provider+"%")
+	if isUser != nil {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Order("price ASC").Offset(offset).Limit(data.Limit).Find(&emoney).Error; err != nil {
+		return emoney, fmt.Errorf("error getting e-money: %s", err)
+	}
+
+	return emoney, nil
+}
+
+func (r *eMoneyRepository) GetEMoneyById(id string) (model.EMoney, error) {
+	var emoney model.EMoney
+
+	result := r.db.First(&emoney, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return emoney, fmt.Errorf("e-money with ID %s not found", id)
+		}
+		return emoney, fmt.Errorf("error getting e-money with ID %s: %s", id, result.Error)
+	}
+
+	return emoney, nil
+}
+
+func (r *eMoneyRepository) UpdateEMoneyById(id string, data model.EMoney) error {
+	result := r.db.Model(&model.EMoney{}).Where("id = ?", id).Updates(&data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("e-money not found")
+	}
+
+	return nil
+}
+
+func (r *eMoneyRepository) DeleteEMoneyById(id string) error {
+	result := r.db.Delete(&model.EMoney{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("e-money not found")
+	}
+
+	return nil
+}
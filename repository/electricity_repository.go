@@ -12,6 +12,7 @@ type ElectricityRepository interface {
 	CreateElectricityRepository(electricity *model.Electricity) (*model.Electricity, error)
 	GetElectricityByIdRepository(electricityId string) (*model.Electricity, error)
 	GetAllElectricityRepository(page, limit int) ([]*model.Electricity, error)
+	GetElectricityCatalogRepository() ([]*model.Electricity, error)
 	GetElectricityByPeriodRepository(customerID, period string) (*model.Electricity, error)
 	UpdateElectricityByIdRepository(electricityId string, electricity *model.Electricity) (*model.Electricity, error)
 	DeleteElectricityByIdRepository(electricityId string) error
@@ -53,7 +54,7 @@ func (r *electricityRepository) GetElectricityByIdRepository(electricityId strin
 func (r *electricityRepository) GetAllElectricityRepository(page, limit int) ([]*model.Electricity, error) {
 	var electricity []*model.Electricity
 	offSet := (page - 1) * limit
-	result := r.db.Offset(offSet).Limit(limit).Find(&electricity)
+	result := r.db.Where("is_active = ?", true).Offset(offSet).Limit(limit).Find(&electricity)
 	if result.Error != nil {
 		return nil, errors.New("failed to get electricity")
 	}
@@ -61,6 +62,18 @@ func (r *electricityRepository) GetAllElectricityRepository(page, limit int) ([]
 	return electricity, nil
 }
 
+// GetElectricityCatalogRepository returns the electricity products the catalog sync manages,
+// which are the ones with a provider code.
+func (r *electricityRepository) GetElectricityCatalogRepository() ([]*model.Electricity, error) {
+	var electricity []*model.Electricity
+
+	if err := r.db.Where("code <> ''").Order("code ASC").Find(&electricity).Error; err != nil {
+		return nil, fmt.Errorf("error getting electricity catalog: %s", err)
+	}
+
+	return electricity, nil
+}
+
 func (r *electricityRepository) GetElectricityByPeriodRepository(customerID, period string) (*model.Electricity, error) {
 	var electricity model.Electricity
 
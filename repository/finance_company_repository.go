@@ -0,0 +1,100 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type FinanceCompanyRepository interface {
+	CreateFinanceCompanyRepository(company *model.FinanceCompany) (*model.FinanceCompany, error)
+	GetFinanceCompanyByIdRepository(id string) (*model.FinanceCompany, error)
+	GetFinanceCompanyByCodeRepository(code string) (*model.FinanceCompany, error)
+	GetAllFinanceCompanyRepository(page, limit int) ([]*model.FinanceCompany, error)
+	UpdateFinanceCompanyByIdRepository(id string, company *model.FinanceCompany) (*model.FinanceCompany, error)
+	DeleteFinanceCompanyByIdRepository(id string) error
+}
+
+type financeCompanyRepository struct {
+	db *gorm.DB
+}
+
+func NewFinanceCompanyRepository(db *gorm.DB) *financeCompanyRepository {
+	return &financeCompanyRepository{db}
+}
+
+func (r *financeCompanyRepository) CreateFinanceCompanyRepository(company *model.FinanceCompany) (*model.FinanceCompany, error) {
+	result := r.db.Create(company)
+	if result.Error != nil {
+		return nil, errors.New("failed to create finance company")
+	}
+
+	return company, nil
+}
+
+func (r *financeCompanyRepository) GetFinanceCompanyByIdRepository(id string) (*model.FinanceCompany, error) {
+	var company model.FinanceCompany
+
+	result := r.db.First(&company, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("finance company with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting finance company with ID %s: %s", id, result.Error)
+	}
+
+	return &company, nil
+}
+
+func (r *financeCompanyRepository) GetFinanceCompanyByCodeRepository(code string) (*model.FinanceCompany, error) {
+	var company model.FinanceCompany
+
+	result := r.db.First(&company, "code = ?", code)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting finance company %s: %s", code, result.Error)
+	}
+
+	return &company, nil
+}
+
+func (r *financeCompanyRepository) GetAllFinanceCompanyRepository(page, limit int) ([]*model.FinanceCompany, error) {
+	var companies []*model.FinanceCompany
+
+	offset := (page - 1) * limit
+
+	result := r.db.Offset(offset).Limit(limit).Order("name ASC").Find(&companies)
+	if result.Error != nil {
+		return nil, errors.New("failed to get finance companies")
+	}
+
+	return companies, nil
+}
+
+func (r *financeCompanyRepository) UpdateFinanceCompanyByIdRepository(id string, company *model.FinanceCompany) (*model.FinanceCompany, error) {
+	result := r.db.Model(&model.FinanceCompany{}).Where("id = ?", id).Updates(company)
+	if result.Error != nil {
+		return nil, errors.New("failed to update finance company")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("finance company not found")
+	}
+
+	return company, nil
+}
+
+func (r *financeCompanyRepository) DeleteFinanceCompanyByIdRepository(id string) error {
+	result := r.db.Delete(&model.FinanceCompany{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("finance company not found")
+	}
+
+	return nil
+}
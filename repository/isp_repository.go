@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type IspRepository interface {
+	CreateIspRepository(isp *model.Isp) (*model.Isp, error)
+	GetIspByIdRepository(id string, isUser *bool) (*model.Isp, error)
+	GetIspByCodeRepository(code string) (*model.Isp, error)
+	GetAllIspRepository(page, limit int, isUser *bool) ([]*model.Isp, error)
+	UpdateIspByIdRepository(id string, isp *model.Isp) (*model.Isp, error)
+	DeleteIspByIdRepository(id string) error
+	CreateIspPlanRepository(plan *model.IspPlan) (*model.IspPlan, error)
+	GetIspPlanByIdRepository(id string) (*model.IspPlan, error)
+	GetIspPlanByCodeRepository(code string) (*model.IspPlan, error)
+	GetAllIspPlanRepository() ([]*model.IspPlan, error)
+	UpdateIspPlanByIdRepository(id string, plan *model.IspPlan) (*model.IspPlan, error)
+	DeleteIspPlanByIdRepository(id string) error
+}
+
+type ispRepository struct {
+	db *gorm.DB
+}
+
+func NewIspRepository(db *gorm.DB) *ispRepository {
+	return &ispRepository{db}
+}
+
+func (r *ispRepository) CreateIspRepository(isp *model.Isp) (*model.Isp, error) {
+	result := r.db.Omit("Plans").Create(isp)
+	if result.Error != nil {
+		return nil, errors.New("failed to create ISP")
+	}
+
+	return isp, nil
+}
+
+func (r *ispRepository) GetIspByIdRepository(id string, isUser *bool) (*model.Isp, error) {
+	var isp model.Isp
+
+	query := r.db.Preload("Plans", func(db *gorm.DB) *gorm.DB {
+		if isUser != nil {
+			db = db.Where("is_active = ?", true)
+		}
+		return db.Order("monthly_price ASC")
+	})
+	if isUser != nil {
+		query = query.Where("is_active = ?", true)
+	}
+
+	result := query.First(&isp, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("ISP with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting ISP with ID %s: %s", id, result.Error)
+	}
+
+	return &isp, nil
+}
+
+// GetIspByCodeRepository returns an active ISP with its active plans.
+func (r *ispRepository) GetIspByCodeRepository(code string) (*model.Isp, error) {
+	var isp model.Isp
+
+	result := r.db.Preload("Plans", func(db *gorm.DB) *gorm.DB {
+		return db.Where("is_active = ?", true).Order("monthly_price ASC")
+	}).Where("is_active = ?", true).First(&isp, "code = ?", code)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting ISP %s: %s", code, result.Error)
+	}
+
+	return &isp, nil
+}
+
+func (r *ispRepository) GetAllIspRepository(page, limit int, isUser *bool) ([]*model.Isp, error) {
+	var isps []*model.Isp
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if isUser != nil {
+		query = query.Where("is_active = ?", true)
+	}
+
+	result := query.Order("name ASC").Find(&isps)
+	if result.Error != nil {
+		return nil, errors.New("failed to get ISPs")
+	}
+
+	return isps, nil
+}
+
+func (r *ispRepository) UpdateIspByIdRepository(id string, isp *model.Isp) (*model.Isp, error) {
+	result := r.db.Model(&model.Isp{}).Where("id = ?", id).Omit("Plans").Updates(isp)
+	if result.Error != nil {
+		return nil, errors.New("failed to update ISP")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("ISP not found")
+	}
+
+	return isp, nil
+}
+
+func (r *ispRepository) DeleteIspByIdRepository(id string) error {
+	result := r.db.Delete(&model.Isp{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("ISP not found")
+	}
+
+	return nil
+}
+
+func (r *ispRepository) CreateIspPlanRepository(plan *model.IspPlan) (*model.IspPlan, error) {
+	result := r.db.Create(plan)
+	if result.Error != nil {
+		return nil, errors.New("failed to create ISP plan")
+	}
+
+	return plan, nil
+}
+
+func (r *ispRepository) GetIspPlanByIdRepository(id string) (*model.IspPlan, error) {
+	var plan model.IspPlan
+
+	result := r.db.First(&plan, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("ISP plan with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting ISP plan with ID %s: %s", id, result.Error)
+	}
+
+	return &plan, nil
+}
+
+func (r *ispRepository) GetIspPlanByCodeRepository(code string) (*model.IspPlan, error) {
+	var plan model.IspPlan
+
+	result := r.db.First(&plan, "code = ?", code)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting ISP plan %s: %s", code, result.Error)
+	}
+
+	return &plan, nil
+}
+
+func (r *ispRepository) GetAllIspPlanRepository() ([]*model.IspPlan, error) {
+	var plans []*model.IspPlan
+
+	result := r.db.Order("code ASC").Find(&plans)
+	if result.Error != nil {
+		return nil, errors.New("failed to get ISP plans")
+	}
+
+	return plans, nil
+}
+
+func (r *ispRepository) UpdateIspPlanByIdRepository(id string, plan *model.IspPlan) (*model.IspPlan, error) {
+	result := r.db.Model(&model.IspPlan{}).Where("id = ?", id).Updates(plan)
+	if result.Error != nil {
+		return nil, errors.New("failed to update ISP plan")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("ISP plan not found")
+	}
+
+	return plan, nil
+}
+
+func (r *ispRepository) DeleteIspPlanByIdRepository(id string) error {
+	result := r.db.Delete(&model.IspPlan{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("ISP plan not found")
+	}
+
+	return nil
+}
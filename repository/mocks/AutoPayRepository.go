@@ -0,0 +1,186 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// AutoPayRepository is an autogenerated mock type for the AutoPayRepository type
+type AutoPayRepository struct {
+	mock.Mock
+}
+
+// CreateAutoPayRepository provides a mock function with given fields: subscription
+func (_m *AutoPayRepository) CreateAutoPayRepository(subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error) {
+	ret := _m.Called(subscription)
+
+	var r0 *model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.AutoPaySubscription) (*model.AutoPaySubscription, error)); ok {
+		return rf(subscription)
+	}
+	if rf, ok := ret.Get(0).(func(*model.AutoPaySubscription) *model.AutoPaySubscription); ok {
+		r0 = rf(subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.AutoPaySubscription) error); ok {
+		r1 = rf(subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAutoPayByCustomerIdRepository provides a mock function with given fields: userID, productID, customerID
+func (_m *AutoPayRepository) GetAutoPayByCustomerIdRepository(userID string, productID string, customerID string) (*model.AutoPaySubscription, error) {
+	ret := _m.Called(userID, productID, customerID)
+
+	var r0 *model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.AutoPaySubscription, error)); ok {
+		return rf(userID, productID, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.AutoPaySubscription); ok {
+		r0 = rf(userID, productID, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(userID, productID, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAutoPayByIdRepository provides a mock function with given fields: id
+func (_m *AutoPayRepository) GetAutoPayByIdRepository(id string) (*model.AutoPaySubscription, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.AutoPaySubscription, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.AutoPaySubscription); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAutoPaysByUserIdRepository provides a mock function with given fields: userID, status, page, limit
+func (_m *AutoPayRepository) GetAutoPaysByUserIdRepository(userID string, status string, page int, limit int) ([]*model.AutoPaySubscription, error) {
+	ret := _m.Called(userID, status, page, limit)
+
+	var r0 []*model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]*model.AutoPaySubscription, error)); ok {
+		return rf(userID, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.AutoPaySubscription); ok {
+		r0 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(userID, status, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueAutoPaysRepository provides a mock function with given fields: now
+func (_m *AutoPayRepository) GetDueAutoPaysRepository(now time.Time) ([]*model.AutoPaySubscription, error) {
+	ret := _m.Called(now)
+
+	var r0 []*model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*model.AutoPaySubscription, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*model.AutoPaySubscription); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateAutoPayByIdRepository provides a mock function with given fields: id, subscription
+func (_m *AutoPayRepository) UpdateAutoPayByIdRepository(id string, subscription *model.AutoPaySubscription) (*model.AutoPaySubscription, error) {
+	ret := _m.Called(id, subscription)
+
+	var r0 *model.AutoPaySubscription
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.AutoPaySubscription) (*model.AutoPaySubscription, error)); ok {
+		return rf(id, subscription)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.AutoPaySubscription) *model.AutoPaySubscription); ok {
+		r0 = rf(id, subscription)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AutoPaySubscription)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.AutoPaySubscription) error); ok {
+		r1 = rf(id, subscription)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewAutoPayRepository creates a new instance of AutoPayRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewAutoPayRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *AutoPayRepository {
+	mock := &AutoPayRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
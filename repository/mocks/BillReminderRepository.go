@@ -0,0 +1,78 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BillReminderRepository is an autogenerated mock type for the BillReminderRepository type
+type BillReminderRepository struct {
+	mock.Mock
+}
+
+// CreateBillReminderRepository provides a mock function with given fields: reminder
+func (_m *BillReminderRepository) CreateBillReminderRepository(reminder *model.BillReminder) (*model.BillReminder, error) {
+	ret := _m.Called(reminder)
+
+	var r0 *model.BillReminder
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.BillReminder) (*model.BillReminder, error)); ok {
+		return rf(reminder)
+	}
+	if rf, ok := ret.Get(0).(func(*model.BillReminder) *model.BillReminder); ok {
+		r0 = rf(reminder)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BillReminder)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.BillReminder) error); ok {
+		r1 = rf(reminder)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsBillReminderSentRepository provides a mock function with given fields: savedBillerID, period, kind
+func (_m *BillReminderRepository) IsBillReminderSentRepository(savedBillerID string, period string, kind string) (bool, error) {
+	ret := _m.Called(savedBillerID, period, kind)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (bool, error)); ok {
+		return rf(savedBillerID, period, kind)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(savedBillerID, period, kind)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(savedBillerID, period, kind)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBillReminderRepository creates a new instance of BillReminderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBillReminderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BillReminderRepository {
+	mock := &BillReminderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// BpjsKetenagakerjaanRepository is an autogenerated mock type for the BpjsKetenagakerjaanRepository type
+type BpjsKetenagakerjaanRepository struct {
+	mock.Mock
+}
+
+// CreateBpjsKetenagakerjaanRepository provides a mock function with given fields: bpjs
+func (_m *BpjsKetenagakerjaanRepository) CreateBpjsKetenagakerjaanRepository(bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	ret := _m.Called(bpjs)
+
+	var r0 *model.BpjsKetenagakerjaan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)); ok {
+		return rf(bpjs)
+	}
+	if rf, ok := ret.Get(0).(func(*model.BpjsKetenagakerjaan) *model.BpjsKetenagakerjaan); ok {
+		r0 = rf(bpjs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BpjsKetenagakerjaan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.BpjsKetenagakerjaan) error); ok {
+		r1 = rf(bpjs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteBpjsKetenagakerjaanByIdRepository provides a mock function with given fields: id
+func (_m *BpjsKetenagakerjaanRepository) DeleteBpjsKetenagakerjaanByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllBpjsKetenagakerjaanRepository provides a mock function with given fields: page, limit
+func (_m *BpjsKetenagakerjaanRepository) GetAllBpjsKetenagakerjaanRepository(page int, limit int) ([]*model.BpjsKetenagakerjaan, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.BpjsKetenagakerjaan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.BpjsKetenagakerjaan, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.BpjsKetenagakerjaan); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.BpjsKetenagakerjaan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBpjsKetenagakerjaanByIdRepository provides a mock function with given fields: id
+func (_m *BpjsKetenagakerjaanRepository) GetBpjsKetenagakerjaanByIdRepository(id string) (*model.BpjsKetenagakerjaan, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.BpjsKetenagakerjaan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.BpjsKetenagakerjaan, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.BpjsKetenagakerjaan); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BpjsKetenagakerjaan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetBpjsKetenagakerjaanByTypeRepository provides a mock function with given fields: productType
+func (_m *BpjsKetenagakerjaanRepository) GetBpjsKetenagakerjaanByTypeRepository(productType string) (*model.BpjsKetenagakerjaan, error) {
+	ret := _m.Called(productType)
+
+	var r0 *model.BpjsKetenagakerjaan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.BpjsKetenagakerjaan, error)); ok {
+		return rf(productType)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.BpjsKetenagakerjaan); ok {
+		r0 = rf(productType)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BpjsKetenagakerjaan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(productType)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateBpjsKetenagakerjaanByIdRepository provides a mock function with given fields: id, bpjs
+func (_m *BpjsKetenagakerjaanRepository) UpdateBpjsKetenagakerjaanByIdRepository(id string, bpjs *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	ret := _m.Called(id, bpjs)
+
+	var r0 *model.BpjsKetenagakerjaan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)); ok {
+		return rf(id, bpjs)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.BpjsKetenagakerjaan) *model.BpjsKetenagakerjaan); ok {
+		r0 = rf(id, bpjs)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.BpjsKetenagakerjaan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.BpjsKetenagakerjaan) error); ok {
+		r1 = rf(id, bpjs)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBpjsKetenagakerjaanRepository creates a new instance of BpjsKetenagakerjaanRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBpjsKetenagakerjaanRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BpjsKetenagakerjaanRepository {
+	mock := &BpjsKetenagakerjaanRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,52 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import mock "github.com/stretchr/testify/mock"
+
+// BulkRepository is an autogenerated mock type for the BulkRepository type
+type BulkRepository struct {
+	mock.Mock
+}
+
+// FindAllRepository provides a mock function with given fields: records
+func (_m *BulkRepository) FindAllRepository(records interface{}) error {
+	ret := _m.Called(records)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(interface{}) error); ok {
+		r0 = rf(records)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveAllRepository provides a mock function with given fields: records
+func (_m *BulkRepository) SaveAllRepository(records []interface{}) error {
+	ret := _m.Called(records)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]interface{}) error); ok {
+		r0 = rf(records)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewBulkRepository creates a new instance of BulkRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBulkRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BulkRepository {
+	mock := &BulkRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
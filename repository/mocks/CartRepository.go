@@ -0,0 +1,222 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CartRepository is an autogenerated mock type for the CartRepository type
+type CartRepository struct {
+	mock.Mock
+}
+
+// CreateCartItemRepository provides a mock function with given fields: item
+func (_m *CartRepository) CreateCartItemRepository(item *model.CartItem) (*model.CartItem, error) {
+	ret := _m.Called(item)
+
+	var r0 *model.CartItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CartItem) (*model.CartItem, error)); ok {
+		return rf(item)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CartItem) *model.CartItem); ok {
+		r0 = rf(item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CartItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CartItem) error); ok {
+		r1 = rf(item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateCartRepository provides a mock function with given fields: cart
+func (_m *CartRepository) CreateCartRepository(cart *model.Cart) (*model.Cart, error) {
+	ret := _m.Called(cart)
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Cart) (*model.Cart, error)); ok {
+		return rf(cart)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Cart) *model.Cart); ok {
+		r0 = rf(cart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Cart) error); ok {
+		r1 = rf(cart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCartItemRepository provides a mock function with given fields: cartID, itemID
+func (_m *CartRepository) DeleteCartItemRepository(cartID string, itemID string) error {
+	ret := _m.Called(cartID, itemID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(cartID, itemID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetCartByIdRepository provides a mock function with given fields: id
+func (_m *CartRepository) GetCartByIdRepository(id string) (*model.Cart, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Cart, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Cart); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOpenCartByUserIdRepository provides a mock function with given fields: userID
+func (_m *CartRepository) GetOpenCartByUserIdRepository(userID string) (*model.Cart, error) {
+	ret := _m.Called(userID)
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Cart, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Cart); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCartItemRepository provides a mock function with given fields: item
+func (_m *CartRepository) UpdateCartItemRepository(item *model.CartItem) (*model.CartItem, error) {
+	ret := _m.Called(item)
+
+	var r0 *model.CartItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CartItem) (*model.CartItem, error)); ok {
+		return rf(item)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CartItem) *model.CartItem); ok {
+		r0 = rf(item)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CartItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CartItem) error); ok {
+		r1 = rf(item)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCartRepository provides a mock function with given fields: cart
+func (_m *CartRepository) UpdateCartRepository(cart *model.Cart) (*model.Cart, error) {
+	ret := _m.Called(cart)
+
+	var r0 *model.Cart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Cart) (*model.Cart, error)); ok {
+		return rf(cart)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Cart) *model.Cart); ok {
+		r0 = rf(cart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Cart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Cart) error); ok {
+		r1 = rf(cart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCartStatusRepository provides a mock function with given fields: id, from, to
+func (_m *CartRepository) UpdateCartStatusRepository(id string, from string, to string) (bool, error) {
+	ret := _m.Called(id, from, to)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (bool, error)); ok {
+		return rf(id, from, to)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) bool); ok {
+		r0 = rf(id, from, to)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(id, from, to)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCartRepository creates a new instance of CartRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCartRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CartRepository {
+	mock := &CartRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,158 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CatalogPriceReviewRepository is an autogenerated mock type for the CatalogPriceReviewRepository type
+type CatalogPriceReviewRepository struct {
+	mock.Mock
+}
+
+// CreatePriceReviewRepository provides a mock function with given fields: review
+func (_m *CatalogPriceReviewRepository) CreatePriceReviewRepository(review *model.CatalogPriceReview) (*model.CatalogPriceReview, error) {
+	ret := _m.Called(review)
+
+	var r0 *model.CatalogPriceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CatalogPriceReview) (*model.CatalogPriceReview, error)); ok {
+		return rf(review)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CatalogPriceReview) *model.CatalogPriceReview); ok {
+		r0 = rf(review)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CatalogPriceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CatalogPriceReview) error); ok {
+		r1 = rf(review)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllPriceReviewRepository provides a mock function with given fields: catalog, status, page, limit
+func (_m *CatalogPriceReviewRepository) GetAllPriceReviewRepository(catalog string, status string, page int, limit int) ([]*model.CatalogPriceReview, error) {
+	ret := _m.Called(catalog, status, page, limit)
+
+	var r0 []*model.CatalogPriceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]*model.CatalogPriceReview, error)); ok {
+		return rf(catalog, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.CatalogPriceReview); ok {
+		r0 = rf(catalog, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CatalogPriceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(catalog, status, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetLatestPriceReviewRepository provides a mock function with given fields: catalog, code
+func (_m *CatalogPriceReviewRepository) GetLatestPriceReviewRepository(catalog string, code string) (*model.CatalogPriceReview, error) {
+	ret := _m.Called(catalog, code)
+
+	var r0 *model.CatalogPriceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.CatalogPriceReview, error)); ok {
+		return rf(catalog, code)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.CatalogPriceReview); ok {
+		r0 = rf(catalog, code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CatalogPriceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(catalog, code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPriceReviewByIdRepository provides a mock function with given fields: id
+func (_m *CatalogPriceReviewRepository) GetPriceReviewByIdRepository(id string) (*model.CatalogPriceReview, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.CatalogPriceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.CatalogPriceReview, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.CatalogPriceReview); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CatalogPriceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePriceReviewByIdRepository provides a mock function with given fields: id, review
+func (_m *CatalogPriceReviewRepository) UpdatePriceReviewByIdRepository(id string, review *model.CatalogPriceReview) (*model.CatalogPriceReview, error) {
+	ret := _m.Called(id, review)
+
+	var r0 *model.CatalogPriceReview
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.CatalogPriceReview) (*model.CatalogPriceReview, error)); ok {
+		return rf(id, review)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.CatalogPriceReview) *model.CatalogPriceReview); ok {
+		r0 = rf(id, review)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CatalogPriceReview)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.CatalogPriceReview) error); ok {
+		r1 = rf(id, review)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCatalogPriceReviewRepository creates a new instance of CatalogPriceReviewRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCatalogPriceReviewRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CatalogPriceReviewRepository {
+	mock := &CatalogPriceReviewRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,198 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// CustomerIdRuleRepository is an autogenerated mock type for the CustomerIdRuleRepository type
+type CustomerIdRuleRepository struct {
+	mock.Mock
+}
+
+// CreateCustomerIdRuleRepository provides a mock function with given fields: rule
+func (_m *CustomerIdRuleRepository) CreateCustomerIdRuleRepository(rule *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	ret := _m.Called(rule)
+
+	var r0 *model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.CustomerIdRule) (*model.CustomerIdRule, error)); ok {
+		return rf(rule)
+	}
+	if rf, ok := ret.Get(0).(func(*model.CustomerIdRule) *model.CustomerIdRule); ok {
+		r0 = rf(rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.CustomerIdRule) error); ok {
+		r1 = rf(rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteCustomerIdRuleByIdRepository provides a mock function with given fields: id
+func (_m *CustomerIdRuleRepository) DeleteCustomerIdRuleByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllCustomerIdRuleRepository provides a mock function with given fields: product, page, limit
+func (_m *CustomerIdRuleRepository) GetAllCustomerIdRuleRepository(product string, page int, limit int) ([]*model.CustomerIdRule, error) {
+	ret := _m.Called(product, page, limit)
+
+	var r0 []*model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.CustomerIdRule, error)); ok {
+		return rf(product, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.CustomerIdRule); ok {
+		r0 = rf(product, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(product, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCustomerIdRuleByIdRepository provides a mock function with given fields: id
+func (_m *CustomerIdRuleRepository) GetCustomerIdRuleByIdRepository(id string) (*model.CustomerIdRule, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.CustomerIdRule, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.CustomerIdRule); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCustomerIdRuleRepository provides a mock function with given fields: product, provider
+func (_m *CustomerIdRuleRepository) GetCustomerIdRuleRepository(product string, provider string) (*model.CustomerIdRule, error) {
+	ret := _m.Called(product, provider)
+
+	var r0 *model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.CustomerIdRule, error)); ok {
+		return rf(product, provider)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.CustomerIdRule); ok {
+		r0 = rf(product, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(product, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetCustomerIdRulesByProductRepository provides a mock function with given fields: product
+func (_m *CustomerIdRuleRepository) GetCustomerIdRulesByProductRepository(product string) ([]model.CustomerIdRule, error) {
+	ret := _m.Called(product)
+
+	var r0 []model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]model.CustomerIdRule, error)); ok {
+		return rf(product)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.CustomerIdRule); ok {
+		r0 = rf(product)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(product)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateCustomerIdRuleByIdRepository provides a mock function with given fields: id, rule
+func (_m *CustomerIdRuleRepository) UpdateCustomerIdRuleByIdRepository(id string, rule *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	ret := _m.Called(id, rule)
+
+	var r0 *model.CustomerIdRule
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.CustomerIdRule) (*model.CustomerIdRule, error)); ok {
+		return rf(id, rule)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.CustomerIdRule) *model.CustomerIdRule); ok {
+		r0 = rf(id, rule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.CustomerIdRule)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.CustomerIdRule) error); ok {
+		r1 = rf(id, rule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewCustomerIdRuleRepository creates a new instance of CustomerIdRuleRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewCustomerIdRuleRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *CustomerIdRuleRepository {
+	mock := &CustomerIdRuleRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
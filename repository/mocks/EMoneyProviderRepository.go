@@ -0,0 +1,106 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EMoneyProviderRepository is an autogenerated mock type for the EMoneyProviderRepository type
+type EMoneyProviderRepository struct {
+	mock.Mock
+}
+
+// AccountInquiryRepository provides a mock function with given fields: provider, accountNumber
+func (_m *EMoneyProviderRepository) AccountInquiryRepository(provider string, accountNumber string) (*model.EMoneyAccount, error) {
+	ret := _m.Called(provider, accountNumber)
+
+	var r0 *model.EMoneyAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.EMoneyAccount, error)); ok {
+		return rf(provider, accountNumber)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.EMoneyAccount); ok {
+		r0 = rf(provider, accountNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EMoneyAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(provider, accountNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopUpRepository provides a mock function with given fields: transactionID, detail
+func (_m *EMoneyProviderRepository) TopUpRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.EMoneyTopUpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionEMoney) (*model.EMoneyTopUpResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionEMoney) *model.EMoneyTopUpResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EMoneyTopUpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionEMoney) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TopUpStatusRepository provides a mock function with given fields: transactionID, detail
+func (_m *EMoneyProviderRepository) TopUpStatusRepository(transactionID string, detail *model.TransactionEMoney) (*model.EMoneyTopUpResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.EMoneyTopUpResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionEMoney) (*model.EMoneyTopUpResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionEMoney) *model.EMoneyTopUpResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.EMoneyTopUpResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionEMoney) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewEMoneyProviderRepository creates a new instance of EMoneyProviderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEMoneyProviderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EMoneyProviderRepository {
+	mock := &EMoneyProviderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,131 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "BE-Golang/dto"
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// EMoneyRepository is an autogenerated mock type for the EMoneyRepository type
+type EMoneyRepository struct {
+	mock.Mock
+}
+
+// CreateEMoney provides a mock function with given fields: data
+func (_m *EMoneyRepository) CreateEMoney(data model.EMoney) (model.EMoney, error) {
+	ret := _m.Called(data)
+
+	var r0 model.EMoney
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.EMoney) (model.EMoney, error)); ok {
+		return rf(data)
+	}
+	if rf, ok := ret.Get(0).(func(model.EMoney) model.EMoney); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Get(0).(model.EMoney)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.EMoney) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteEMoneyById provides a mock function with given fields: id
+func (_m *EMoneyRepository) DeleteEMoneyById(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllEMoney provides a mock function with given fields: data, isUser
+func (_m *EMoneyRepository) GetAllEMoney(data dto.EMoneyDto, isUser *bool) ([]model.EMoney, error) {
+	ret := _m.Called(data, isUser)
+
+	var r0 []model.EMoney
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.EMoneyDto, *bool) ([]model.EMoney, error)); ok {
+		return rf(data, isUser)
+	}
+	if rf, ok := ret.Get(0).(func(dto.EMoneyDto, *bool) []model.EMoney); ok {
+		r0 = rf(data, isUser)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.EMoney)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.EMoneyDto, *bool) error); ok {
+		r1 = rf(data, isUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetEMoneyById provides a mock function with given fields: id
+func (_m *EMoneyRepository) GetEMoneyById(id string) (model.EMoney, error) {
+	ret := _m.Called(id)
+
+	var r0 model.EMoney
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (model.EMoney, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.EMoney); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(model.EMoney)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateEMoneyById provides a mock function with given fields: id, data
+func (_m *EMoneyRepository) UpdateEMoneyById(id string, data model.EMoney) error {
+	ret := _m.Called(id, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.EMoney) error); ok {
+		r0 = rf(id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewEMoneyRepository creates a new instance of EMoneyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewEMoneyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *EMoneyRepository {
+	mock := &EMoneyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
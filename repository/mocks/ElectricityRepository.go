@@ -131,6 +131,32 @@ func (_m *ElectricityRepository) GetElectricityByPeriodRepository(customerID str
 	return r0, r1
 }
 
+// GetElectricityCatalogRepository provides a mock function with given fields:
+func (_m *ElectricityRepository) GetElectricityCatalogRepository() ([]*model.Electricity, error) {
+	ret := _m.Called()
+
+	var r0 []*model.Electricity
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.Electricity, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.Electricity); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Electricity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdateElectricityByIdRepository provides a mock function with given fields: electricityId, electricity
 func (_m *ElectricityRepository) UpdateElectricityByIdRepository(electricityId string, electricity *model.Electricity) (*model.Electricity, error) {
 	ret := _m.Called(electricityId, electricity)
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// FinanceCompanyRepository is an autogenerated mock type for the FinanceCompanyRepository type
+type FinanceCompanyRepository struct {
+	mock.Mock
+}
+
+// CreateFinanceCompanyRepository provides a mock function with given fields: company
+func (_m *FinanceCompanyRepository) CreateFinanceCompanyRepository(company *model.FinanceCompany) (*model.FinanceCompany, error) {
+	ret := _m.Called(company)
+
+	var r0 *model.FinanceCompany
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.FinanceCompany) (*model.FinanceCompany, error)); ok {
+		return rf(company)
+	}
+	if rf, ok := ret.Get(0).(func(*model.FinanceCompany) *model.FinanceCompany); ok {
+		r0 = rf(company)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FinanceCompany)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.FinanceCompany) error); ok {
+		r1 = rf(company)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteFinanceCompanyByIdRepository provides a mock function with given fields: id
+func (_m *FinanceCompanyRepository) DeleteFinanceCompanyByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllFinanceCompanyRepository provides a mock function with given fields: page, limit
+func (_m *FinanceCompanyRepository) GetAllFinanceCompanyRepository(page int, limit int) ([]*model.FinanceCompany, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.FinanceCompany
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.FinanceCompany, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.FinanceCompany); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.FinanceCompany)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFinanceCompanyByCodeRepository provides a mock function with given fields: code
+func (_m *FinanceCompanyRepository) GetFinanceCompanyByCodeRepository(code string) (*model.FinanceCompany, error) {
+	ret := _m.Called(code)
+
+	var r0 *model.FinanceCompany
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.FinanceCompany, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.FinanceCompany); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FinanceCompany)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetFinanceCompanyByIdRepository provides a mock function with given fields: id
+func (_m *FinanceCompanyRepository) GetFinanceCompanyByIdRepository(id string) (*model.FinanceCompany, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.FinanceCompany
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.FinanceCompany, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.FinanceCompany); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FinanceCompany)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateFinanceCompanyByIdRepository provides a mock function with given fields: id, company
+func (_m *FinanceCompanyRepository) UpdateFinanceCompanyByIdRepository(id string, company *model.FinanceCompany) (*model.FinanceCompany, error) {
+	ret := _m.Called(id, company)
+
+	var r0 *model.FinanceCompany
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.FinanceCompany) (*model.FinanceCompany, error)); ok {
+		return rf(id, company)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.FinanceCompany) *model.FinanceCompany); ok {
+		r0 = rf(id, company)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.FinanceCompany)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.FinanceCompany) error); ok {
+		r1 = rf(id, company)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewFinanceCompanyRepository creates a new instance of FinanceCompanyRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewFinanceCompanyRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *FinanceCompanyRepository {
+	mock := &FinanceCompanyRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
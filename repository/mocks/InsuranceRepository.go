@@ -105,6 +105,32 @@ func (_m *InsuranceRepository) GetInsuranceByPeriodRepository(customerID string,
 	return r0, r1
 }
 
+// GetInsuranceCatalogRepository provides a mock function with given fields:
+func (_m *InsuranceRepository) GetInsuranceCatalogRepository() ([]*model.Insurance, error) {
+	ret := _m.Called()
+
+	var r0 []*model.Insurance
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.Insurance, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.Insurance); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Insurance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetInsuranceyIdRepository provides a mock function with given fields: insuranceId
 func (_m *InsuranceRepository) GetInsuranceyIdRepository(insuranceId string) (*model.Insurance, error) {
 	ret := _m.Called(insuranceId)
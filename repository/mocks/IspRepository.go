@@ -0,0 +1,316 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// IspRepository is an autogenerated mock type for the IspRepository type
+type IspRepository struct {
+	mock.Mock
+}
+
+// CreateIspPlanRepository provides a mock function with given fields: plan
+func (_m *IspRepository) CreateIspPlanRepository(plan *model.IspPlan) (*model.IspPlan, error) {
+	ret := _m.Called(plan)
+
+	var r0 *model.IspPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.IspPlan) (*model.IspPlan, error)); ok {
+		return rf(plan)
+	}
+	if rf, ok := ret.Get(0).(func(*model.IspPlan) *model.IspPlan); ok {
+		r0 = rf(plan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IspPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.IspPlan) error); ok {
+		r1 = rf(plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateIspRepository provides a mock function with given fields: isp
+func (_m *IspRepository) CreateIspRepository(isp *model.Isp) (*model.Isp, error) {
+	ret := _m.Called(isp)
+
+	var r0 *model.Isp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Isp) (*model.Isp, error)); ok {
+		return rf(isp)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Isp) *model.Isp); ok {
+		r0 = rf(isp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Isp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Isp) error); ok {
+		r1 = rf(isp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteIspByIdRepository provides a mock function with given fields: id
+func (_m *IspRepository) DeleteIspByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteIspPlanByIdRepository provides a mock function with given fields: id
+func (_m *IspRepository) DeleteIspPlanByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllIspPlanRepository provides a mock function with given fields:
+func (_m *IspRepository) GetAllIspPlanRepository() ([]*model.IspPlan, error) {
+	ret := _m.Called()
+
+	var r0 []*model.IspPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.IspPlan, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.IspPlan); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.IspPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllIspRepository provides a mock function with given fields: page, limit, isUser
+func (_m *IspRepository) GetAllIspRepository(page int, limit int, isUser *bool) ([]*model.Isp, error) {
+	ret := _m.Called(page, limit, isUser)
+
+	var r0 []*model.Isp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, *bool) ([]*model.Isp, error)); ok {
+		return rf(page, limit, isUser)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, *bool) []*model.Isp); ok {
+		r0 = rf(page, limit, isUser)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Isp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, *bool) error); ok {
+		r1 = rf(page, limit, isUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIspByCodeRepository provides a mock function with given fields: code
+func (_m *IspRepository) GetIspByCodeRepository(code string) (*model.Isp, error) {
+	ret := _m.Called(code)
+
+	var r0 *model.Isp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Isp, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Isp); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Isp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIspByIdRepository provides a mock function with given fields: id, isUser
+func (_m *IspRepository) GetIspByIdRepository(id string, isUser *bool) (*model.Isp, error) {
+	ret := _m.Called(id, isUser)
+
+	var r0 *model.Isp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *bool) (*model.Isp, error)); ok {
+		return rf(id, isUser)
+	}
+	if rf, ok := ret.Get(0).(func(string, *bool) *model.Isp); ok {
+		r0 = rf(id, isUser)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Isp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *bool) error); ok {
+		r1 = rf(id, isUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIspPlanByCodeRepository provides a mock function with given fields: code
+func (_m *IspRepository) GetIspPlanByCodeRepository(code string) (*model.IspPlan, error) {
+	ret := _m.Called(code)
+
+	var r0 *model.IspPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.IspPlan, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.IspPlan); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IspPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetIspPlanByIdRepository provides a mock function with given fields: id
+func (_m *IspRepository) GetIspPlanByIdRepository(id string) (*model.IspPlan, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.IspPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.IspPlan, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.IspPlan); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IspPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateIspByIdRepository provides a mock function with given fields: id, isp
+func (_m *IspRepository) UpdateIspByIdRepository(id string, isp *model.Isp) (*model.Isp, error) {
+	ret := _m.Called(id, isp)
+
+	var r0 *model.Isp
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Isp) (*model.Isp, error)); ok {
+		return rf(id, isp)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Isp) *model.Isp); ok {
+		r0 = rf(id, isp)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Isp)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Isp) error); ok {
+		r1 = rf(id, isp)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateIspPlanByIdRepository provides a mock function with given fields: id, plan
+func (_m *IspRepository) UpdateIspPlanByIdRepository(id string, plan *model.IspPlan) (*model.IspPlan, error) {
+	ret := _m.Called(id, plan)
+
+	var r0 *model.IspPlan
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.IspPlan) (*model.IspPlan, error)); ok {
+		return rf(id, plan)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.IspPlan) *model.IspPlan); ok {
+		r0 = rf(id, plan)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.IspPlan)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.IspPlan) error); ok {
+		r1 = rf(id, plan)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewIspRepository creates a new instance of IspRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewIspRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *IspRepository {
+	mock := &IspRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
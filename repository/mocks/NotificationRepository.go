@@ -0,0 +1,146 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationRepository is an autogenerated mock type for the NotificationRepository type
+type NotificationRepository struct {
+	mock.Mock
+}
+
+// CreateNotificationRepository provides a mock function with given fields: notification
+func (_m *NotificationRepository) CreateNotificationRepository(notification *model.Notification) (*model.Notification, error) {
+	ret := _m.Called(notification)
+
+	var r0 *model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Notification) (*model.Notification, error)); ok {
+		return rf(notification)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Notification) *model.Notification); ok {
+		r0 = rf(notification)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Notification) error); ok {
+		r1 = rf(notification)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationPreferenceByUserIdRepository provides a mock function with given fields: userID
+func (_m *NotificationRepository) GetNotificationPreferenceByUserIdRepository(userID string) (*model.NotificationPreference, error) {
+	ret := _m.Called(userID)
+
+	var r0 *model.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.NotificationPreference, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.NotificationPreference); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByUserIdRepository provides a mock function with given fields: userID, page, limit
+func (_m *NotificationRepository) GetNotificationsByUserIdRepository(userID string, page int, limit int) ([]*model.Notification, error) {
+	ret := _m.Called(userID, page, limit)
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.Notification, error)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.Notification); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadNotificationByIdRepository provides a mock function with given fields: userID, id
+func (_m *NotificationRepository) ReadNotificationByIdRepository(userID string, id string) error {
+	ret := _m.Called(userID, id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveNotificationPreferenceRepository provides a mock function with given fields: preference
+func (_m *NotificationRepository) SaveNotificationPreferenceRepository(preference *model.NotificationPreference) (*model.NotificationPreference, error) {
+	ret := _m.Called(preference)
+
+	var r0 *model.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.NotificationPreference) (*model.NotificationPreference, error)); ok {
+		return rf(preference)
+	}
+	if rf, ok := ret.Get(0).(func(*model.NotificationPreference) *model.NotificationPreference); ok {
+		r0 = rf(preference)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.NotificationPreference) error); ok {
+		r1 = rf(preference)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationRepository creates a new instance of NotificationRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationRepository {
+	mock := &NotificationRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
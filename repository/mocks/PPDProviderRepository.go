@@ -0,0 +1,80 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PPDProviderRepository is an autogenerated mock type for the PPDProviderRepository type
+type PPDProviderRepository struct {
+	mock.Mock
+}
+
+// PurchasePPDRepository provides a mock function with given fields: transactionID, detail
+func (_m *PPDProviderRepository) PurchasePPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.PPDPurchaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionPPD) (*model.PPDPurchaseResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionPPD) *model.PPDPurchaseResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PPDPurchaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionPPD) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchaseStatusPPDRepository provides a mock function with given fields: transactionID, detail
+func (_m *PPDProviderRepository) PurchaseStatusPPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.PPDPurchaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionPPD) (*model.PPDPurchaseResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionPPD) *model.PPDPurchaseResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PPDPurchaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionPPD) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPPDProviderRepository creates a new instance of PPDProviderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPPDProviderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PPDProviderRepository {
+	mock := &PPDProviderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,168 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PasswordResetRepository is an autogenerated mock type for the PasswordResetRepository type
+type PasswordResetRepository struct {
+	mock.Mock
+}
+
+// AddPasswordResetAttemptRepository provides a mock function with given fields: id, maxAttempts
+func (_m *PasswordResetRepository) AddPasswordResetAttemptRepository(id string, maxAttempts int) (bool, error) {
+	ret := _m.Called(id, maxAttempts)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (bool, error)); ok {
+		return rf(id, maxAttempts)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) bool); ok {
+		r0 = rf(id, maxAttempts)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(id, maxAttempts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CountPasswordResetsSinceRepository provides a mock function with given fields: userID, since
+func (_m *PasswordResetRepository) CountPasswordResetsSinceRepository(userID string, since time.Time) (int64, error) {
+	ret := _m.Called(userID, since)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (int64, error)); ok {
+		return rf(userID, since)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) int64); ok {
+		r0 = rf(userID, since)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePasswordResetRepository provides a mock function with given fields: reset
+func (_m *PasswordResetRepository) CreatePasswordResetRepository(reset *model.PasswordReset) (*model.PasswordReset, error) {
+	ret := _m.Called(reset)
+
+	var r0 *model.PasswordReset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PasswordReset) (*model.PasswordReset, error)); ok {
+		return rf(reset)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PasswordReset) *model.PasswordReset); ok {
+		r0 = rf(reset)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PasswordReset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PasswordReset) error); ok {
+		r1 = rf(reset)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteExpiredPasswordResetsRepository provides a mock function with given fields: now
+func (_m *PasswordResetRepository) DeleteExpiredPasswordResetsRepository(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetLatestPasswordResetByUserIdRepository provides a mock function with given fields: userID
+func (_m *PasswordResetRepository) GetLatestPasswordResetByUserIdRepository(userID string) (*model.PasswordReset, error) {
+	ret := _m.Called(userID)
+
+	var r0 *model.PasswordReset
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PasswordReset, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PasswordReset); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PasswordReset)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkPasswordResetUsedRepository provides a mock function with given fields: id, usedAt
+func (_m *PasswordResetRepository) MarkPasswordResetUsedRepository(id string, usedAt time.Time) (bool, error) {
+	ret := _m.Called(id, usedAt)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (bool, error)); ok {
+		return rf(id, usedAt)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) bool); ok {
+		r0 = rf(id, usedAt)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(id, usedAt)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPasswordResetRepository creates a new instance of PasswordResetRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordResetRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordResetRepository {
+	mock := &PasswordResetRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
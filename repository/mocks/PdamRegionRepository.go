@@ -0,0 +1,172 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PdamRegionRepository is an autogenerated mock type for the PdamRegionRepository type
+type PdamRegionRepository struct {
+	mock.Mock
+}
+
+// CreatePdamRegionRepository provides a mock function with given fields: region
+func (_m *PdamRegionRepository) CreatePdamRegionRepository(region *model.PdamRegion) (*model.PdamRegion, error) {
+	ret := _m.Called(region)
+
+	var r0 *model.PdamRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PdamRegion) (*model.PdamRegion, error)); ok {
+		return rf(region)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PdamRegion) *model.PdamRegion); ok {
+		r0 = rf(region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PdamRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PdamRegion) error); ok {
+		r1 = rf(region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePdamRegionByIdRepository provides a mock function with given fields: id
+func (_m *PdamRegionRepository) DeletePdamRegionByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllPdamRegionRepository provides a mock function with given fields: name, page, limit
+func (_m *PdamRegionRepository) GetAllPdamRegionRepository(name string, page int, limit int) ([]*model.PdamRegion, error) {
+	ret := _m.Called(name, page, limit)
+
+	var r0 []*model.PdamRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.PdamRegion, error)); ok {
+		return rf(name, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.PdamRegion); ok {
+		r0 = rf(name, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PdamRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(name, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPdamRegionByCodeRepository provides a mock function with given fields: code
+func (_m *PdamRegionRepository) GetPdamRegionByCodeRepository(code string) (*model.PdamRegion, error) {
+	ret := _m.Called(code)
+
+	var r0 *model.PdamRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PdamRegion, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PdamRegion); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PdamRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPdamRegionByIdRepository provides a mock function with given fields: id
+func (_m *PdamRegionRepository) GetPdamRegionByIdRepository(id string) (*model.PdamRegion, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.PdamRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PdamRegion, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PdamRegion); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PdamRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePdamRegionByIdRepository provides a mock function with given fields: id, region
+func (_m *PdamRegionRepository) UpdatePdamRegionByIdRepository(id string, region *model.PdamRegion) (*model.PdamRegion, error) {
+	ret := _m.Called(id, region)
+
+	var r0 *model.PdamRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.PdamRegion) (*model.PdamRegion, error)); ok {
+		return rf(id, region)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.PdamRegion) *model.PdamRegion); ok {
+		r0 = rf(id, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PdamRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.PdamRegion) error); ok {
+		r1 = rf(id, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPdamRegionRepository creates a new instance of PdamRegionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPdamRegionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PdamRegionRepository {
+	mock := &PdamRegionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
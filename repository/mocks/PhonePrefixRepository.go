@@ -0,0 +1,198 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PhonePrefixRepository is an autogenerated mock type for the PhonePrefixRepository type
+type PhonePrefixRepository struct {
+	mock.Mock
+}
+
+// CreatePhonePrefixRepository provides a mock function with given fields: prefix
+func (_m *PhonePrefixRepository) CreatePhonePrefixRepository(prefix *model.PhonePrefix) (*model.PhonePrefix, error) {
+	ret := _m.Called(prefix)
+
+	var r0 *model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PhonePrefix) (*model.PhonePrefix, error)); ok {
+		return rf(prefix)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PhonePrefix) *model.PhonePrefix); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PhonePrefix) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePhonePrefixByIdRepository provides a mock function with given fields: id
+func (_m *PhonePrefixRepository) DeletePhonePrefixByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllPhonePrefixRepository provides a mock function with given fields: provider, page, limit
+func (_m *PhonePrefixRepository) GetAllPhonePrefixRepository(provider string, page int, limit int) ([]*model.PhonePrefix, error) {
+	ret := _m.Called(provider, page, limit)
+
+	var r0 []*model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.PhonePrefix, error)); ok {
+		return rf(provider, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.PhonePrefix); ok {
+		r0 = rf(provider, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(provider, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPhonePrefixByIdRepository provides a mock function with given fields: id
+func (_m *PhonePrefixRepository) GetPhonePrefixByIdRepository(id string) (*model.PhonePrefix, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PhonePrefix, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PhonePrefix); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPhonePrefixByPhoneRepository provides a mock function with given fields: phone
+func (_m *PhonePrefixRepository) GetPhonePrefixByPhoneRepository(phone string) (*model.PhonePrefix, error) {
+	ret := _m.Called(phone)
+
+	var r0 *model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PhonePrefix, error)); ok {
+		return rf(phone)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PhonePrefix); ok {
+		r0 = rf(phone)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(phone)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPhonePrefixByPrefixRepository provides a mock function with given fields: prefix
+func (_m *PhonePrefixRepository) GetPhonePrefixByPrefixRepository(prefix string) (*model.PhonePrefix, error) {
+	ret := _m.Called(prefix)
+
+	var r0 *model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PhonePrefix, error)); ok {
+		return rf(prefix)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PhonePrefix); ok {
+		r0 = rf(prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePhonePrefixByIdRepository provides a mock function with given fields: id, prefix
+func (_m *PhonePrefixRepository) UpdatePhonePrefixByIdRepository(id string, prefix *model.PhonePrefix) (*model.PhonePrefix, error) {
+	ret := _m.Called(id, prefix)
+
+	var r0 *model.PhonePrefix
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.PhonePrefix) (*model.PhonePrefix, error)); ok {
+		return rf(id, prefix)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.PhonePrefix) *model.PhonePrefix); ok {
+		r0 = rf(id, prefix)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PhonePrefix)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.PhonePrefix) error); ok {
+		r1 = rf(id, prefix)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPhonePrefixRepository creates a new instance of PhonePrefixRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPhonePrefixRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PhonePrefixRepository {
+	mock := &PhonePrefixRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
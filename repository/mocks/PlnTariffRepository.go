@@ -0,0 +1,172 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PlnTariffRepository is an autogenerated mock type for the PlnTariffRepository type
+type PlnTariffRepository struct {
+	mock.Mock
+}
+
+// CreatePlnTariffRepository provides a mock function with given fields: tariff
+func (_m *PlnTariffRepository) CreatePlnTariffRepository(tariff *model.PlnTariff) (*model.PlnTariff, error) {
+	ret := _m.Called(tariff)
+
+	var r0 *model.PlnTariff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.PlnTariff) (*model.PlnTariff, error)); ok {
+		return rf(tariff)
+	}
+	if rf, ok := ret.Get(0).(func(*model.PlnTariff) *model.PlnTariff); ok {
+		r0 = rf(tariff)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PlnTariff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.PlnTariff) error); ok {
+		r1 = rf(tariff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePlnTariffByIdRepository provides a mock function with given fields: id
+func (_m *PlnTariffRepository) DeletePlnTariffByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllPlnTariffRepository provides a mock function with given fields: page, limit
+func (_m *PlnTariffRepository) GetAllPlnTariffRepository(page int, limit int) ([]*model.PlnTariff, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.PlnTariff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.PlnTariff, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.PlnTariff); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.PlnTariff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlnTariffByIdRepository provides a mock function with given fields: id
+func (_m *PlnTariffRepository) GetPlnTariffByIdRepository(id string) (*model.PlnTariff, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.PlnTariff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PlnTariff, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PlnTariff); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PlnTariff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPlnTariffByPowerRepository provides a mock function with given fields: segment, power
+func (_m *PlnTariffRepository) GetPlnTariffByPowerRepository(segment string, power int) (*model.PlnTariff, error) {
+	ret := _m.Called(segment, power)
+
+	var r0 *model.PlnTariff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) (*model.PlnTariff, error)); ok {
+		return rf(segment, power)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) *model.PlnTariff); ok {
+		r0 = rf(segment, power)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PlnTariff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(segment, power)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePlnTariffByIdRepository provides a mock function with given fields: id, tariff
+func (_m *PlnTariffRepository) UpdatePlnTariffByIdRepository(id string, tariff *model.PlnTariff) (*model.PlnTariff, error) {
+	ret := _m.Called(id, tariff)
+
+	var r0 *model.PlnTariff
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.PlnTariff) (*model.PlnTariff, error)); ok {
+		return rf(id, tariff)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.PlnTariff) *model.PlnTariff); ok {
+		r0 = rf(id, tariff)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PlnTariff)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.PlnTariff) error); ok {
+		r1 = rf(id, tariff)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPlnTariffRepository creates a new instance of PlnTariffRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPlnTariffRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PlnTariffRepository {
+	mock := &PlnTariffRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
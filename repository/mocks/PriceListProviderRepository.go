@@ -0,0 +1,54 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PriceListProviderRepository is an autogenerated mock type for the PriceListProviderRepository type
+type PriceListProviderRepository struct {
+	mock.Mock
+}
+
+// GetPriceListRepository provides a mock function with given fields: catalog
+func (_m *PriceListProviderRepository) GetPriceListRepository(catalog string) ([]model.ProviderPrice, error) {
+	ret := _m.Called(catalog)
+
+	var r0 []model.ProviderPrice
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]model.ProviderPrice, error)); ok {
+		return rf(catalog)
+	}
+	if rf, ok := ret.Get(0).(func(string) []model.ProviderPrice); ok {
+		r0 = rf(catalog)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProviderPrice)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(catalog)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPriceListProviderRepository creates a new instance of PriceListProviderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPriceListProviderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PriceListProviderRepository {
+	mock := &PriceListProviderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
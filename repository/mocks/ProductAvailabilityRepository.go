@@ -0,0 +1,252 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ProductAvailabilityRepository is an autogenerated mock type for the ProductAvailabilityRepository type
+type ProductAvailabilityRepository struct {
+	mock.Mock
+}
+
+// AddProductAvailabilityFailureRepository provides a mock function with given fields: product, provider
+func (_m *ProductAvailabilityRepository) AddProductAvailabilityFailureRepository(product string, provider string) error {
+	ret := _m.Called(product, provider)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(product, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreateProductAvailabilityRepository provides a mock function with given fields: availability
+func (_m *ProductAvailabilityRepository) CreateProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error) {
+	ret := _m.Called(availability)
+
+	var r0 *model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ProductAvailability) (*model.ProductAvailability, error)); ok {
+		return rf(availability)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ProductAvailability) *model.ProductAvailability); ok {
+		r0 = rf(availability)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ProductAvailability) error); ok {
+		r1 = rf(availability)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteProductAvailabilityByIdRepository provides a mock function with given fields: id
+func (_m *ProductAvailabilityRepository) DeleteProductAvailabilityByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllProductAvailabilityRepository provides a mock function with given fields: product, page, limit
+func (_m *ProductAvailabilityRepository) GetAllProductAvailabilityRepository(product string, page int, limit int) ([]*model.ProductAvailability, error) {
+	ret := _m.Called(product, page, limit)
+
+	var r0 []*model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.ProductAvailability, error)); ok {
+		return rf(product, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.ProductAvailability); ok {
+		r0 = rf(product, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(product, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAvailabilityByIdRepository provides a mock function with given fields: id
+func (_m *ProductAvailabilityRepository) GetProductAvailabilityByIdRepository(id string) (*model.ProductAvailability, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ProductAvailability, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ProductAvailability); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAvailabilityByProviderRepository provides a mock function with given fields: product, provider
+func (_m *ProductAvailabilityRepository) GetProductAvailabilityByProviderRepository(product string, provider string) (*model.ProductAvailability, error) {
+	ret := _m.Called(product, provider)
+
+	var r0 *model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.ProductAvailability, error)); ok {
+		return rf(product, provider)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.ProductAvailability); ok {
+		r0 = rf(product, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(product, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductAvailabilityRepository provides a mock function with given fields: product, provider
+func (_m *ProductAvailabilityRepository) GetProductAvailabilityRepository(product string, provider string) ([]model.ProductAvailability, error) {
+	ret := _m.Called(product, provider)
+
+	var r0 []model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) ([]model.ProductAvailability, error)); ok {
+		return rf(product, provider)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) []model.ProductAvailability); ok {
+		r0 = rf(product, provider)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(product, provider)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResetProductAvailabilityFailuresRepository provides a mock function with given fields: product, provider
+func (_m *ProductAvailabilityRepository) ResetProductAvailabilityFailuresRepository(product string, provider string) error {
+	ret := _m.Called(product, provider)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(product, provider)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveProductAvailabilityRepository provides a mock function with given fields: availability
+func (_m *ProductAvailabilityRepository) SaveProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error) {
+	ret := _m.Called(availability)
+
+	var r0 *model.ProductAvailability
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ProductAvailability) (*model.ProductAvailability, error)); ok {
+		return rf(availability)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ProductAvailability) *model.ProductAvailability); ok {
+		r0 = rf(availability)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ProductAvailability)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ProductAvailability) error); ok {
+		r1 = rf(availability)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TripProductAvailabilityRepository provides a mock function with given fields: product, provider, threshold, until, message
+func (_m *ProductAvailabilityRepository) TripProductAvailabilityRepository(product string, provider string, threshold int, until time.Time, message string) (bool, error) {
+	ret := _m.Called(product, provider, threshold, until, message)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, time.Time, string) (bool, error)); ok {
+		return rf(product, provider, threshold, until, message)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, time.Time, string) bool); ok {
+		r0 = rf(product, provider, threshold, until, message)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, time.Time, string) error); ok {
+		r1 = rf(product, provider, threshold, until, message)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewProductAvailabilityRepository creates a new instance of ProductAvailabilityRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewProductAvailabilityRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ProductAvailabilityRepository {
+	mock := &ProductAvailabilityRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -102,6 +102,32 @@ func (_m *PulsaPaketDataRepository) GetPulsaPaketDataById(id string) (model.Puls
 	return r0, r1
 }
 
+// GetPulsaPaketDataCatalog provides a mock function with given fields:
+func (_m *PulsaPaketDataRepository) GetPulsaPaketDataCatalog() ([]model.PulsaPaketData, error) {
+	ret := _m.Called()
+
+	var r0 []model.PulsaPaketData
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]model.PulsaPaketData, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []model.PulsaPaketData); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.PulsaPaketData)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // UpdatePulsaById provides a mock function with given fields: id, data
 func (_m *PulsaPaketDataRepository) UpdatePulsaById(id string, data model.PulsaPaketData) error {
 	ret := _m.Called(id, data)
@@ -0,0 +1,250 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// SavedBillerRepository is an autogenerated mock type for the SavedBillerRepository type
+type SavedBillerRepository struct {
+	mock.Mock
+}
+
+// CreateSavedBillerRepository provides a mock function with given fields: biller
+func (_m *SavedBillerRepository) CreateSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error) {
+	ret := _m.Called(biller)
+
+	var r0 *model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.SavedBiller) (*model.SavedBiller, error)); ok {
+		return rf(biller)
+	}
+	if rf, ok := ret.Get(0).(func(*model.SavedBiller) *model.SavedBiller); ok {
+		r0 = rf(biller)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.SavedBiller) error); ok {
+		r1 = rf(biller)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteSavedBillerByIdRepository provides a mock function with given fields: id
+func (_m *SavedBillerRepository) DeleteSavedBillerByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllSavedBillersByUserIdRepository provides a mock function with given fields: userID
+func (_m *SavedBillerRepository) GetAllSavedBillersByUserIdRepository(userID string) ([]*model.SavedBiller, error) {
+	ret := _m.Called(userID)
+
+	var r0 []*model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) ([]*model.SavedBiller, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) []*model.SavedBiller); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetAllSavedBillersRepository provides a mock function with given fields:
+func (_m *SavedBillerRepository) GetAllSavedBillersRepository() ([]*model.SavedBiller, error) {
+	ret := _m.Called()
+
+	var r0 []*model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func() ([]*model.SavedBiller, error)); ok {
+		return rf()
+	}
+	if rf, ok := ret.Get(0).(func() []*model.SavedBiller); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSavedBillerByCustomerIdRepository provides a mock function with given fields: userID, productID, customerID
+func (_m *SavedBillerRepository) GetSavedBillerByCustomerIdRepository(userID string, productID string, customerID string) (*model.SavedBiller, error) {
+	ret := _m.Called(userID, productID, customerID)
+
+	var r0 *model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.SavedBiller, error)); ok {
+		return rf(userID, productID, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.SavedBiller); ok {
+		r0 = rf(userID, productID, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(userID, productID, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSavedBillerByIdRepository provides a mock function with given fields: id
+func (_m *SavedBillerRepository) GetSavedBillerByIdRepository(id string) (*model.SavedBiller, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.SavedBiller, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.SavedBiller); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSavedBillersByUserIdRepository provides a mock function with given fields: userID, category, page, limit
+func (_m *SavedBillerRepository) GetSavedBillersByUserIdRepository(userID string, category string, page int, limit int) ([]*model.SavedBiller, error) {
+	ret := _m.Called(userID, category, page, limit)
+
+	var r0 []*model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]*model.SavedBiller, error)); ok {
+		return rf(userID, category, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.SavedBiller); ok {
+		r0 = rf(userID, category, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(userID, category, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateSavedBillerByIdRepository provides a mock function with given fields: id, biller
+func (_m *SavedBillerRepository) UpdateSavedBillerByIdRepository(id string, biller *model.SavedBiller) (*model.SavedBiller, error) {
+	ret := _m.Called(id, biller)
+
+	var r0 *model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.SavedBiller) (*model.SavedBiller, error)); ok {
+		return rf(id, biller)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.SavedBiller) *model.SavedBiller); ok {
+		r0 = rf(id, biller)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.SavedBiller) error); ok {
+		r1 = rf(id, biller)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpsertSavedBillerRepository provides a mock function with given fields: biller
+func (_m *SavedBillerRepository) UpsertSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error) {
+	ret := _m.Called(biller)
+
+	var r0 *model.SavedBiller
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.SavedBiller) (*model.SavedBiller, error)); ok {
+		return rf(biller)
+	}
+	if rf, ok := ret.Get(0).(func(*model.SavedBiller) *model.SavedBiller); ok {
+		r0 = rf(biller)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SavedBiller)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.SavedBiller) error); ok {
+		r1 = rf(biller)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSavedBillerRepository creates a new instance of SavedBillerRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSavedBillerRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SavedBillerRepository {
+	mock := &SavedBillerRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
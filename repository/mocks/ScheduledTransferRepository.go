@@ -0,0 +1,184 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// ScheduledTransferRepository is an autogenerated mock type for the ScheduledTransferRepository type
+type ScheduledTransferRepository struct {
+	mock.Mock
+}
+
+// ClaimScheduledTransferRunRepository provides a mock function with given fields: id, dueAt, claimed
+func (_m *ScheduledTransferRepository) ClaimScheduledTransferRunRepository(id string, dueAt time.Time, claimed *model.ScheduledTransfer) (bool, error) {
+	ret := _m.Called(id, dueAt, claimed)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time, *model.ScheduledTransfer) (bool, error)); ok {
+		return rf(id, dueAt, claimed)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time, *model.ScheduledTransfer) bool); ok {
+		r0 = rf(id, dueAt, claimed)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time, *model.ScheduledTransfer) error); ok {
+		r1 = rf(id, dueAt, claimed)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateScheduledTransferRepository provides a mock function with given fields: schedule
+func (_m *ScheduledTransferRepository) CreateScheduledTransferRepository(schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	ret := _m.Called(schedule)
+
+	var r0 *model.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.ScheduledTransfer) (*model.ScheduledTransfer, error)); ok {
+		return rf(schedule)
+	}
+	if rf, ok := ret.Get(0).(func(*model.ScheduledTransfer) *model.ScheduledTransfer); ok {
+		r0 = rf(schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.ScheduledTransfer) error); ok {
+		r1 = rf(schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetDueScheduledTransfersRepository provides a mock function with given fields: now
+func (_m *ScheduledTransferRepository) GetDueScheduledTransfersRepository(now time.Time) ([]*model.ScheduledTransfer, error) {
+	ret := _m.Called(now)
+
+	var r0 []*model.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time) ([]*model.ScheduledTransfer, error)); ok {
+		return rf(now)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time) []*model.ScheduledTransfer); ok {
+		r0 = rf(now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time) error); ok {
+		r1 = rf(now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScheduledTransferByIdRepository provides a mock function with given fields: id
+func (_m *ScheduledTransferRepository) GetScheduledTransferByIdRepository(id string) (*model.ScheduledTransfer, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.ScheduledTransfer, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.ScheduledTransfer); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetScheduledTransfersByUserIdRepository provides a mock function with given fields: userID, status, page, limit
+func (_m *ScheduledTransferRepository) GetScheduledTransfersByUserIdRepository(userID string, status string, page int, limit int) ([]*model.ScheduledTransfer, error) {
+	ret := _m.Called(userID, status, page, limit)
+
+	var r0 []*model.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, int, int) ([]*model.ScheduledTransfer, error)); ok {
+		return rf(userID, status, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, int, int) []*model.ScheduledTransfer); ok {
+		r0 = rf(userID, status, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, int, int) error); ok {
+		r1 = rf(userID, status, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateScheduledTransferByIdRepository provides a mock function with given fields: id, schedule
+func (_m *ScheduledTransferRepository) UpdateScheduledTransferByIdRepository(id string, schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	ret := _m.Called(id, schedule)
+
+	var r0 *model.ScheduledTransfer
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.ScheduledTransfer) (*model.ScheduledTransfer, error)); ok {
+		return rf(id, schedule)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.ScheduledTransfer) *model.ScheduledTransfer); ok {
+		r0 = rf(id, schedule)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.ScheduledTransfer)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.ScheduledTransfer) error); ok {
+		r1 = rf(id, schedule)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewScheduledTransferRepository creates a new instance of ScheduledTransferRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewScheduledTransferRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ScheduledTransferRepository {
+	mock := &ScheduledTransferRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
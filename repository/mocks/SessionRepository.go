@@ -0,0 +1,234 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SessionRepository is an autogenerated mock type for the SessionRepository type
+type SessionRepository struct {
+	mock.Mock
+}
+
+// CreateSessionRepository provides a mock function with given fields: session
+func (_m *SessionRepository) CreateSessionRepository(session *model.Session) (*model.Session, error) {
+	ret := _m.Called(session)
+
+	var r0 *model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Session) (*model.Session, error)); ok {
+		return rf(session)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Session) *model.Session); ok {
+		r0 = rf(session)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Session) error); ok {
+		r1 = rf(session)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteExpiredSessionsRepository provides a mock function with given fields: now
+func (_m *SessionRepository) DeleteExpiredSessionsRepository(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetActiveSessionsByUserIdRepository provides a mock function with given fields: userID, now
+func (_m *SessionRepository) GetActiveSessionsByUserIdRepository(userID string, now time.Time) ([]*model.Session, error) {
+	ret := _m.Called(userID, now)
+
+	var r0 []*model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) ([]*model.Session, error)); ok {
+		return rf(userID, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) []*model.Session); ok {
+		r0 = rf(userID, now)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(userID, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSessionByIdRepository provides a mock function with given fields: id
+func (_m *SessionRepository) GetSessionByIdRepository(id string) (*model.Session, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Session, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Session); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSessionByRefreshTokenRepository provides a mock function with given fields: refreshTokenHash
+func (_m *SessionRepository) GetSessionByRefreshTokenRepository(refreshTokenHash string) (*model.Session, error) {
+	ret := _m.Called(refreshTokenHash)
+
+	var r0 *model.Session
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Session, error)); ok {
+		return rf(refreshTokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Session); ok {
+		r0 = rf(refreshTokenHash)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Session)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(refreshTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// IsTokenRevokedRepository provides a mock function with given fields: id
+func (_m *SessionRepository) IsTokenRevokedRepository(id string) (bool, error) {
+	ret := _m.Called(id)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (bool, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) bool); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeSessionRepository provides a mock function with given fields: id, now
+func (_m *SessionRepository) RevokeSessionRepository(id string, now time.Time) (bool, error) {
+	ret := _m.Called(id, now)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, time.Time) (bool, error)); ok {
+		return rf(id, now)
+	}
+	if rf, ok := ret.Get(0).(func(string, time.Time) bool); ok {
+		r0 = rf(id, now)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, time.Time) error); ok {
+		r1 = rf(id, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RevokeTokenRepository provides a mock function with given fields: token
+func (_m *SessionRepository) RevokeTokenRepository(token *model.RevokedToken) error {
+	ret := _m.Called(token)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.RevokedToken) error); ok {
+		r0 = rf(token)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RotateSessionRepository provides a mock function with given fields: session, refreshTokenHash
+func (_m *SessionRepository) RotateSessionRepository(session *model.Session, refreshTokenHash string) (bool, error) {
+	ret := _m.Called(session, refreshTokenHash)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Session, string) (bool, error)); ok {
+		return rf(session, refreshTokenHash)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Session, string) bool); ok {
+		r0 = rf(session, refreshTokenHash)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Session, string) error); ok {
+		r1 = rf(session, refreshTokenHash)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewSessionRepository creates a new instance of SessionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionRepository {
+	mock := &SessionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,172 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// TaxRegionRepository is an autogenerated mock type for the TaxRegionRepository type
+type TaxRegionRepository struct {
+	mock.Mock
+}
+
+// CreateTaxRegionRepository provides a mock function with given fields: region
+func (_m *TaxRegionRepository) CreateTaxRegionRepository(region *model.TaxRegion) (*model.TaxRegion, error) {
+	ret := _m.Called(region)
+
+	var r0 *model.TaxRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.TaxRegion) (*model.TaxRegion, error)); ok {
+		return rf(region)
+	}
+	if rf, ok := ret.Get(0).(func(*model.TaxRegion) *model.TaxRegion); ok {
+		r0 = rf(region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TaxRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.TaxRegion) error); ok {
+		r1 = rf(region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteTaxRegionByIdRepository provides a mock function with given fields: id
+func (_m *TaxRegionRepository) DeleteTaxRegionByIdRepository(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllTaxRegionRepository provides a mock function with given fields: category, page, limit
+func (_m *TaxRegionRepository) GetAllTaxRegionRepository(category string, page int, limit int) ([]*model.TaxRegion, error) {
+	ret := _m.Called(category, page, limit)
+
+	var r0 []*model.TaxRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.TaxRegion, error)); ok {
+		return rf(category, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.TaxRegion); ok {
+		r0 = rf(category, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.TaxRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(category, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaxRegionByCodeRepository provides a mock function with given fields: code
+func (_m *TaxRegionRepository) GetTaxRegionByCodeRepository(code string) (*model.TaxRegion, error) {
+	ret := _m.Called(code)
+
+	var r0 *model.TaxRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.TaxRegion, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.TaxRegion); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TaxRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTaxRegionByIdRepository provides a mock function with given fields: id
+func (_m *TaxRegionRepository) GetTaxRegionByIdRepository(id string) (*model.TaxRegion, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.TaxRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.TaxRegion, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.TaxRegion); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TaxRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateTaxRegionByIdRepository provides a mock function with given fields: id, region
+func (_m *TaxRegionRepository) UpdateTaxRegionByIdRepository(id string, region *model.TaxRegion) (*model.TaxRegion, error) {
+	ret := _m.Called(id, region)
+
+	var r0 *model.TaxRegion
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TaxRegion) (*model.TaxRegion, error)); ok {
+		return rf(id, region)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TaxRegion) *model.TaxRegion); ok {
+		r0 = rf(id, region)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.TaxRegion)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TaxRegion) error); ok {
+		r1 = rf(id, region)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewTaxRegionRepository creates a new instance of TaxRegionRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewTaxRegionRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *TaxRegionRepository {
+	mock := &TaxRegionRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -15,6 +15,46 @@ type TransactionRepository struct {
 	mock.Mock
 }
 
+// CloseUnpaidTransactionRepository provides a mock function with given fields: id, status
+func (_m *TransactionRepository) CloseUnpaidTransactionRepository(id string, status string) error {
+	ret := _m.Called(id, status)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(id, status)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePaidTransactionRepository provides a mock function with given fields: transaction
+func (_m *TransactionRepository) CreatePaidTransactionRepository(transaction *model.Transaction) (*model.Transaction, error) {
+	ret := _m.Called(transaction)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Transaction) (*model.Transaction, error)); ok {
+		return rf(transaction)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Transaction) *model.Transaction); ok {
+		r0 = rf(transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Transaction) error); ok {
+		r1 = rf(transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateTransactionByUserIdRepository provides a mock function with given fields: transaction
 func (_m *TransactionRepository) CreateTransactionByUserIdRepository(transaction *model.Transaction) (*model.Transaction, error) {
 	ret := _m.Called(transaction)
@@ -41,6 +81,30 @@ func (_m *TransactionRepository) CreateTransactionByUserIdRepository(transaction
 	return r0, r1
 }
 
+// ExpireUnpaidTransactionsRepository provides a mock function with given fields: now, createdBefore
+func (_m *TransactionRepository) ExpireUnpaidTransactionsRepository(now time.Time, createdBefore time.Time) (int64, error) {
+	ret := _m.Called(now, createdBefore)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) (int64, error)); ok {
+		return rf(now, createdBefore)
+	}
+	if rf, ok := ret.Get(0).(func(time.Time, time.Time) int64); ok {
+		r0 = rf(now, createdBefore)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(time.Time, time.Time) error); ok {
+		r1 = rf(now, createdBefore)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetAllTransactionsRepository provides a mock function with given fields: page, limit
 func (_m *TransactionRepository) GetAllTransactionsRepository(page int, limit int) ([]*model.Transaction, error) {
 	ret := _m.Called(page, limit)
@@ -67,6 +131,84 @@ func (_m *TransactionRepository) GetAllTransactionsRepository(page int, limit in
 	return r0, r1
 }
 
+// GetLastPaidBillRepository provides a mock function with given fields: prefix, productType, customerID
+func (_m *TransactionRepository) GetLastPaidBillRepository(prefix string, productType string, customerID string) (*model.Transaction, error) {
+	ret := _m.Called(prefix, productType, customerID)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.Transaction, error)); ok {
+		return rf(prefix, productType, customerID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.Transaction); ok {
+		r0 = rf(prefix, productType, customerID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(prefix, productType, customerID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPaidBillsSinceRepository provides a mock function with given fields: prefix, customerID, since
+func (_m *TransactionRepository) GetPaidBillsSinceRepository(prefix string, customerID string, since time.Time) ([]*model.Transaction, error) {
+	ret := _m.Called(prefix, customerID, since)
+
+	var r0 []*model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) ([]*model.Transaction, error)); ok {
+		return rf(prefix, customerID, since)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, time.Time) []*model.Transaction); ok {
+		r0 = rf(prefix, customerID, since)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, time.Time) error); ok {
+		r1 = rf(prefix, customerID, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProcessingTransactionsByPrefixRepository provides a mock function with given fields: prefix, limit
+func (_m *TransactionRepository) GetProcessingTransactionsByPrefixRepository(prefix string, limit int) ([]*model.Transaction, error) {
+	ret := _m.Called(prefix, limit)
+
+	var r0 []*model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int) ([]*model.Transaction, error)); ok {
+		return rf(prefix, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int) []*model.Transaction); ok {
+		r0 = rf(prefix, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int) error); ok {
+		r1 = rf(prefix, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetProductDetailsByPeriodAndCustomerID provides a mock function with given fields: payload
 func (_m *TransactionRepository) GetProductDetailsByPeriodAndCustomerID(payload model.GetProductDetail) (*model.Transaction, error) {
 	ret := _m.Called(payload)
@@ -275,6 +417,110 @@ func (_m *TransactionRepository) GetTransactionsProductTypeRepository(productTyp
 	return r0, r1
 }
 
+// PayTransactionRepository provides a mock function with given fields: id, userID, transaction
+func (_m *TransactionRepository) PayTransactionRepository(id string, userID string, transaction *model.Transaction) (*model.Transaction, error) {
+	ret := _m.Called(id, userID, transaction)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *model.Transaction) (*model.Transaction, error)); ok {
+		return rf(id, userID, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *model.Transaction) *model.Transaction); ok {
+		r0 = rf(id, userID, transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *model.Transaction) error); ok {
+		r1 = rf(id, userID, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RefundProcessingTransactionRepository provides a mock function with given fields: transaction, update
+func (_m *TransactionRepository) RefundProcessingTransactionRepository(transaction *model.Transaction, update *model.Transaction) error {
+	ret := _m.Called(transaction, update)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Transaction, *model.Transaction) error); ok {
+		r0 = rf(transaction, update)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SettleTransactionRepository provides a mock function with given fields: id, transaction
+func (_m *TransactionRepository) SettleTransactionRepository(id string, transaction *model.Transaction) (*model.Transaction, error) {
+	ret := _m.Called(id, transaction)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Transaction) (*model.Transaction, error)); ok {
+		return rf(id, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Transaction) *model.Transaction); ok {
+		r0 = rf(id, transaction)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Transaction) error); ok {
+		r1 = rf(id, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SubmitProcessingTransactionRepository provides a mock function with given fields: id, transaction
+func (_m *TransactionRepository) SubmitProcessingTransactionRepository(id string, transaction *model.Transaction) (bool, error) {
+	ret := _m.Called(id, transaction)
+
+	var r0 bool
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Transaction) (bool, error)); ok {
+		return rf(id, transaction)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Transaction) bool); ok {
+		r0 = rf(id, transaction)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Transaction) error); ok {
+		r1 = rf(id, transaction)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateProcessingTransactionRepository provides a mock function with given fields: id, transaction
+func (_m *TransactionRepository) UpdateProcessingTransactionRepository(id string, transaction *model.Transaction) error {
+	ret := _m.Called(id, transaction)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *model.Transaction) error); ok {
+		r0 = rf(id, transaction)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateTransactionByIdRepository provides a mock function with given fields: userId, transaction
 func (_m *TransactionRepository) UpdateTransactionByIdRepository(userId string, transaction *model.Transaction) (*model.Transaction, error) {
 	ret := _m.Called(userId, transaction)
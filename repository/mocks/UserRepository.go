@@ -13,6 +13,34 @@ type UserRepository struct {
 	mock.Mock
 }
 
+// CreditUserAmountRepository provides a mock function with given fields: id, amount
+func (_m *UserRepository) CreditUserAmountRepository(id string, amount float64) error {
+	ret := _m.Called(id, amount)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, float64) error); ok {
+		r0 = rf(id, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DebitUserAmountRepository provides a mock function with given fields: id, amount
+func (_m *UserRepository) DebitUserAmountRepository(id string, amount float64) error {
+	ret := _m.Called(id, amount)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, float64) error); ok {
+		r0 = rf(id, amount)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteUserByIDRepository provides a mock function with given fields: id
 func (_m *UserRepository) DeleteUserByIDRepository(id string) error {
 	ret := _m.Called(id)
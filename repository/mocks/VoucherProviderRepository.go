@@ -0,0 +1,106 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VoucherProviderRepository is an autogenerated mock type for the VoucherProviderRepository type
+type VoucherProviderRepository struct {
+	mock.Mock
+}
+
+// NicknameInquiryRepository provides a mock function with given fields: brandCode, userId, zoneId
+func (_m *VoucherProviderRepository) NicknameInquiryRepository(brandCode string, userId string, zoneId string) (*model.VoucherAccount, error) {
+	ret := _m.Called(brandCode, userId, zoneId)
+
+	var r0 *model.VoucherAccount
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, string) (*model.VoucherAccount, error)); ok {
+		return rf(brandCode, userId, zoneId)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, string) *model.VoucherAccount); ok {
+		r0 = rf(brandCode, userId, zoneId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VoucherAccount)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, string) error); ok {
+		r1 = rf(brandCode, userId, zoneId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchaseStatusVoucherRepository provides a mock function with given fields: transactionID, detail
+func (_m *VoucherProviderRepository) PurchaseStatusVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.VoucherPurchaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionVoucher) (*model.VoucherPurchaseResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionVoucher) *model.VoucherPurchaseResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VoucherPurchaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionVoucher) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PurchaseVoucherRepository provides a mock function with given fields: transactionID, detail
+func (_m *VoucherProviderRepository) PurchaseVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error) {
+	ret := _m.Called(transactionID, detail)
+
+	var r0 *model.VoucherPurchaseResult
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionVoucher) (*model.VoucherPurchaseResult, error)); ok {
+		return rf(transactionID, detail)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.TransactionVoucher) *model.VoucherPurchaseResult); ok {
+		r0 = rf(transactionID, detail)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.VoucherPurchaseResult)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.TransactionVoucher) error); ok {
+		r1 = rf(transactionID, detail)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewVoucherProviderRepository creates a new instance of VoucherProviderRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoucherProviderRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoucherProviderRepository {
+	mock := &VoucherProviderRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,207 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "BE-Golang/dto"
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// VoucherRepository is an autogenerated mock type for the VoucherRepository type
+type VoucherRepository struct {
+	mock.Mock
+}
+
+// CreateVoucher provides a mock function with given fields: data
+func (_m *VoucherRepository) CreateVoucher(data model.Voucher) (model.Voucher, error) {
+	ret := _m.Called(data)
+
+	var r0 model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.Voucher) (model.Voucher, error)); ok {
+		return rf(data)
+	}
+	if rf, ok := ret.Get(0).(func(model.Voucher) model.Voucher); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Get(0).(model.Voucher)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.Voucher) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateVoucherBrand provides a mock function with given fields: data
+func (_m *VoucherRepository) CreateVoucherBrand(data model.VoucherBrand) (model.VoucherBrand, error) {
+	ret := _m.Called(data)
+
+	var r0 model.VoucherBrand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(model.VoucherBrand) (model.VoucherBrand, error)); ok {
+		return rf(data)
+	}
+	if rf, ok := ret.Get(0).(func(model.VoucherBrand) model.VoucherBrand); ok {
+		r0 = rf(data)
+	} else {
+		r0 = ret.Get(0).(model.VoucherBrand)
+	}
+
+	if rf, ok := ret.Get(1).(func(model.VoucherBrand) error); ok {
+		r1 = rf(data)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteVoucherBrandById provides a mock function with given fields: id
+func (_m *VoucherRepository) DeleteVoucherBrandById(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteVoucherById provides a mock function with given fields: id
+func (_m *VoucherRepository) DeleteVoucherById(id string) error {
+	ret := _m.Called(id)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllVoucherBrand provides a mock function with given fields: data, isUser
+func (_m *VoucherRepository) GetAllVoucherBrand(data dto.VoucherBrandDto, isUser *bool) ([]model.VoucherBrand, error) {
+	ret := _m.Called(data, isUser)
+
+	var r0 []model.VoucherBrand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.VoucherBrandDto, *bool) ([]model.VoucherBrand, error)); ok {
+		return rf(data, isUser)
+	}
+	if rf, ok := ret.Get(0).(func(dto.VoucherBrandDto, *bool) []model.VoucherBrand); ok {
+		r0 = rf(data, isUser)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.VoucherBrand)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.VoucherBrandDto, *bool) error); ok {
+		r1 = rf(data, isUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherBrandById provides a mock function with given fields: id, isUser
+func (_m *VoucherRepository) GetVoucherBrandById(id string, isUser *bool) (model.VoucherBrand, error) {
+	ret := _m.Called(id, isUser)
+
+	var r0 model.VoucherBrand
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *bool) (model.VoucherBrand, error)); ok {
+		return rf(id, isUser)
+	}
+	if rf, ok := ret.Get(0).(func(string, *bool) model.VoucherBrand); ok {
+		r0 = rf(id, isUser)
+	} else {
+		r0 = ret.Get(0).(model.VoucherBrand)
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *bool) error); ok {
+		r1 = rf(id, isUser)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetVoucherById provides a mock function with given fields: id
+func (_m *VoucherRepository) GetVoucherById(id string) (model.Voucher, error) {
+	ret := _m.Called(id)
+
+	var r0 model.Voucher
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (model.Voucher, error)); ok {
+		return rf(id)
+	}
+	if rf, ok := ret.Get(0).(func(string) model.Voucher); ok {
+		r0 = rf(id)
+	} else {
+		r0 = ret.Get(0).(model.Voucher)
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateVoucherBrandById provides a mock function with given fields: id, data
+func (_m *VoucherRepository) UpdateVoucherBrandById(id string, data model.VoucherBrand) error {
+	ret := _m.Called(id, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.VoucherBrand) error); ok {
+		r0 = rf(id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateVoucherById provides a mock function with given fields: id, data
+func (_m *VoucherRepository) UpdateVoucherById(id string, data model.Voucher) error {
+	ret := _m.Called(id, data)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, model.Voucher) error); ok {
+		r0 = rf(id, data)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewVoucherRepository creates a new instance of VoucherRepository. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewVoucherRepository(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *VoucherRepository {
+	mock := &VoucherRepository{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
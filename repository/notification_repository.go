@@ -0,0 +1,90 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type NotificationRepository interface {
+	CreateNotificationRepository(notification *model.Notification) (*model.Notification, error)
+	GetNotificationsByUserIdRepository(userID string, page, limit int) ([]*model.Notification, error)
+	ReadNotificationByIdRepository(userID, id string) error
+	GetNotificationPreferenceByUserIdRepository(userID string) (*model.NotificationPreference, error)
+	SaveNotificationPreferenceRepository(preference *model.NotificationPreference) (*model.NotificationPreference, error)
+}
+
+type notificationRepository struct {
+	db *gorm.DB
+}
+
+func NewNotificationRepository(db *gorm.DB) *notificationRepository {
+	return &notificationRepository{db}
+}
+
+func (r *notificationRepository) CreateNotificationRepository(notification *model.Notification) (*model.Notification, error) {
+	result := r.db.Create(notification)
+	if result.Error != nil {
+		return nil, errors.New("failed to create notification")
+	}
+
+	return notification, nil
+}
+
+func (r *notificationRepository) GetNotificationsByUserIdRepository(userID string, page, limit int) ([]*model.Notification, error) {
+	var notifications []*model.Notification
+
+	offset := (page - 1) * limit
+
+	result := r.db.Where("user_id = ?", userID).Offset(offset).Limit(limit).Order("created_at DESC").Find(&notifications)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting notifications: %s", result.Error)
+	}
+
+	return notifications, nil
+}
+
+func (r *notificationRepository) ReadNotificationByIdRepository(userID, id string) error {
+	result := r.db.Model(&model.Notification{}).Where("id = ? AND user_id = ?", id, userID).Update("is_read", true)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("notification not found")
+	}
+
+	return nil
+}
+
+func (r *notificationRepository) GetNotificationPreferenceByUserIdRepository(userID string) (*model.NotificationPreference, error) {
+	var preference model.NotificationPreference
+
+	result := r.db.Where("user_id = ?", userID).First(&preference)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting notification preference: %s", result.Error)
+	}
+
+	return &preference, nil
+}
+
+func (r *notificationRepository) SaveNotificationPreferenceRepository(preference *model.NotificationPreference) (*model.NotificationPreference, error) {
+	if preference.ID == "" {
+		if err := r.db.Create(preference).Error; err != nil {
+			return nil, errors.New("failed to create notification preference")
+		}
+		return preference, nil
+	}
+
+	// Select all columns so that disabled channels are stored as false.
+	result := r.db.Model(&model.NotificationPreference{}).Where("id = ?", preference.ID).Select("*").Omit("id", "created_at").Updates(preference)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+
+	return preference, nil
+}
@@ -0,0 +1,93 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type PasswordResetRepository interface {
+	CreatePasswordResetRepository(reset *model.PasswordReset) (*model.PasswordReset, error)
+	GetLatestPasswordResetByUserIdRepository(userID string) (*model.PasswordReset, error)
+	CountPasswordResetsSinceRepository(userID string, since time.Time) (int64, error)
+	AddPasswordResetAttemptRepository(id string, maxAttempts int) (bool, error)
+	MarkPasswordResetUsedRepository(id string, usedAt time.Time) (bool, error)
+	DeleteExpiredPasswordResetsRepository(now time.Time) error
+}
+
+type passwordResetRepository struct {
+	db *gorm.DB
+}
+
+func NewPasswordResetRepository(db *gorm.DB) *passwordResetRepository {
+	return &passwordResetRepository{db}
+}
+
+func (r *passwordResetRepository) CreatePasswordResetRepository(reset *model.PasswordReset) (*model.PasswordReset, error) {
+	result := r.db.Create(reset)
+	if result.Error != nil {
+		return nil, errors.New("failed to create password reset")
+	}
+
+	return reset, nil
+}
+
+func (r *passwordResetRepository) GetLatestPasswordResetByUserIdRepository(userID string) (*model.PasswordReset, error) {
+	var reset model.PasswordReset
+
+	result := r.db.Where("user_id = ?", userID).Order("created_at DESC").First(&reset)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting password reset: %s", result.Error)
+	}
+
+	return &reset, nil
+}
+
+func (r *passwordResetRepository) CountPasswordResetsSinceRepository(userID string, since time.Time) (int64, error) {
+	var count int64
+
+	if err := r.db.Model(&model.PasswordReset{}).Where("user_id = ? AND created_at >= ?", userID, since).Count(&count).Error; err != nil {
+		return 0, err
+	}
+
+	return count, nil
+}
+
+// AddPasswordResetAttemptRepository counts one attempt at an unused code. It
+// reports false, without counting, once the code has had maxAttempts, so
+// concurrent guesses cannot get past the limit.
+func (r *passwordResetRepository) AddPasswordResetAttemptRepository(id string, maxAttempts int) (bool, error) {
+	result := r.db.Model(&model.PasswordReset{}).
+		Where("id = ? AND attempts < ? AND used_at IS NULL", id, maxAttempts).
+		Update("attempts", gorm.Expr("attempts + 1"))
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// MarkPasswordResetUsedRepository marks a code used. It reports false when
+// the code was already used, so a code can only reset the password once.
+func (r *passwordResetRepository) MarkPasswordResetUsedRepository(id string, usedAt time.Time) (bool, error) {
+	result := r.db.Model(&model.PasswordReset{}).
+		Where("id = ? AND used_at IS NULL", id).
+		Update("used_at", usedAt)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// DeleteExpiredPasswordResetsRepository removes codes that expired at or
+// before the given time.
+func (r *passwordResetRepository) DeleteExpiredPasswordResetsRepository(now time.Time) error {
+	return r.db.Unscoped().Where("expires_at <= ?", now).Delete(&model.PasswordReset{}).Error
+}
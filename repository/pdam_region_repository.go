@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type PdamRegionRepository interface {
+	CreatePdamRegionRepository(region *model.PdamRegion) (*model.PdamRegion, error)
+	GetPdamRegionByIdRepository(id string) (*model.PdamRegion, error)
+	GetPdamRegionByCodeRepository(code string) (*model.PdamRegion, error)
+	GetAllPdamRegionRepository(name string, page, limit int) ([]*model.PdamRegion, error)
+	UpdatePdamRegionByIdRepository(id string, region *model.PdamRegion) (*model.PdamRegion, error)
+	DeletePdamRegionByIdRepository(id string) error
+}
+
+type pdamRegionRepository struct {
+	db *gorm.DB
+}
+
+func NewPdamRegionRepository(db *gorm.DB) *pdamRegionRepository {
+	return &pdamRegionRepository{db}
+}
+
+func (r *pdamRegionRepository) CreatePdamRegionRepository(region *model.PdamRegion) (*model.PdamRegion, error) {
+	result := r.db.Create(region)
+	if result.Error != nil {
+		return nil, errors.New("failed to create PDAM region")
+	}
+
+	return region, nil
+}
+
+func (r *pdamRegionRepository) GetPdamRegionByIdRepository(id string) (*model.PdamRegion, error) {
+	var region model.PdamRegion
+
+	result := r.db.Preload("Tariffs", orderTariffs).First(&region, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("PDAM region with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting PDAM region with ID %s: %s", id, result.Error)
+	}
+
+	return &region, nil
+}
+
+func (r *pdamRegionRepository) GetPdamRegionByCodeRepository(code string) (*model.PdamRegion, error) {
+	var region model.PdamRegion
+
+	result := r.db.Preload("Tariffs", orderTariffs).First(&region, "code = ?", code)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting PDAM region %s: %s", code, result.Error)
+	}
+
+	return &region, nil
+}
+
+func (r *pdamRegionRepository) GetAllPdamRegionRepository(name string, page, limit int) ([]*model.PdamRegion, error) {
+	var regions []*model.PdamRegion
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if name != "" {
+		query = query.Where("name ILIKE ?", "%"+name+"%")
+	}
+
+	result := query.Preload("Tariffs", orderTariffs).Order("name ASC").Find(&regions)
+	if result.Error != nil {
+		return nil, errors.New("failed to get PDAM regions")
+	}
+
+	return regions, nil
+}
+
+// UpdatePdamRegionByIdRepository updates the region and, when the region
+// carries tariffs, replaces the whole tariff table with them.
+func (r *pdamRegionRepository) UpdatePdamRegionByIdRepository(id string, region *model.PdamRegion) (*model.PdamRegion, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		result := tx.Model(&model.PdamRegion{}).Where("id = ?", id).Omit("Tariffs").Updates(region)
+		if result.Error != nil {
+			return errors.New("failed to update PDAM region")
+		}
+		if result.RowsAffected == 0 {
+			return errors.New("PDAM region not found")
+		}
+		if region.Tariffs == nil {
+			return nil
+		}
+
+		if err := tx.Unscoped().Where("region_id = ?", id).Delete(&model.PdamTariff{}).Error; err != nil {
+			return errors.New("failed to update PDAM tariffs")
+		}
+		for i := range region.Tariffs {
+			region.Tariffs[i].ID = ""
+			region.Tariffs[i].RegionID = id
+		}
+		if len(region.Tariffs) > 0 {
+			if err := tx.Create(&region.Tariffs).Error; err != nil {
+				return errors.New("failed to update PDAM tariffs")
+			}
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return region, nil
+}
+
+func (r *pdamRegionRepository) DeletePdamRegionByIdRepository(id string) error {
+	result := r.db.Delete(&model.PdamRegion{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("PDAM region not found")
+	}
+
+	return nil
+}
+
+// orderTariffs lists each customer class's blocks from the lowest usage up,
+// with the open-ended block last.
+func orderTariffs(db *gorm.DB) *gorm.DB {
+	return db.Order("customer_class ASC").Order("up_to = 0 ASC").Order("up_to ASC")
+}
@@ -0,0 +1,122 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type PhonePrefixRepository interface {
+	CreatePhonePrefixRepository(prefix *model.PhonePrefix) (*model.PhonePrefix, error)
+	GetPhonePrefixByIdRepository(id string) (*model.PhonePrefix, error)
+	GetPhonePrefixByPrefixRepository(prefix string) (*model.PhonePrefix, error)
+	GetPhonePrefixByPhoneRepository(phone string) (*model.PhonePrefix, error)
+	GetAllPhonePrefixRepository(provider string, page, limit int) ([]*model.PhonePrefix, error)
+	UpdatePhonePrefixByIdRepository(id string, prefix *model.PhonePrefix) (*model.PhonePrefix, error)
+	DeletePhonePrefixByIdRepository(id string) error
+}
+
+type phonePrefixRepository struct {
+	db *gorm.DB
+}
+
+func NewPhonePrefixRepository(db *gorm.DB) *phonePrefixRepository {
+	return &phonePrefixRepository{db}
+}
+
+func (r *phonePrefixRepository) CreatePhonePrefixRepository(prefix *model.PhonePrefix) (*model.PhonePrefix, error) {
+	result := r.db.Create(prefix)
+	if result.Error != nil {
+		return nil, errors.New("failed to create phone prefix")
+	}
+
+	return prefix, nil
+}
+
+func (r *phonePrefixRepository) GetPhonePrefixByIdRepository(id string) (*model.PhonePrefix, error) {
+	var prefix model.PhonePrefix
+
+	result := r.db.First(&prefix, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("phone prefix with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting phone prefix with ID %s: %s", id, result.Error)
+	}
+
+	return &prefix, nil
+}
+
+func (r *phonePrefixRepository) GetPhonePrefixByPrefixRepository(prefix string) (*model.PhonePrefix, error) {
+	var phonePrefix model.PhonePrefix
+
+	result := r.db.First(&phonePrefix, "prefix = ?", prefix)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting phone prefix %s: %s", prefix, result.Error)
+	}
+
+	return &phonePrefix, nil
+}
+
+// GetPhonePrefixByPhoneRepository returns the longest prefix the phone number
+// starts with, or nil when no prefix matches.
+func (r *phonePrefixRepository) GetPhonePrefixByPhoneRepository(phone string) (*model.PhonePrefix, error) {
+	var prefix model.PhonePrefix
+
+	result := r.db.Where("? LIKE prefix || '%'", phone).Order("LENGTH(prefix) DESC").First(&prefix)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting phone prefix of %s: %s", phone, result.Error)
+	}
+
+	return &prefix, nil
+}
+
+func (r *phonePrefixRepository) GetAllPhonePrefixRepository(provider string, page, limit int) ([]*model.PhonePrefix, error) {
+	var prefixes []*model.PhonePrefix
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if provider != "" {
+		query = query.Where("LOWER(provider) = LOWER(?)", provider)
+	}
+
+	result := query.Order("prefix ASC").Find(&prefixes)
+	if result.Error != nil {
+		return nil, errors.New("failed to get phone prefixes")
+	}
+
+	return prefixes, nil
+}
+
+func (r *phonePrefixRepository) UpdatePhonePrefixByIdRepository(id string, prefix *model.PhonePrefix) (*model.PhonePrefix, error) {
+	result := r.db.Model(&model.PhonePrefix{}).Where("id = ?", id).Updates(prefix)
+	if result.Error != nil {
+		return nil, errors.New("failed to update phone prefix")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("phone prefix not found")
+	}
+
+	return prefix, nil
+}
+
+func (r *phonePrefixRepository) DeletePhonePrefixByIdRepository(id string) error {
+	result := r.db.Delete(&model.PhonePrefix{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("phone prefix not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type PlnTariffRepository interface {
+	CreatePlnTariffRepository(tariff *model.PlnTariff) (*model.PlnTariff, error)
+	GetPlnTariffByIdRepository(id string) (*model.PlnTariff, error)
+	GetPlnTariffByPowerRepository(segment string, power int) (*model.PlnTariff, error)
+	GetAllPlnTariffRepository(page, limit int) ([]*model.PlnTariff, error)
+	UpdatePlnTariffByIdRepository(id string, tariff *model.PlnTariff) (*model.PlnTariff, error)
+	DeletePlnTariffByIdRepository(id string) error
+}
+
+type plnTariffRepository struct {
+	db *gorm.DB
+}
+
+func NewPlnTariffRepository(db *gorm.DB) *plnTariffRepository {
+	return &plnTariffRepository{db}
+}
+
+func (r *plnTariffRepository) CreatePlnTariffRepository(tariff *model.PlnTariff) (*model.PlnTariff, error) {
+	result := r.db.Create(tariff)
+	if result.Error != nil {
+		return nil, errors.New("failed to create PLN tariff")
+	}
+
+	return tariff, nil
+}
+
+func (r *plnTariffRepository) GetPlnTariffByIdRepository(id string) (*model.PlnTariff, error) {
+	var tariff model.PlnTariff
+
+	result := r.db.First(&tariff, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("PLN tariff with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting PLN tariff with ID %s: %s", id, result.Error)
+	}
+
+	return &tariff, nil
+}
+
+// GetPlnTariffByPowerRepository returns the segment's tariff whose band covers
+// power. Where bands overlap, e.g. subsidised and non-subsidised 900 VA, the
+// lowest rate wins.
+func (r *plnTariffRepository) GetPlnTariffByPowerRepository(segment string, power int) (*model.PlnTariff, error) {
+	var tariff model.PlnTariff
+
+	result := r.db.Where("class LIKE ? AND min_power <= ? AND (max_power = 0 OR max_power >= ?)", segment+"%", power, power).
+		Order("rate_per_kwh ASC").
+		First(&tariff)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting PLN tariff for %d VA: %s", power, result.Error)
+	}
+
+	return &tariff, nil
+}
+
+func (r *plnTariffRepository) GetAllPlnTariffRepository(page, limit int) ([]*model.PlnTariff, error) {
+	var tariffs []*model.PlnTariff
+
+	offset := (page - 1) * limit
+
+	result := r.db.Offset(offset).Limit(limit).Order("class ASC, min_power ASC").Find(&tariffs)
+	if result.Error != nil {
+		return nil, errors.New("failed to get PLN tariffs")
+	}
+
+	return tariffs, nil
+}
+
+func (r *plnTariffRepository) UpdatePlnTariffByIdRepository(id string, tariff *model.PlnTariff) (*model.PlnTariff, error) {
+	result := r.db.Model(&model.PlnTariff{}).Where("id = ?", id).Updates(tariff)
+	if result.Error != nil {
+		return nil, errors.New("failed to update PLN tariff")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("PLN tariff not found")
+	}
+
+	return tariff, nil
+}
+
+func (r *plnTariffRepository) DeletePlnTariffByIdRepository(id string) error {
+	result := r.db.Delete(&model.PlnTariff{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("PLN tariff not found")
+	}
+
+	return nil
+}
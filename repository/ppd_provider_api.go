@@ -0,0 +1,47 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+)
+
+type PPDProviderRepository interface {
+	PurchasePPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error)
+	PurchaseStatusPPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error)
+}
+
+// ppdSandboxRepository stands in for the pulsa and data operator until it is
+// integrated. Purchases are settled by sandboxStatus.
+type ppdSandboxRepository struct{}
+
+func NewPPDSandboxRepository() PPDProviderRepository {
+	return &ppdSandboxRepository{}
+}
+
+func (r *ppdSandboxRepository) PurchasePPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error) {
+	if transactionID == "" {
+		return nil, errors.New("transaction ID is required")
+	}
+
+	return r.PurchaseStatusPPDRepository(transactionID, detail)
+}
+
+// PurchaseStatusPPDRepository looks a purchase up by the transaction ID it was
+// sent with.
+func (*ppdSandboxRepository) PurchaseStatusPPDRepository(transactionID string, detail *model.TransactionPPD) (*model.PPDPurchaseResult, error) {
+	result := &model.PPDPurchaseResult{
+		Status:            sandboxStatus(detail.Phone),
+		ProviderReference: sandboxNumber(transactionID, "ppd", 16),
+	}
+
+	switch result.Status {
+	case model.STATUS_PROCESSING:
+		result.Message = "purchase is pending at the provider"
+	case model.STATUS_FAIL:
+		result.Message = "purchase rejected by the provider"
+	default:
+		result.SerialNumber = sandboxNumber(transactionID, "serial", 20)
+	}
+
+	return result, nil
+}
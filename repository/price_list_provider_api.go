@@ -0,0 +1,53 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+)
+
+type PriceListProviderRepository interface {
+	GetPriceListRepository(catalog string) ([]model.ProviderPrice, error)
+}
+
+// priceListApiRepository pulls provider price lists as JSON arrays from
+// {baseURL}/{catalog}.
+type priceListApiRepository struct {
+	baseURL string
+}
+
+func NewPriceListApiRepository(baseURL string) PriceListProviderRepository {
+	return &priceListApiRepository{baseURL: strings.TrimRight(baseURL, "/")}
+}
+
+func (r *priceListApiRepository) GetPriceListRepository(catalog string) ([]model.ProviderPrice, error) {
+	if r.baseURL == "" {
+		return nil, errors.New("price list URL is not configured")
+	}
+
+	resp, err := doRequest(http.MethodGet, fmt.Sprintf("%s/%s", r.baseURL, catalog), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("price list provider returned %s", resp.Status)
+	}
+
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.New("error reading response body")
+	}
+
+	var prices []model.ProviderPrice
+	if err := json.Unmarshal(body, &prices); err != nil {
+		return nil, fmt.Errorf("error parsing response body: %w", err)
+	}
+
+	return prices, nil
+}
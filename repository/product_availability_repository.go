@@ -0,0 +1,187 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type ProductAvailabilityRepository interface {
+	CreateProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error)
+	GetProductAvailabilityByIdRepository(id string) (*model.ProductAvailability, error)
+	GetProductAvailabilityByProviderRepository(product, provider string) (*model.ProductAvailability, error)
+	GetProductAvailabilityRepository(product, provider string) ([]model.ProductAvailability, error)
+	GetAllProductAvailabilityRepository(product string, page, limit int) ([]*model.ProductAvailability, error)
+	SaveProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error)
+	AddProductAvailabilityFailureRepository(product, provider string) error
+	TripProductAvailabilityRepository(product, provider string, threshold int, until time.Time, message string) (bool, error)
+	ResetProductAvailabilityFailuresRepository(product, provider string) error
+	DeleteProductAvailabilityByIdRepository(id string) error
+}
+
+type productAvailabilityRepository struct {
+	db *gorm.DB
+}
+
+func NewProductAvailabilityRepository(db *gorm.DB) *productAvailabilityRepository {
+	return &productAvailabilityRepository{db}
+}
+
+func (r *productAvailabilityRepository) CreateProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error) {
+	result := r.db.Create(availability)
+	if result.Error != nil {
+		return nil, errors.New("failed to create product availability")
+	}
+
+	return availability, nil
+}
+
+func (r *productAvailabilityRepository) GetProductAvailabilityByIdRepository(id string) (*model.ProductAvailability, error) {
+	var availability model.ProductAvailability
+
+	result := r.db.First(&availability, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("product availability with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting product availability with ID %s: %s", id, result.Error)
+	}
+
+	return &availability, nil
+}
+
+// GetProductAvailabilityByProviderRepository returns the availability of
+// exactly this product and provider, or nil when there is none.
+func (r *productAvailabilityRepository) GetProductAvailabilityByProviderRepository(product, provider string) (*model.ProductAvailability, error) {
+	var availability model.ProductAvailability
+
+	result := r.db.Where("product = ? AND provider = ?", product, provider).First(&availability)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting availability of %s: %s", product, result.Error)
+	}
+
+	return &availability, nil
+}
+
+// GetProductAvailabilityRepository returns what applies to a provider of a
+// product: its own availability and the one of the whole product.
+func (r *productAvailabilityRepository) GetProductAvailabilityRepository(product, provider string) ([]model.ProductAvailability, error) {
+	var availabilities []model.ProductAvailability
+
+	result := r.db.Where("product = ? AND provider IN ?", product, []string{"", provider}).Find(&availabilities)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting availability of %s: %s", product, result.Error)
+	}
+
+	return availabilities, nil
+}
+
+func (r *productAvailabilityRepository) GetAllProductAvailabilityRepository(product string, page, limit int) ([]*model.ProductAvailability, error) {
+	var availabilities []*model.ProductAvailability
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if product != "" {
+		query = query.Where("product = ?", product)
+	}
+
+	result := query.Order("product ASC, provider ASC").Find(&availabilities)
+	if result.Error != nil {
+		return nil, errors.New("failed to get product availabilities")
+	}
+
+	return availabilities, nil
+}
+
+// SaveProductAvailabilityRepository writes every field, so maintenance and
+// failure counts can be cleared.
+func (r *productAvailabilityRepository) SaveProductAvailabilityRepository(availability *model.ProductAvailability) (*model.ProductAvailability, error) {
+	result := r.db.Save(availability)
+	if result.Error != nil {
+		return nil, errors.New("failed to save product availability")
+	}
+
+	return availability, nil
+}
+
+// breakerSources are the maintenance sources the circuit breaker may change.
+// Rows an admin put in maintenance are never touched by it.
+var breakerSources = []string{"", model.MAINTENANCE_CIRCUIT_BREAKER}
+
+// AddProductAvailabilityFailureRepository counts a failed provider call with
+// failures = failures + 1, creating the provider's row on its first failure.
+func (r *productAvailabilityRepository) AddProductAvailabilityFailureRepository(product, provider string) error {
+	err := r.db.Clauses(clause.OnConflict{DoNothing: true}).
+		Create(&model.ProductAvailability{Product: product, Provider: provider}).Error
+	if err != nil {
+		return fmt.Errorf("error creating availability of %s: %s", product, err)
+	}
+
+	err = r.db.Model(&model.ProductAvailability{}).
+		Where("product = ? AND provider = ? AND maintenance_source IN ?", product, provider, breakerSources).
+		Update("failures", gorm.Expr("failures + 1")).Error
+	if err != nil {
+		return fmt.Errorf("error counting failure of %s: %s", product, err)
+	}
+
+	return nil
+}
+
+// TripProductAvailabilityRepository puts the provider in circuit breaker
+// maintenance until the given time once it failed threshold times in a row.
+// It reports whether it did.
+func (r *productAvailabilityRepository) TripProductAvailabilityRepository(product, provider string, threshold int, until time.Time, message string) (bool, error) {
+	result := r.db.Model(&model.ProductAvailability{}).
+		Where("product = ? AND provider = ? AND maintenance_source IN ? AND failures >= ?", product, provider, breakerSources, threshold).
+		Updates(map[string]interface{}{
+			"maintenance":        true,
+			"maintenance_source": model.MAINTENANCE_CIRCUIT_BREAKER,
+			"maintenance_until":  until,
+			"message":            message,
+		})
+	if result.Error != nil {
+		return false, fmt.Errorf("error tripping availability of %s: %s", product, result.Error)
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// ResetProductAvailabilityFailuresRepository clears the failure count and ends
+// circuit breaker maintenance after a successful provider call.
+func (r *productAvailabilityRepository) ResetProductAvailabilityFailuresRepository(product, provider string) error {
+	err := r.db.Model(&model.ProductAvailability{}).
+		Where("product = ? AND provider = ? AND maintenance_source IN ?", product, provider, breakerSources).
+		Where("failures > 0 OR maintenance_source = ?", model.MAINTENANCE_CIRCUIT_BREAKER).
+		Updates(map[string]interface{}{
+			"failures":           0,
+			"maintenance":        false,
+			"maintenance_source": "",
+			"maintenance_until":  nil,
+			"message":            "",
+		}).Error
+	if err != nil {
+		return fmt.Errorf("error resetting availability of %s: %s", product, err)
+	}
+
+	return nil
+}
+
+func (r *productAvailabilityRepository) DeleteProductAvailabilityByIdRepository(id string) error {
+	result := r.db.Delete(&model.ProductAvailability{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("product availability not found")
+	}
+
+	return nil
+}
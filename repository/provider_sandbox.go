@@ -0,0 +1,45 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"crypto/sha256"
+	"hash/fnv"
+	"strings"
+)
+
+// sandboxStatus is how the provider sandboxes settle an order for a target
+// number, e.g. a phone or account number. Orders for numbers ending in 8 stay
+// pending, those ending in 7 are rejected and the rest succeed.
+func sandboxStatus(target string) string {
+	switch {
+	case strings.HasSuffix(target, "8"):
+		return model.STATUS_PROCESSING
+	case strings.HasSuffix(target, "7"):
+		return model.STATUS_FAIL
+	}
+
+	return model.STATUS_SUCCESSFUL
+}
+
+// sandboxPick picks a stable entry of names for an account, so the sandboxes
+// always return the same account holder for it.
+func sandboxPick(names []string, account string) string {
+	h := fnv.New32a()
+	h.Write([]byte(account))
+
+	return names[h.Sum32()%uint32(len(names))]
+}
+
+// sandboxNumber derives a number of up to 32 digits from an order's
+// transaction ID, so asking a sandbox about the same order again gives the
+// same reference or serial number.
+func sandboxNumber(transactionID, kind string, digits int) string {
+	sum := sha256.Sum256([]byte(kind + ":" + transactionID))
+
+	number := make([]byte, digits)
+	for i := range number {
+		number[i] = '0' + sum[i]%10
+	}
+
+	return string(number)
+}
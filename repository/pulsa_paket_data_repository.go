@@ -13,6 +13,7 @@ type PulsaPaketDataRepository interface {
 	CreatePulsaPaketData(data model.PulsaPaketData) (model.PulsaPaketData, error)
 	GetAllPulsaPaketData(data dto.PulsaDto, isUser *bool) ([]model.PulsaPaketData, error)
 	GetPulsaPaketDataById(id string) (model.PulsaPaketData, error)
+	GetPulsaPaketDataCatalog() ([]model.PulsaPaketData, error)
 	UpdatePulsaById(id string, data model.PulsaPaketData) error
 	DeletePulsaById(id string) error
 }
@@ -71,6 +72,16 @@ func (r *pulsaPaketDataRepository) GetPulsaPaketDataById(id string) (model.Pulsa
 	return ppd, nil
 }
 
+func (r *pulsaPaketDataRepository) GetPulsaPaketDataCatalog() ([]model.PulsaPaketData, error) {
+	var ppd []model.PulsaPaketData
+
+	if err := r.db.Order("code ASC").Find(&ppd).Error; err != nil {
+		return ppd, fmt.Errorf("error getting pulsa and paket data catalog: %s", err)
+	}
+
+	return ppd, nil
+}
+
 func (r *pulsaPaketDataRepository) UpdatePulsaById(id string, data model.PulsaPaketData) error {
 	fmt.Printf("test::: %+v\n", data)
 
@@ -0,0 +1,149 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type SavedBillerRepository interface {
+	CreateSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error)
+	GetSavedBillerByIdRepository(id string) (*model.SavedBiller, error)
+	GetSavedBillerByCustomerIdRepository(userID, productID, customerID string) (*model.SavedBiller, error)
+	GetSavedBillersByUserIdRepository(userID, category string, page, limit int) ([]*model.SavedBiller, error)
+	GetAllSavedBillersByUserIdRepository(userID string) ([]*model.SavedBiller, error)
+	GetAllSavedBillersRepository() ([]*model.SavedBiller, error)
+	UpdateSavedBillerByIdRepository(id string, biller *model.SavedBiller) (*model.SavedBiller, error)
+	UpsertSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error)
+	DeleteSavedBillerByIdRepository(id string) error
+}
+
+type savedBillerRepository struct {
+	db *gorm.DB
+}
+
+func NewSavedBillerRepository(db *gorm.DB) *savedBillerRepository {
+	return &savedBillerRepository{db}
+}
+
+func (r *savedBillerRepository) CreateSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error) {
+	result := r.db.Create(biller)
+	if result.Error != nil {
+		return nil, errors.New("failed to create saved biller")
+	}
+
+	return biller, nil
+}
+
+func (r *savedBillerRepository) GetSavedBillerByIdRepository(id string) (*model.SavedBiller, error) {
+	var biller model.SavedBiller
+
+	result := r.db.First(&biller, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("saved biller with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting saved biller with ID %s: %s", id, result.Error)
+	}
+
+	return &biller, nil
+}
+
+func (r *savedBillerRepository) GetSavedBillerByCustomerIdRepository(userID, productID, customerID string) (*model.SavedBiller, error) {
+	var biller model.SavedBiller
+
+	result := r.db.Where("user_id = ? AND product_id = ? AND customer_id = ?", userID, productID, customerID).First(&biller)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting saved biller: %s", result.Error)
+	}
+
+	return &biller, nil
+}
+
+func (r *savedBillerRepository) GetSavedBillersByUserIdRepository(userID, category string, page, limit int) ([]*model.SavedBiller, error) {
+	var billers []*model.SavedBiller
+
+	offset := (page - 1) * limit
+
+	query := r.db.Where("user_id = ?", userID).Offset(offset).Limit(limit)
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	result := query.Order("updated_at DESC").Find(&billers)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting saved billers: %s", result.Error)
+	}
+
+	return billers, nil
+}
+
+func (r *savedBillerRepository) GetAllSavedBillersByUserIdRepository(userID string) ([]*model.SavedBiller, error) {
+	var billers []*model.SavedBiller
+
+	result := r.db.Where("user_id = ?", userID).Order("updated_at DESC").Find(&billers)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting saved billers: %s", result.Error)
+	}
+
+	return billers, nil
+}
+
+func (r *savedBillerRepository) GetAllSavedBillersRepository() ([]*model.SavedBiller, error) {
+	var billers []*model.SavedBiller
+
+	result := r.db.Order("user_id ASC").Find(&billers)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting saved billers: %s", result.Error)
+	}
+
+	return billers, nil
+}
+
+func (r *savedBillerRepository) UpdateSavedBillerByIdRepository(id string, biller *model.SavedBiller) (*model.SavedBiller, error) {
+	result := r.db.Model(&model.SavedBiller{}).Where("id = ?", id).Updates(biller)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("saved biller not found")
+	}
+
+	return biller, nil
+}
+
+// UpsertSavedBillerRepository keeps the nickname of an existing biller and only refreshes its payment details.
+func (r *savedBillerRepository) UpsertSavedBillerRepository(biller *model.SavedBiller) (*model.SavedBiller, error) {
+	existing, err := r.GetSavedBillerByCustomerIdRepository(biller.UserID, biller.ProductId, biller.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	if existing == nil {
+		return r.CreateSavedBillerRepository(biller)
+	}
+
+	existing.Category = biller.Category
+	existing.ProviderName = biller.ProviderName
+	existing.LastPaidPeriod = biller.LastPaidPeriod
+	existing.LastPaidAmount = biller.LastPaidAmount
+	existing.LastPaidAt = biller.LastPaidAt
+
+	return r.UpdateSavedBillerByIdRepository(existing.ID, existing)
+}
+
+func (r *savedBillerRepository) DeleteSavedBillerByIdRepository(id string) error {
+	result := r.db.Delete(&model.SavedBiller{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("saved biller not found")
+	}
+
+	return nil
+}
@@ -0,0 +1,110 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+type ScheduledTransferRepository interface {
+	CreateScheduledTransferRepository(schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error)
+	GetScheduledTransferByIdRepository(id string) (*model.ScheduledTransfer, error)
+	GetScheduledTransfersByUserIdRepository(userID, status string, page, limit int) ([]*model.ScheduledTransfer, error)
+	GetDueScheduledTransfersRepository(now time.Time) ([]*model.ScheduledTransfer, error)
+	UpdateScheduledTransferByIdRepository(id string, schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error)
+	ClaimScheduledTransferRunRepository(id string, dueAt time.Time, claimed *model.ScheduledTransfer) (bool, error)
+}
+
+type scheduledTransferRepository struct {
+	db *gorm.DB
+}
+
+func NewScheduledTransferRepository(db *gorm.DB) *scheduledTransferRepository {
+	return &scheduledTransferRepository{db}
+}
+
+func (r *scheduledTransferRepository) CreateScheduledTransferRepository(schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	result := r.db.Create(schedule)
+	if result.Error != nil {
+		return nil, errors.New("failed to create scheduled transfer")
+	}
+
+	return schedule, nil
+}
+
+func (r *scheduledTransferRepository) GetScheduledTransferByIdRepository(id string) (*model.ScheduledTransfer, error) {
+	var schedule model.ScheduledTransfer
+
+	result := r.db.First(&schedule, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("scheduled transfer with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting scheduled transfer with ID %s: %s", id, result.Error)
+	}
+
+	return &schedule, nil
+}
+
+func (r *scheduledTransferRepository) GetScheduledTransfersByUserIdRepository(userID, status string, page, limit int) ([]*model.ScheduledTransfer, error) {
+	var schedules []*model.ScheduledTransfer
+
+	offset := (page - 1) * limit
+
+	query := r.db.Where("user_id = ?", userID).Offset(offset).Limit(limit)
+	if status != "" {
+		query = query.Where("status = ?", status)
+	}
+
+	result := query.Order("created_at DESC").Find(&schedules)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting scheduled transfers: %s", result.Error)
+	}
+
+	return schedules, nil
+}
+
+func (r *scheduledTransferRepository) GetDueScheduledTransfersRepository(now time.Time) ([]*model.ScheduledTransfer, error) {
+	var schedules []*model.ScheduledTransfer
+
+	result := r.db.Where("status = ? AND next_run_at <= ?", model.SCHEDULE_STATUS_ACTIVE, now).Order("next_run_at ASC").Find(&schedules)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting due scheduled transfers: %s", result.Error)
+	}
+
+	return schedules, nil
+}
+
+func (r *scheduledTransferRepository) UpdateScheduledTransferByIdRepository(id string, schedule *model.ScheduledTransfer) (*model.ScheduledTransfer, error) {
+	// Select all columns so that counters and errors can be reset to their zero value.
+	result := r.db.Model(&model.ScheduledTransfer{}).Where("id = ?", id).Select("*").Omit("id", "created_at").Updates(schedule)
+	if result.Error != nil {
+		return nil, result.Error
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("scheduled transfer not found")
+	}
+
+	return schedule, nil
+}
+
+// ClaimScheduledTransferRunRepository moves an active schedule that is still
+// due at dueAt on to the status and next run of claimed. It reports false when
+// another run has already moved the schedule on.
+func (r *scheduledTransferRepository) ClaimScheduledTransferRunRepository(id string, dueAt time.Time, claimed *model.ScheduledTransfer) (bool, error) {
+	result := r.db.Model(&model.ScheduledTransfer{}).
+		Where("id = ? AND status = ? AND next_run_at = ?", id, model.SCHEDULE_STATUS_ACTIVE, dueAt).
+		Updates(map[string]interface{}{
+			"status":      claimed.Status,
+			"next_run_at": claimed.NextRunAt,
+			"updated_at":  claimed.UpdatedAt,
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+)
+
+type SessionRepository interface {
+	CreateSessionRepository(session *model.Session) (*model.Session, error)
+	GetSessionByIdRepository(id string) (*model.Session, error)
+	GetSessionByRefreshTokenRepository(refreshTokenHash string) (*model.Session, error)
+	GetActiveSessionsByUserIdRepository(userID string, now time.Time) ([]*model.Session, error)
+	RotateSessionRepository(session *model.Session, refreshTokenHash string) (bool, error)
+	RevokeSessionRepository(id string, now time.Time) (bool, error)
+	RevokeTokenRepository(token *model.RevokedToken) error
+	IsTokenRevokedRepository(id string) (bool, error)
+	DeleteExpiredSessionsRepository(now time.Time) error
+}
+
+type sessionRepository struct {
+	db *gorm.DB
+}
+
+func NewSessionRepository(db *gorm.DB) *sessionRepository {
+	return &sessionRepository{db}
+}
+
+func (r *sessionRepository) CreateSessionRepository(session *model.Session) (*model.Session, error) {
+	result := r.db.Create(session)
+	if result.Error != nil {
+		return nil, errors.New("failed to create session")
+	}
+
+	return session, nil
+}
+
+func (r *sessionRepository) GetSessionByIdRepository(id string) (*model.Session, error) {
+	var session model.Session
+
+	result := r.db.First(&session, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("session with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting session with ID %s: %s", id, result.Error)
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepository) GetSessionByRefreshTokenRepository(refreshTokenHash string) (*model.Session, error) {
+	var session model.Session
+
+	result := r.db.First(&session, "refresh_token_hash = ?", refreshTokenHash)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting session: %s", result.Error)
+	}
+
+	return &session, nil
+}
+
+func (r *sessionRepository) GetActiveSessionsByUserIdRepository(userID string, now time.Time) ([]*model.Session, error) {
+	var sessions []*model.Session
+
+	result := r.db.Where("user_id = ? AND revoked_at IS NULL AND expires_at > ?", userID, now).Find(&sessions)
+	if result.Error != nil {
+		return nil, fmt.Errorf("error getting sessions of user %s: %s", userID, result.Error)
+	}
+
+	return sessions, nil
+}
+
+// RotateSessionRepository stores the tokens newly issued to a session, but only
+// while it still has the refresh token refreshTokenHash and is not revoked.
+// It reports false when another refresh or a logout got there first.
+func (r *sessionRepository) RotateSessionRepository(session *model.Session, refreshTokenHash string) (bool, error) {
+	result := r.db.Model(&model.Session{}).
+		Where("id = ? AND refresh_token_hash = ? AND revoked_at IS NULL", session.ID, refreshTokenHash).
+		Updates(map[string]interface{}{
+			"refresh_token_hash": session.RefreshTokenHash,
+			"access_token_id":    session.AccessTokenID,
+			"access_expires_at":  session.AccessExpiresAt,
+			"updated_at":         time.Now(),
+		})
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RevokeSessionRepository revokes a session that is not revoked yet and
+// denylists the access token it was last issued, unless that has expired, in
+// one database transaction. It reports false when the session was already
+// revoked, e.g. by a concurrent logout.
+func (r *sessionRepository) RevokeSessionRepository(id string, now time.Time) (bool, error) {
+	revoked := false
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		var session model.Session
+		result := tx.Model(&session).
+			Clauses(clause.Returning{Columns: []clause.Column{{Name: "access_token_id"}, {Name: "access_expires_at"}}}).
+			Where("id = ? AND revoked_at IS NULL", id).
+			Updates(map[string]interface{}{"revoked_at": now, "updated_at": time.Now()})
+		if result.Error != nil {
+			return result.Error
+		}
+		if result.RowsAffected == 0 {
+			return nil
+		}
+
+		revoked = true
+		if session.AccessTokenID == "" || !now.Before(session.AccessExpiresAt) {
+			return nil
+		}
+
+		return tx.Clauses(clause.OnConflict{DoNothing: true}).
+			Create(&model.RevokedToken{ID: session.AccessTokenID, ExpiresAt: session.AccessExpiresAt}).Error
+	})
+	if err != nil {
+		return false, err
+	}
+
+	return revoked, nil
+}
+
+// RevokeTokenRepository adds an access token ID to the denylist. Revoking a
+// token twice is not an error.
+func (r *sessionRepository) RevokeTokenRepository(token *model.RevokedToken) error {
+	return r.db.Clauses(clause.OnConflict{DoNothing: true}).Create(token).Error
+}
+
+func (r *sessionRepository) IsTokenRevokedRepository(id string) (bool, error) {
+	var count int64
+
+	if err := r.db.Model(&model.RevokedToken{}).Where("id = ?", id).Count(&count).Error; err != nil {
+		return false, err
+	}
+
+	return count > 0, nil
+}
+
+// DeleteExpiredSessionsRepository removes sessions that can no longer be
+// refreshed and denylist entries of access tokens that have expired anyway.
+func (r *sessionRepository) DeleteExpiredSessionsRepository(now time.Time) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := tx.Unscoped().Where("expires_at <= ? OR revoked_at IS NOT NULL", now).Delete(&model.Session{}).Error; err != nil {
+			return err
+		}
+		return tx.Where("expires_at <= ?", now).Delete(&model.RevokedToken{}).Error
+	})
+}
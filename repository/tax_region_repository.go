@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type TaxRegionRepository interface {
+	CreateTaxRegionRepository(region *model.TaxRegion) (*model.TaxRegion, error)
+	GetTaxRegionByIdRepository(id string) (*model.TaxRegion, error)
+	GetTaxRegionByCodeRepository(code string) (*model.TaxRegion, error)
+	GetAllTaxRegionRepository(category string, page, limit int) ([]*model.TaxRegion, error)
+	UpdateTaxRegionByIdRepository(id string, region *model.TaxRegion) (*model.TaxRegion, error)
+	DeleteTaxRegionByIdRepository(id string) error
+}
+
+type taxRegionRepository struct {
+	db *gorm.DB
+}
+
+func NewTaxRegionRepository(db *gorm.DB) *taxRegionRepository {
+	return &taxRegionRepository{db}
+}
+
+func (r *taxRegionRepository) CreateTaxRegionRepository(region *model.TaxRegion) (*model.TaxRegion, error) {
+	result := r.db.Create(region)
+	if result.Error != nil {
+		return nil, errors.New("failed to create tax region")
+	}
+
+	return region, nil
+}
+
+func (r *taxRegionRepository) GetTaxRegionByIdRepository(id string) (*model.TaxRegion, error) {
+	var region model.TaxRegion
+
+	result := r.db.First(&region, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, fmt.Errorf("tax region with ID %s not found", id)
+		}
+		return nil, fmt.Errorf("error getting tax region with ID %s: %s", id, result.Error)
+	}
+
+	return &region, nil
+}
+
+func (r *taxRegionRepository) GetTaxRegionByCodeRepository(code string) (*model.TaxRegion, error) {
+	var region model.TaxRegion
+
+	result := r.db.First(&region, "code = ?", code)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("error getting tax region %s: %s", code, result.Error)
+	}
+
+	return &region, nil
+}
+
+func (r *taxRegionRepository) GetAllTaxRegionRepository(category string, page, limit int) ([]*model.TaxRegion, error) {
+	var regions []*model.TaxRegion
+
+	offset := (page - 1) * limit
+
+	query := r.db.Offset(offset).Limit(limit)
+	if category != "" {
+		query = query.Where("category = ?", category)
+	}
+
+	result := query.Order("name ASC").Find(&regions)
+	if result.Error != nil {
+		return nil, errors.New("failed to get tax regions")
+	}
+
+	return regions, nil
+}
+
+func (r *taxRegionRepository) UpdateTaxRegionByIdRepository(id string, region *model.TaxRegion) (*model.TaxRegion, error) {
+	result := r.db.Model(&model.TaxRegion{}).Where("id = ?", id).Updates(region)
+	if result.Error != nil {
+		return nil, errors.New("failed to update tax region")
+	}
+	if result.RowsAffected == 0 {
+		return nil, errors.New("tax region not found")
+	}
+
+	return region, nil
+}
+
+func (r *taxRegionRepository) DeleteTaxRegionByIdRepository(id string) error {
+	result := r.db.Delete(&model.TaxRegion{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("tax region not found")
+	}
+
+	return nil
+}
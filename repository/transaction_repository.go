@@ -11,16 +11,27 @@ import (
 
 type TransactionRepository interface {
 	CreateTransactionByUserIdRepository(transaction *model.Transaction) (*model.Transaction, error)
+	CreatePaidTransactionRepository(transaction *model.Transaction) (*model.Transaction, error)
 	GetAllTransactionsRepository(page, limit int) ([]*model.Transaction, error)
 	GetTransactionByIdRepository(transactionID string) (*model.Transaction, error)
 	GetTransactionByUserIdRepository(userID, productType string, page, limit int) ([]*model.Transaction, error)
 	GetProductDetailsByPeriodAndCustomerID(payload model.GetProductDetail) (*model.Transaction, error)
+	GetLastPaidBillRepository(prefix, productType, customerID string) (*model.Transaction, error)
+	GetPaidBillsSinceRepository(prefix, customerID string, since time.Time) ([]*model.Transaction, error)
 	GetTransactionsProductTypeRepository(productType, status string, page, limit int) ([]*model.Transaction, error)
+	GetProcessingTransactionsByPrefixRepository(prefix string, limit int) ([]*model.Transaction, error)
+	UpdateProcessingTransactionRepository(id string, transaction *model.Transaction) error
+	SubmitProcessingTransactionRepository(id string, transaction *model.Transaction) (bool, error)
+	RefundProcessingTransactionRepository(transaction, update *model.Transaction) error
 	UpdateTransactionByIdRepository(userId string, transaction *model.Transaction) (*model.Transaction, error)
+	SettleTransactionRepository(id string, transaction *model.Transaction) (*model.Transaction, error)
+	PayTransactionRepository(id, userID string, transaction *model.Transaction) (*model.Transaction, error)
 	GetTransactionsByQueryRepository(query string, page, limit int) ([]*model.Transaction, error)
 	GetTransactionsByStatusQueryRepository(query, status string, page, limit int) ([]*model.Transaction, error)
 	GetTransactionsPriceCountRepository() ([]*model.Transaction, error)
 	GetTransactionsByMonthRepository(month time.Month, year int) ([]*model.Transaction, error)
+	CloseUnpaidTransactionRepository(id, status string) error
+	ExpireUnpaidTransactionsRepository(now, createdBefore time.Time) (int64, error)
 }
 
 type transactionRepository struct {
@@ -40,6 +51,25 @@ func (r *transactionRepository) CreateTransactionByUserIdRepository(transaction
 	return transaction, nil
 }
 
+// CreatePaidTransactionRepository debits the total price of a purchase from
+// its user and stores it in one database transaction, so a purchase is never
+// stored unpaid or paid for without being stored. ErrBalanceNotEnough is
+// returned when the balance does not cover it.
+func (r *transactionRepository) CreatePaidTransactionRepository(transaction *model.Transaction) (*model.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := debitUser(tx, transaction.UserID, transaction.TotalPrice); err != nil {
+			return err
+		}
+
+		return tx.Create(transaction).Error
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
 func (r *transactionRepository) GetAllTransactionsRepository(page, limit int) ([]*model.Transaction, error) {
 	var transactions []*model.Transaction
 
@@ -82,16 +112,51 @@ func (r *transactionRepository) GetTransactionByUserIdRepository(userID, product
 }
 
 func (r *transactionRepository) GetProductDetailsByPeriodAndCustomerID(payload model.GetProductDetail) (*model.Transaction, error) {
-	query := r.db.Where("product_type = ? AND product_detail::jsonb ->>'period' = ? AND product_detail::jsonb  ->>'customer_id' = ?", payload.ProductId, payload.Period, payload.CustomerId)
+	query := r.db.Where("product_type = ? AND product_detail::jsonb ->>'period' = ? AND product_detail::jsonb  ->>'customer_id' = ?", payload.ProductId, payload.Period, payload.CustomerId).
+		Where("status NOT IN ?", []string{model.STATUS_EXPIRED, model.STATUS_CANCELLED})
+
+	var transaction model.Transaction
+	err := query.Order("created_at DESC").First(&transaction).Error
+	if err != nil {
+		return nil, err
+	}
+	return &transaction, nil
+}
 
+// GetLastPaidBillRepository returns the customer's latest paid bill among the
+// transactions whose ID starts with prefix, or nil when there is none.
+func (r *transactionRepository) GetLastPaidBillRepository(prefix, productType, customerID string) (*model.Transaction, error) {
 	var transaction model.Transaction
-	err := query.First(&transaction).Error
+
+	err := r.db.Where("id LIKE ? AND product_type = ? AND product_detail::jsonb ->>'customer_id' = ?", prefix+"-%", productType, customerID).
+		Where("status = ?", model.STATUS_SUCCESSFUL).
+		Order("created_at DESC").
+		First(&transaction).Error
 	if err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, nil
+		}
 		return nil, err
 	}
+
 	return &transaction, nil
 }
 
+// GetPaidBillsSinceRepository returns the customer's paid bills created since
+// the given time among the transactions whose ID starts with prefix.
+func (r *transactionRepository) GetPaidBillsSinceRepository(prefix, customerID string, since time.Time) ([]*model.Transaction, error) {
+	var transactions []*model.Transaction
+
+	err := r.db.Where("id LIKE ? AND product_detail::jsonb ->>'customer_id' = ?", prefix+"-%", customerID).
+		Where("status = ? AND created_at >= ?", model.STATUS_SUCCESSFUL, since).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
 func (r *transactionRepository) GetTransactionsProductTypeRepository(productType, status string, page, limit int) ([]*model.Transaction, error) {
 	var transactions []*model.Transaction
 
@@ -113,6 +178,72 @@ func (r *transactionRepository) GetTransactionsProductTypeRepository(productType
 	return transactions, nil
 }
 
+// GetProcessingTransactionsByPrefixRepository returns the oldest processing
+// transactions whose ID starts with the given prefix, e.g. "EMONEY". IDs are
+// generated by the server, unlike the product type.
+func (r *transactionRepository) GetProcessingTransactionsByPrefixRepository(prefix string, limit int) ([]*model.Transaction, error) {
+	var transactions []*model.Transaction
+
+	err := r.db.Where("id LIKE ? AND status = ?", prefix+"-%", model.STATUS_PROCESSING).
+		Order("created_at ASC").
+		Limit(limit).
+		Find(&transactions).Error
+	if err != nil {
+		return nil, err
+	}
+
+	return transactions, nil
+}
+
+// UpdateProcessingTransactionRepository only updates a transaction that is
+// still processing, so a purchase finished by another run is never finished,
+// or refunded, twice.
+func (r *transactionRepository) UpdateProcessingTransactionRepository(id string, transaction *model.Transaction) error {
+	return updateProcessingTransaction(r.db, id, transaction)
+}
+
+// SubmitProcessingTransactionRepository updates a purchase that is still
+// processing and has not been sent to its provider yet. It reports whether it
+// did, so only one run ever sends a purchase.
+func (r *transactionRepository) SubmitProcessingTransactionRepository(id string, transaction *model.Transaction) (bool, error) {
+	result := r.db.Model(&model.Transaction{}).
+		Where("id = ? AND status = ? AND product_detail::jsonb ->>'submitted_at' IS NULL", id, model.STATUS_PROCESSING).
+		Updates(transaction)
+	if result.Error != nil {
+		return false, result.Error
+	}
+
+	return result.RowsAffected > 0, nil
+}
+
+// RefundProcessingTransactionRepository updates a purchase that is still
+// processing like UpdateProcessingTransactionRepository and credits its total
+// price back to its user in the same database transaction, so a failed
+// purchase is refunded exactly once.
+func (r *transactionRepository) RefundProcessingTransactionRepository(transaction, update *model.Transaction) error {
+	return r.db.Transaction(func(tx *gorm.DB) error {
+		if err := updateProcessingTransaction(tx, transaction.ID, update); err != nil {
+			return err
+		}
+
+		return creditUser(tx, transaction.UserID, transaction.TotalPrice)
+	})
+}
+
+func updateProcessingTransaction(db *gorm.DB, id string, transaction *model.Transaction) error {
+	result := db.Model(&model.Transaction{}).
+		Where("id = ? AND status = ?", id, model.STATUS_PROCESSING).
+		Updates(transaction)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("transaction is no longer processing")
+	}
+
+	return nil
+}
+
 func (r *transactionRepository) GetTransactionsByQueryRepository(query string, page, limit int) ([]*model.Transaction, error) {
 	var transactions []*model.Transaction
 
@@ -164,6 +295,87 @@ func (r *transactionRepository) UpdateTransactionByIdRepository(id string, trans
 	return transaction, nil
 }
 
+// SettleTransactionRepository only updates a transaction that is still unpaid
+// and has not expired, so a bill paid elsewhere in the meantime is never
+// settled twice and a stale inquiry is never paid. The product detail is only
+// written when one is given.
+func (r *transactionRepository) SettleTransactionRepository(id string, transaction *model.Transaction) (*model.Transaction, error) {
+	if err := settleTransaction(r.db.Where("id = ?", id), transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+// PayTransactionRepository settles the user's unpaid bill like
+// SettleTransactionRepository and debits its total price from the user in the
+// same database transaction. Nothing is written when the bill was settled in
+// the meantime or the balance is not enough.
+func (r *transactionRepository) PayTransactionRepository(id, userID string, transaction *model.Transaction) (*model.Transaction, error) {
+	err := r.db.Transaction(func(tx *gorm.DB) error {
+		if err := settleTransaction(tx.Where("id = ? AND user_id = ?", id, userID), transaction); err != nil {
+			return err
+		}
+
+		return debitUser(tx, userID, transaction.TotalPrice)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func settleTransaction(db *gorm.DB, transaction *model.Transaction) error {
+	columns := []interface{}{"admin_fee", "total_price", "updated_at"}
+	if transaction.ProductDetail != nil {
+		columns = append(columns, "product_detail")
+	}
+
+	result := db.Model(&model.Transaction{}).
+		Where("status = ? AND (expires_at IS NULL OR expires_at > ?)", model.STATUS_UNPAID, time.Now()).
+		Select("status", columns...).
+		Updates(transaction)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("transaction is no longer unpaid or has expired")
+	}
+
+	return nil
+}
+
+// CloseUnpaidTransactionRepository moves a transaction that is still unpaid to
+// status, such as expired or cancelled, so it can no longer be paid.
+func (r *transactionRepository) CloseUnpaidTransactionRepository(id, status string) error {
+	result := r.db.Model(&model.Transaction{}).
+		Where("id = ? AND status = ?", id, model.STATUS_UNPAID).
+		Updates(map[string]interface{}{"status": status, "updated_at": time.Now()})
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("transaction is no longer unpaid")
+	}
+
+	return nil
+}
+
+// ExpireUnpaidTransactionsRepository expires unpaid transactions past their
+// expiry. Transactions created before expiries were recorded expire once
+// created before createdBefore.
+func (r *transactionRepository) ExpireUnpaidTransactionsRepository(now, createdBefore time.Time) (int64, error) {
+	result := r.db.Model(&model.Transaction{}).
+		Where("status = ? AND (expires_at <= ? OR (expires_at IS NULL AND created_at <= ?))", model.STATUS_UNPAID, now, createdBefore).
+		Updates(map[string]interface{}{"status": model.STATUS_EXPIRED, "updated_at": now})
+	if result.Error != nil {
+		return 0, result.Error
+	}
+
+	return result.RowsAffected, nil
+}
+
 func (r *transactionRepository) GetTransactionsPriceCountRepository() ([]*model.Transaction, error) {
 	var transactions []*model.Transaction
 
@@ -8,6 +8,10 @@ import (
 	"gorm.io/gorm"
 )
 
+// ErrBalanceNotEnough is returned when a debit would take a user's balance
+// below zero.
+var ErrBalanceNotEnough = errors.New("your balance is not enough")
+
 type UserRepository interface {
 	GetAllUsersRepository(page, limit int, name string) ([]*model.User, error)
 	GetUserByIDRepository(id string) (*model.User, error)
@@ -17,6 +21,8 @@ type UserRepository interface {
 	DeleteUserByIDRepository(id string) error
 	InsertAmountByUserIDRepository(userID string, amount float64) error
 	UpdateUserAmountByIDRepository(id string, user *model.User) (*model.User, error)
+	DebitUserAmountRepository(id string, amount float64) error
+	CreditUserAmountRepository(id string, amount float64) error
 	GetUserByQueryRepository(query string, page, limit int) ([]*model.User, error)
 }
 
@@ -93,7 +99,8 @@ func (r *userRepository) UpdateUserAmountByIDRepository(id string, user *model.U
 		Amount: user.Amount,
 	}
 
-	result := r.db.Model(&model.User{}).Where("id = ?", id).Updates(updatedUser)
+	// Update the column directly so that an emptied balance is saved as 0.
+	result := r.db.Model(&model.User{}).Where("id = ?", id).Update("amount", updatedUser.Amount)
 	if result.Error != nil {
 		return nil, result.Error
 	}
@@ -103,6 +110,45 @@ func (r *userRepository) UpdateUserAmountByIDRepository(id string, user *model.U
 	return updatedUser, nil
 }
 
+// DebitUserAmountRepository takes amount off the balance in one statement, so
+// concurrent debits can neither overdraw the balance nor overwrite each other.
+func (r *userRepository) DebitUserAmountRepository(id string, amount float64) error {
+	return debitUser(r.db, id, amount)
+}
+
+// CreditUserAmountRepository adds amount to the balance in one statement.
+func (r *userRepository) CreditUserAmountRepository(id string, amount float64) error {
+	return creditUser(r.db, id, amount)
+}
+
+func debitUser(db *gorm.DB, id string, amount float64) error {
+	result := db.Model(&model.User{}).
+		Where("id = ? AND amount >= ?", id, amount).
+		Update("amount", gorm.Expr("amount - ?", amount))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return ErrBalanceNotEnough
+	}
+
+	return nil
+}
+
+func creditUser(db *gorm.DB, id string, amount float64) error {
+	result := db.Model(&model.User{}).
+		Where("id = ?", id).
+		Update("amount", gorm.Expr("amount + ?", amount))
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("user not found")
+	}
+
+	return nil
+}
+
 func (r *userRepository) GetUserByPhone(phone string) (*model.User, error) {
 	var user model.User
 	result := r.db.Where("phone = ?", phone).First(&user)
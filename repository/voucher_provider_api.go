@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+type VoucherProviderRepository interface {
+	NicknameInquiryRepository(brandCode, userId, zoneId string) (*model.VoucherAccount, error)
+	PurchaseVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error)
+	PurchaseStatusVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error)
+}
+
+// voucherSandboxRepository stands in for the voucher aggregator until it is
+// integrated. Player IDs ending in 9 do not exist; purchases are settled by
+// sandboxStatus.
+type voucherSandboxRepository struct{}
+
+var sandboxNicknames = []string{"ShadowHunter", "NoobMaster69", "KucingOren", "RajaPush", "SiGanteng", "MageAbadi"}
+
+func NewVoucherSandboxRepository() VoucherProviderRepository {
+	return &voucherSandboxRepository{}
+}
+
+func (*voucherSandboxRepository) NicknameInquiryRepository(brandCode, userId, zoneId string) (*model.VoucherAccount, error) {
+	if strings.HasSuffix(userId, "9") {
+		return nil, fmt.Errorf("%s player %s not found", brandCode, userId)
+	}
+
+	return &model.VoucherAccount{
+		UserId:   userId,
+		ZoneId:   zoneId,
+		Nickname: sandboxPick(sandboxNicknames, brandCode+userId+zoneId),
+	}, nil
+}
+
+func (r *voucherSandboxRepository) PurchaseVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error) {
+	if transactionID == "" {
+		return nil, errors.New("transaction ID is required")
+	}
+
+	return r.PurchaseStatusVoucherRepository(transactionID, detail)
+}
+
+// PurchaseStatusVoucherRepository looks a purchase up by the transaction ID
+// it was sent with.
+func (*voucherSandboxRepository) PurchaseStatusVoucherRepository(transactionID string, detail *model.TransactionVoucher) (*model.VoucherPurchaseResult, error) {
+	result := &model.VoucherPurchaseResult{Status: sandboxStatus(detail.UserId)}
+
+	switch result.Status {
+	case model.STATUS_PROCESSING:
+		result.Message = "purchase is pending at the provider"
+	case model.STATUS_FAIL:
+		result.Message = "purchase rejected by the provider"
+	default:
+		result.ReferenceNumber = sandboxNumber(transactionID, "reference", 12)
+		if detail.Delivery == model.VOUCHER_DELIVERY_CODE {
+			code := sandboxNumber(transactionID, "voucher", 16)
+			result.Code = fmt.Sprintf("%s-%s-%s-%s", code[:4], code[4:8], code[8:12], code[12:])
+		}
+	}
+
+	return result, nil
+}
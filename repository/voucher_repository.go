@@ -0,0 +1,159 @@
+package repository
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"errors"
+	"fmt"
+
+	"gorm.io/gorm"
+)
+
+type VoucherRepository interface {
+	CreateVoucherBrand(data model.VoucherBrand) (model.VoucherBrand, error)
+	GetAllVoucherBrand(data dto.VoucherBrandDto, isUser *bool) ([]model.VoucherBrand, error)
+	GetVoucherBrandById(id string, isUser *bool) (model.VoucherBrand, error)
+	UpdateVoucherBrandById(id string, data model.VoucherBrand) error
+	DeleteVoucherBrandById(id string) error
+	CreateVoucher(data model.Voucher) (model.Voucher, error)
+	GetVoucherById(id string) (model.Voucher, error)
+	UpdateVoucherById(id string, data model.Voucher) error
+	DeleteVoucherById(id string) error
+}
+
+type voucherRepository struct {
+	db *gorm.DB
+}
+
+func NewVoucherRepository(db *gorm.DB) *voucherRepository {
+	return &voucherRepository{db}
+}
+
+func (r *voucherRepository) CreateVoucherBrand(data model.VoucherBrand) (model.VoucherBrand, error) {
+	var count int64
+
+	r.db.Model(&model.VoucherBrand{}).Where("code = ?", data.Code).Count(&count)
+	if count > 0 {
+		return model.VoucherBrand{}, errors.New("code already exists")
+	}
+
+	if err := r.db.Omit("Vouchers").Create(&data).Error; err != nil {
+		return model.VoucherBrand{}, err
+	}
+
+	return data, nil
+}
+
+func (r *voucherRepository) GetAllVoucherBrand(data dto.VoucherBrandDto, isUser *bool) ([]model.VoucherBrand, error) {
+	var brands []model.VoucherBrand
+	offset := (data.Page - 1) * data.Limit
+
+	query := r.db.Where("type LIKE ? AND name ILIKE ?", "%"+data.Type+"%", "%"+data.Name+"%")
+	if isUser != nil {
+		query = query.Where("is_active = ?", true)
+	}
+
+	if err := query.Order("name ASC").Offset(offset).Limit(data.Limit).Find(&brands).Error; err != nil {
+		return brands, fmt.Errorf("error getting voucher brands: %s", err)
+	}
+
+	return brands, nil
+}
+
+// GetVoucherBrandById loads the brand with its denominations, cheapest first.
+// Users only see active denominations.
+func (r *voucherRepository) GetVoucherBrandById(id string, isUser *bool) (model.VoucherBrand, error) {
+	var brand model.VoucherBrand
+
+	result := r.db.Preload("Vouchers", func(db *gorm.DB) *gorm.DB {
+		if isUser != nil {
+			db = db.Where("is_active = ?", true)
+		}
+		return db.Order("price ASC")
+	}).First(&brand, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return brand, fmt.Errorf("voucher brand with ID %s not found", id)
+		}
+		return brand, fmt.Errorf("error getting voucher brand with ID %s: %s", id, result.Error)
+	}
+
+	return brand, nil
+}
+
+func (r *voucherRepository) UpdateVoucherBrandById(id string, data model.VoucherBrand) error {
+	result := r.db.Model(&model.VoucherBrand{}).Where("id = ?", id).Omit("Vouchers").Updates(&data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("voucher brand not found")
+	}
+
+	return nil
+}
+
+func (r *voucherRepository) DeleteVoucherBrandById(id string) error {
+	result := r.db.Delete(&model.VoucherBrand{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("voucher brand not found")
+	}
+
+	return nil
+}
+
+func (r *voucherRepository) CreateVoucher(data model.Voucher) (model.Voucher, error) {
+	var count int64
+
+	r.db.Model(&model.Voucher{}).Where("code = ?", data.Code).Count(&count)
+	if count > 0 {
+		return model.Voucher{}, errors.New("code already exists")
+	}
+
+	if err := r.db.Create(&data).Error; err != nil {
+		return model.Voucher{}, err
+	}
+
+	return data, nil
+}
+
+func (r *voucherRepository) GetVoucherById(id string) (model.Voucher, error) {
+	var voucher model.Voucher
+
+	result := r.db.First(&voucher, "id = ?", id)
+	if result.Error != nil {
+		if errors.Is(result.Error, gorm.ErrRecordNotFound) {
+			return voucher, fmt.Errorf("voucher with ID %s not found", id)
+		}
+		return voucher, fmt.Errorf("error getting voucher with ID %s: %s", id, result.Error)
+	}
+
+	return voucher, nil
+}
+
+func (r *voucherRepository) UpdateVoucherById(id string, data model.Voucher) error {
+	result := r.db.Model(&model.Voucher{}).Where("id = ?", id).Updates(&data)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("voucher not found")
+	}
+
+	return nil
+}
+
+func (r *voucherRepository) DeleteVoucherById(id string) error {
+	result := r.db.Delete(&model.Voucher{}, "id = ?", id)
+	if result.Error != nil {
+		return result.Error
+	}
+	if result.RowsAffected == 0 {
+		return errors.New("voucher not found")
+	}
+
+	return nil
+}
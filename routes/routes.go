@@ -6,28 +6,55 @@ import (
 	"BE-Golang/repository"
 	insurance "BE-Golang/usecase/Insurance"
 	"BE-Golang/usecase/auth"
+	"BE-Golang/usecase/autopay"
+	"BE-Golang/usecase/availability"
 	"BE-Golang/usecase/balance"
 	"BE-Golang/usecase/bank"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/bulk"
+	"BE-Golang/usecase/cart"
+	"BE-Golang/usecase/catalog"
 	"BE-Golang/usecase/discount"
 	"BE-Golang/usecase/electricity"
+	"BE-Golang/usecase/emoney"
+	"BE-Golang/usecase/middlewares"
+	"BE-Golang/usecase/multifinance"
+	"BE-Golang/usecase/notification"
 	"BE-Golang/usecase/pdam"
+	"BE-Golang/usecase/postpaid"
 	pulsa "BE-Golang/usecase/pulsa_paket_data"
+	"BE-Golang/usecase/reminder"
+	"BE-Golang/usecase/savedbiller"
+	transfer "BE-Golang/usecase/scheduled_transfer"
+	"BE-Golang/usecase/scheduler"
+	"BE-Golang/usecase/tax"
 	"BE-Golang/usecase/transaction"
 	"BE-Golang/usecase/users"
+	"BE-Golang/usecase/voucher"
 	"BE-Golang/usecase/wifi"
 	"net/http"
+	"time"
 
 	"github.com/labstack/echo/v4"
 	"github.com/labstack/echo/v4/middleware"
 	"gorm.io/gorm"
 )
 
-func Routes(e *echo.Echo, db *gorm.DB) {
+// Routes registers every endpoint on e and returns the background jobs,
+// which the caller starts and stops.
+func Routes(e *echo.Echo, db *gorm.DB) *scheduler.Scheduler {
 
 	// Auth user
 
 	authRepository := repository.NewAuthRepository(db)
-	authUseCase := auth.NewAuthUsecase(authRepository)
+	userRepository := repository.NewUserRepository(db)
+	sessionRepository := repository.NewSessionRepository(db)
+	sessionUseCase := auth.NewSessionUseCase(sessionRepository, userRepository)
+	sessionController := controller.NewSessionController(sessionUseCase)
+	passwordResetRepository := repository.NewPasswordResetRepository(db)
+	passwordResetUseCase := auth.NewPasswordResetUseCase(passwordResetRepository, userRepository, sessionUseCase, config.AppConfig.PasswordResetKey)
+	passwordResetController := controller.NewPasswordResetController(passwordResetUseCase)
+	authUseCase := auth.NewAuthUsecase(authRepository, sessionUseCase)
 	authController := controller.NewAuthController(authUseCase)
 
 	// Transaction
@@ -36,24 +63,54 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	transactionController := controller.NewTransactionController(transactionUseCase)
 
 	// Users
-	userRepository := repository.NewUserRepository(db)
-	userUseCase := users.NewUserUsecase(userRepository, transactionRepository)
+	userUseCase := users.NewUserUsecase(userRepository, transactionRepository, sessionUseCase)
 	userController := controller.NewUserController(userUseCase)
 
+	// Notification
+	notificationRepository := repository.NewNotificationRepository(db)
+	notificationUseCase := notification.NewNotificationUseCase(notificationRepository, userRepository)
+	notificationController := controller.NewNotificationController(notificationUseCase)
+
+	// Scheduled Transfer
+	scheduledTransferRepository := repository.NewScheduledTransferRepository(db)
+	scheduledTransferUseCase := transfer.NewScheduledTransferUseCase(scheduledTransferRepository, userRepository, userUseCase, notificationUseCase)
+	scheduledTransferController := controller.NewScheduledTransferController(scheduledTransferUseCase)
+
 	// Discount
 	discountRepository := repository.NewDiscountRepository(db)
 	discountUseCase := discount.NewDiscountUseCase(discountRepository)
 	discountController := controller.NewDiscountController(discountUseCase)
 
+	// Availability
+	availabilityRepository := repository.NewProductAvailabilityRepository(db)
+	availabilityUseCase := availability.NewAvailabilityUseCase(availabilityRepository)
+	availabilityController := controller.NewProductAvailabilityController(availabilityUseCase)
+
 	// Pulsa Paket Data
 	ppdRepository := repository.NewPulsaPaketDataRepository(db)
-	ppdUsecase := pulsa.NewPulsaPaketDataUsecase(ppdRepository, userRepository, transactionRepository, discountRepository)
+	phonePrefixRepository := repository.NewPhonePrefixRepository(db)
+	phonePrefixUsecase := pulsa.NewPhonePrefixUsecase(phonePrefixRepository)
+	phonePrefixController := controller.NewPhonePrefixController(phonePrefixUsecase)
+	ppdProviderRepository := repository.NewPPDSandboxRepository()
+	ppdUsecase := pulsa.NewPulsaPaketDataUsecase(ppdRepository, phonePrefixRepository, ppdProviderRepository, userRepository, transactionRepository, discountRepository, availabilityRepository, notificationUseCase)
 	ppdController := controller.NewPulsaPaketDataController(ppdUsecase)
 
+	// E-MONEY
+	eMoneyRepository := repository.NewEMoneyRepository(db)
+	eMoneyProviderRepository := repository.NewEMoneySandboxRepository()
+	eMoneyUseCase := emoney.NewEMoneyUseCase(eMoneyRepository, eMoneyProviderRepository, transactionRepository, discountRepository, notificationUseCase)
+	eMoneyController := controller.NewEMoneyController(eMoneyUseCase)
+
+	// Voucher
+	voucherRepository := repository.NewVoucherRepository(db)
+	voucherProviderRepository := repository.NewVoucherSandboxRepository()
+	voucherUseCase := voucher.NewVoucherUseCase(voucherRepository, voucherProviderRepository, transactionRepository, discountRepository, notificationUseCase, config.AppConfig.VoucherCodeKey)
+	voucherController := controller.NewVoucherController(voucherUseCase)
+
 	// Balance
 	virtualAgregatorOyApi := repository.NewVirtualAgregatorOyApiRepository()
 	balanceRepository := repository.NewBalanceRepository(db)
-	balanceUseCase := balance.NewBalanceUsecase(balanceRepository, userRepository, transactionRepository, virtualAgregatorOyApi)
+	balanceUseCase := balance.NewBalanceUsecase(balanceRepository, userRepository, transactionRepository, virtualAgregatorOyApi, availabilityRepository)
 	balanceController := controller.NewBalanceController(balanceUseCase)
 
 	// Payment
@@ -63,25 +120,126 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 
 	// PDAM
 	billerRepository := repository.NewBillerOyApiOyApiRepository()
+	savedBillerRepository := repository.NewSavedBillerRepository(db)
+	customerIdRuleRepository := repository.NewCustomerIdRuleRepository(db)
 	pdamRepository := repository.NewPdamRepository(db)
-	pdamUseCase := pdam.NewPdamUseCase(pdamRepository, userRepository, discountRepository, transactionRepository, billerRepository)
+	pdamRegionRepository := repository.NewPdamRegionRepository(db)
+	pdamRegionUseCase := pdam.NewPdamRegionUseCase(pdamRegionRepository)
+	pdamRegionController := controller.NewPdamRegionController(pdamRegionUseCase)
+	pdamUseCase := pdam.NewPdamUseCase(pdamRepository, pdamRegionRepository, userRepository, discountRepository, transactionRepository, billerRepository, savedBillerRepository, customerIdRuleRepository, availabilityRepository)
 	pdamController := controller.NewPdamController(pdamUseCase)
 
 	// Wifi
 	wifiRepository := repository.NewWifiRepository(db)
-	wifiUsecase := wifi.NewWifiUseCase(wifiRepository, userRepository, discountRepository, transactionRepository, billerRepository)
+	ispRepository := repository.NewIspRepository(db)
+	ispUseCase := wifi.NewIspUseCase(ispRepository)
+	ispController := controller.NewIspController(ispUseCase)
+	wifiUsecase := wifi.NewWifiUseCase(wifiRepository, ispRepository, userRepository, discountRepository, transactionRepository, billerRepository, savedBillerRepository, customerIdRuleRepository, availabilityRepository)
 	wifiController := controller.NewWifiController(wifiUsecase)
 
 	// INSURANCE
 	insuranceRepository := repository.NewInsuranceRepository(db)
-	insuranceUseCase := insurance.NewInsuranceUseCase(insuranceRepository, userRepository, discountRepository, transactionRepository, billerRepository)
+	insuranceUseCase := insurance.NewInsuranceUseCase(insuranceRepository, userRepository, discountRepository, transactionRepository, billerRepository, savedBillerRepository, customerIdRuleRepository, availabilityRepository)
 	insuranceController := controller.NewInsuranceController(insuranceUseCase)
 
+	// BPJS KETENAGAKERJAAN
+	ketenagakerjaanRepository := repository.NewBpjsKetenagakerjaanRepository(db)
+	ketenagakerjaanUseCase := insurance.NewKetenagakerjaanUseCase(ketenagakerjaanRepository)
+	ketenagakerjaanController := controller.NewKetenagakerjaanController(ketenagakerjaanUseCase)
+
 	// ELECTRICITY
 	electricityRepository := repository.NewElectricityRepository(db)
-	electricityUseCase := electricity.NewElectricityUseCase(electricityRepository, userRepository, discountRepository, transactionRepository, billerRepository)
+	plnTariffRepository := repository.NewPlnTariffRepository(db)
+	plnTariffUseCase := electricity.NewPlnTariffUseCase(plnTariffRepository)
+	plnTariffController := controller.NewPlnTariffController(plnTariffUseCase)
+	electricityUseCase := electricity.NewElectricityUseCase(electricityRepository, plnTariffRepository, userRepository, discountRepository, transactionRepository, billerRepository, savedBillerRepository, customerIdRuleRepository, availabilityRepository)
 	electricityController := controller.NewElectricityController(electricityUseCase)
 
+	// TAX
+	taxRegionRepository := repository.NewTaxRegionRepository(db)
+	taxRegionUseCase := tax.NewTaxRegionUseCase(taxRegionRepository)
+	taxRegionController := controller.NewTaxRegionController(taxRegionUseCase)
+
+	// Multifinance
+	financeCompanyRepository := repository.NewFinanceCompanyRepository(db)
+	financeCompanyUseCase := multifinance.NewFinanceCompanyUseCase(financeCompanyRepository)
+	financeCompanyController := controller.NewFinanceCompanyController(financeCompanyUseCase)
+
+	// Bill products
+	billerUseCase := biller.NewBillerUseCase(userRepository, discountRepository, transactionRepository, billerRepository, savedBillerRepository, customerIdRuleRepository, availabilityRepository,
+		pdam.NewProduct(pdamRegionRepository),
+		wifi.NewProduct(ispRepository),
+		insurance.NewProduct(transactionRepository),
+		insurance.NewKetenagakerjaanProduct(ketenagakerjaanRepository),
+		electricity.NewPostpaidProduct(plnTariffRepository),
+		electricity.NewPrepaidProduct(plnTariffRepository),
+		tax.NewPbbProduct(taxRegionRepository, billerRepository),
+		tax.NewSamsatProduct(taxRegionRepository, billerRepository),
+		postpaid.NewMobileProduct(phonePrefixRepository),
+		postpaid.CableTvProduct,
+		multifinance.NewMultifinanceProduct(financeCompanyRepository, transactionRepository),
+	)
+	billController := controller.NewBillController(billerUseCase)
+	customerIdRuleUseCase := biller.NewCustomerIdRuleUseCase(customerIdRuleRepository, billerUseCase)
+	customerIdRuleController := controller.NewCustomerIdRuleController(customerIdRuleUseCase)
+
+	// Saved Biller
+	savedBillerUseCase := savedbiller.NewSavedBillerUseCase(savedBillerRepository, transactionRepository, billerUseCase)
+	savedBillerController := controller.NewSavedBillerController(savedBillerUseCase)
+
+	// Bill Reminder
+	billReminderRepository := repository.NewBillReminderRepository(db)
+	billReminderUseCase := reminder.NewBillReminderUseCase(savedBillerRepository, transactionRepository, billReminderRepository, notificationUseCase)
+
+	// Auto Pay
+	autoPayRepository := repository.NewAutoPayRepository(db)
+	autoPayUseCase := autopay.NewAutoPayUseCase(autoPayRepository, transactionRepository, billerUseCase, notificationUseCase)
+	autoPayController := controller.NewAutoPayController(autoPayUseCase)
+
+	// Cart
+	cartRepository := repository.NewCartRepository(db)
+	cartUseCase := cart.NewCartUseCase(cartRepository, transactionRepository, userRepository, billerUseCase, notificationUseCase)
+	cartController := controller.NewCartController(cartUseCase)
+
+	// Catalog sync
+	catalogPriceReviewRepository := repository.NewCatalogPriceReviewRepository(db)
+	priceListRepository := repository.NewPriceListApiRepository(config.AppConfig.PriceListUrl)
+	catalogSyncUseCase := catalog.NewCatalogSyncUseCase(catalogPriceReviewRepository, priceListRepository,
+		pulsa.NewCatalog(ppdRepository),
+		wifi.NewCatalog(ispRepository),
+		insurance.NewCatalog(insuranceRepository),
+		electricity.NewCatalog(electricityRepository),
+	)
+	catalogSyncController := controller.NewCatalogSyncController(catalogSyncUseCase)
+
+	// Bulk catalog upload
+	bulkRepository := repository.NewBulkRepository(db)
+	bulkUseCase := bulk.NewBulkUseCase(bulkRepository,
+		pulsa.NewBulkCatalog(bulkRepository),
+		discount.NewBulkCatalog(bulkRepository),
+		bank.NewBulkCatalog(bulkRepository),
+		pdam.NewBulkCatalog(bulkRepository),
+		wifi.NewBulkCatalog(bulkRepository),
+		insurance.NewBulkCatalog(bulkRepository),
+		electricity.NewBulkCatalog(bulkRepository),
+	)
+	bulkController := controller.NewBulkController(bulkUseCase)
+
+	// Background jobs
+	jobScheduler := scheduler.NewScheduler()
+	jobScheduler.AddJob("scheduled-transfer", time.Minute, scheduledTransferUseCase.RunDueScheduledTransfersUseCase)
+	jobScheduler.AddJob("auto-pay", time.Minute, autoPayUseCase.RunDueAutoPaysUseCase)
+	jobScheduler.AddJob("emoney-topup", 15*time.Second, eMoneyUseCase.RunPendingTopUpsUseCase)
+	jobScheduler.AddJob("ppd-purchase", 15*time.Second, ppdUsecase.RunPendingTransactionPPD)
+	jobScheduler.AddJob("voucher-purchase", 15*time.Second, voucherUseCase.RunPendingVouchersUseCase)
+	jobScheduler.AddJob("bill-reminder", time.Hour, billReminderUseCase.RunBillRemindersUseCase)
+	jobScheduler.AddJob("inquiry-expiry", 5*time.Minute, billerUseCase.RunExpireInquiriesUseCase)
+	jobScheduler.AddJob("session-cleanup", time.Hour, sessionUseCase.RunSessionCleanupUseCase)
+	jobScheduler.AddJob("password-reset-cleanup", time.Hour, passwordResetUseCase.RunPasswordResetCleanupUseCase)
+	if config.AppConfig.PriceListUrl != "" {
+		jobScheduler.AddJob("catalog-sync", time.Hour, catalogSyncUseCase.RunCatalogSyncUseCase)
+	}
+
 	e.GET("/", func(c echo.Context) error {
 		return c.HTML(http.StatusOK, `
 			<h1>Welcome to PPOB APP</h1>
@@ -97,11 +255,10 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	url.POST("/login", authController.LoginController)
 	url.POST("/register", authController.RegisterController)
 	url.POST("/admin/register", authController.RegisterAdminController)
+	url.POST("/token/refresh", sessionController.RefreshTokenController)
 
-	// User
-	url.GET("/user/email", userController.GetUserByEmailController)
-	url.POST("/user/pin/:id", userController.CheckPinForgotPasswordController)
-	url.POST("/user/password/forgot/:id", userController.UpdateForgotPasswordController)
+	url.POST("/password/forgot", passwordResetController.ForgotPasswordController)
+	url.POST("/password/reset", passwordResetController.ResetPasswordController)
 
 	all := e.Group("/api/v1")
 	user := e.Group("/api/v1")
@@ -112,7 +269,7 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	}
 
 	// ====== ADMIN ROLE =======
-	admin.Use(middleware.JWTWithConfig(jwtConfig))
+	admin.Use(middleware.JWTWithConfig(jwtConfig), middlewares.RevokedTokenMiddleware(sessionRepository))
 
 	// users
 	admin.GET("/users", userController.GetAllUsersController)
@@ -142,16 +299,51 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	admin.POST("/pdam", pdamController.CreatePdamController)
 	admin.PUT("/pdam/:id", pdamController.UpdatePdamController)
 	admin.DELETE("/pdam/:id", pdamController.DeletePdamByIdController)
+	admin.POST("/pdam-region", pdamRegionController.CreatePdamRegionController)
+	admin.PUT("/pdam-region/:id", pdamRegionController.UpdatePdamRegionController)
+	admin.DELETE("/pdam-region/:id", pdamRegionController.DeletePdamRegionByIdController)
 
 	//Insurance
 	admin.POST("/insurance", insuranceController.CreateInsuranceController)
 	admin.PUT("/insurance/:id", insuranceController.UpdateInsuranceController)
 	admin.DELETE("/insurance/:id", insuranceController.DeleteInsuranceByIdController)
 
+	// BPJS Ketenagakerjaan
+	admin.POST("/bpjs-ketenagakerjaan", ketenagakerjaanController.CreateKetenagakerjaanController)
+	admin.PUT("/bpjs-ketenagakerjaan/:id", ketenagakerjaanController.UpdateKetenagakerjaanController)
+	admin.DELETE("/bpjs-ketenagakerjaan/:id", ketenagakerjaanController.DeleteKetenagakerjaanByIdController)
+
+	// Tax regions
+	admin.POST("/tax-region", taxRegionController.CreateTaxRegionController)
+	admin.PUT("/tax-region/:id", taxRegionController.UpdateTaxRegionController)
+	admin.DELETE("/tax-region/:id", taxRegionController.DeleteTaxRegionByIdController)
+
+	// Customer ID rules
+	admin.POST("/customer-id-rule", customerIdRuleController.CreateCustomerIdRuleController)
+	admin.GET("/customer-id-rules", customerIdRuleController.GetAllCustomerIdRuleController)
+	admin.GET("/customer-id-rule/:id", customerIdRuleController.GetCustomerIdRuleByIdController)
+	admin.PUT("/customer-id-rule/:id", customerIdRuleController.UpdateCustomerIdRuleController)
+	admin.DELETE("/customer-id-rule/:id", customerIdRuleController.DeleteCustomerIdRuleByIdController)
+
+	// Availability
+	admin.POST("/availability", availabilityController.CreateProductAvailabilityController)
+	admin.GET("/availabilities", availabilityController.GetAllProductAvailabilityController)
+	admin.GET("/availability/:id", availabilityController.GetProductAvailabilityByIdController)
+	admin.PUT("/availability/:id", availabilityController.UpdateProductAvailabilityController)
+	admin.PUT("/availability/:id/maintenance", availabilityController.SetMaintenanceController)
+	admin.DELETE("/availability/:id", availabilityController.DeleteProductAvailabilityByIdController)
+
+	admin.POST("/finance-company", financeCompanyController.CreateFinanceCompanyController)
+	admin.PUT("/finance-company/:id", financeCompanyController.UpdateFinanceCompanyController)
+	admin.DELETE("/finance-company/:id", financeCompanyController.DeleteFinanceCompanyByIdController)
+
 	// Electricity
 	admin.POST("/electricity", electricityController.CreateElectricityController)
 	admin.PUT("/electricity/:id", electricityController.UpdateElectricityController)
 	admin.DELETE("/electricity/:id", electricityController.DeleteElectricityByIdController)
+	admin.POST("/pln-tariff", plnTariffController.CreatePlnTariffController)
+	admin.PUT("/pln-tariff/:id", plnTariffController.UpdatePlnTariffController)
+	admin.DELETE("/pln-tariff/:id", plnTariffController.DeletePlnTariffByIdController)
 
 	// pulsa paket data
 	admin.POST("/ppd", ppdController.CreatePulsaPaketData)
@@ -159,14 +351,53 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	admin.GET("/ppd/:id", ppdController.GetPPDByID)
 	admin.PUT("/ppd/:id", ppdController.UpdatePPDById)
 	admin.DELETE("/ppd/:id", ppdController.DeletePPDById)
+	admin.POST("/phone-prefix", phonePrefixController.CreatePhonePrefixController)
+	admin.PUT("/phone-prefix/:id", phonePrefixController.UpdatePhonePrefixController)
+	admin.DELETE("/phone-prefix/:id", phonePrefixController.DeletePhonePrefixByIdController)
+
+	// E-Money
+	admin.POST("/emoney", eMoneyController.CreateEMoneyController)
+	admin.GET("/emoney", eMoneyController.GetEMoneyByAdminController)
+	admin.GET("/emoney/:id", eMoneyController.GetEMoneyByIdController)
+	admin.PUT("/emoney/:id", eMoneyController.UpdateEMoneyByIdController)
+	admin.DELETE("/emoney/:id", eMoneyController.DeleteEMoneyByIdController)
+
+	admin.POST("/voucher-brand", voucherController.CreateVoucherBrandController)
+	admin.GET("/voucher-brand", voucherController.GetVoucherBrandByAdminController)
+	admin.GET("/voucher-brand/:id", voucherController.GetVoucherBrandByIdAdminController)
+	admin.PUT("/voucher-brand/:id", voucherController.UpdateVoucherBrandByIdController)
+	admin.DELETE("/voucher-brand/:id", voucherController.DeleteVoucherBrandByIdController)
+	admin.POST("/voucher", voucherController.CreateVoucherController)
+	admin.PUT("/voucher/:id", voucherController.UpdateVoucherByIdController)
+	admin.DELETE("/voucher/:id", voucherController.DeleteVoucherByIdController)
 
 	//wifi
 	admin.POST("/wifi", wifiController.CreateWifiController)
 	admin.PUT("/wifi/:id", wifiController.UpdateWifiController)
 	admin.DELETE("/wifi/:id", wifiController.DeleteWifiByIdController)
+	admin.POST("/isp", ispController.CreateIspController)
+	admin.GET("/isp", ispController.GetAllIspByAdminController)
+	admin.GET("/isp/:id", ispController.GetIspByIdAdminController)
+	admin.PUT("/isp/:id", ispController.UpdateIspController)
+	admin.DELETE("/isp/:id", ispController.DeleteIspByIdController)
+	admin.POST("/isp-plan", ispController.CreateIspPlanController)
+	admin.PUT("/isp-plan/:id", ispController.UpdateIspPlanController)
+	admin.DELETE("/isp-plan/:id", ispController.DeleteIspPlanByIdController)
+
+	//catalog sync
+	admin.POST("/catalog/:catalog/sync", catalogSyncController.SyncCatalogController)
+	admin.POST("/catalog/:catalog/import", catalogSyncController.ImportPriceListController)
+	admin.GET("/catalog/price-reviews", catalogSyncController.GetAllPriceReviewController)
+	admin.PUT("/catalog/price-review/:id/approve", catalogSyncController.ApprovePriceReviewController)
+	admin.PUT("/catalog/price-review/:id/reject", catalogSyncController.RejectPriceReviewController)
+
+	//bulk catalog
+	admin.GET("/bulk/:catalog", bulkController.ExportCatalogController)
+	admin.POST("/bulk/:catalog", bulkController.ImportCatalogController)
+	admin.POST("/bulk/:catalog/price-adjustment", bulkController.AdjustPriceController)
 
 	// ====== USER ROLE =======
-	user.Use(middleware.JWTWithConfig(jwtConfig))
+	user.Use(middleware.JWTWithConfig(jwtConfig), middlewares.RevokedTokenMiddleware(sessionRepository))
 	user.GET("/profile", userController.GetUserByIdController)
 	user.PUT("/user", userController.UpdateUserByIDController)
 	user.PUT("/user/image", userController.UpdateUserImageByIDController)
@@ -177,15 +408,70 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	user.POST("/user/transfer/amount", userController.TransferAmountController)
 	user.DELETE("/user", userController.DeleteUserByIDController)
 
+	// scheduled transfer
+	user.POST("/user/transfer/schedule", scheduledTransferController.CreateScheduledTransferController)
+	user.GET("/user/transfer/schedules", scheduledTransferController.GetScheduledTransfersController)
+	user.GET("/user/transfer/schedule/:id", scheduledTransferController.GetScheduledTransferByIdController)
+	user.PUT("/user/transfer/schedule/:id/pause", scheduledTransferController.PauseScheduledTransferController)
+	user.PUT("/user/transfer/schedule/:id/resume", scheduledTransferController.ResumeScheduledTransferController)
+	user.DELETE("/user/transfer/schedule/:id", scheduledTransferController.CancelScheduledTransferController)
+
+	// auto pay
+	user.POST("/user/autopay", autoPayController.CreateAutoPayController)
+	user.GET("/user/autopays", autoPayController.GetAutoPaysController)
+	user.GET("/user/autopay/:id", autoPayController.GetAutoPayByIdController)
+	user.PUT("/user/autopay/:id", autoPayController.UpdateAutoPayController)
+	user.PUT("/user/autopay/:id/pause", autoPayController.PauseAutoPayController)
+	user.PUT("/user/autopay/:id/resume", autoPayController.ResumeAutoPayController)
+	user.POST("/user/autopay/:id/approve", autoPayController.ApproveAutoPayController)
+	user.DELETE("/user/autopay/:id", autoPayController.CancelAutoPayController)
+
+	// saved biller
+	user.POST("/user/biller", savedBillerController.CreateSavedBillerController)
+	user.GET("/user/billers", savedBillerController.GetSavedBillersController)
+	user.GET("/user/billers/due", savedBillerController.GetDueBillsController)
+	user.GET("/user/biller/:id", savedBillerController.GetSavedBillerByIdController)
+	user.PUT("/user/biller/:id", savedBillerController.UpdateSavedBillerController)
+	user.DELETE("/user/biller/:id", savedBillerController.DeleteSavedBillerController)
+
+	// cart
+	user.GET("/user/cart", cartController.GetCartController)
+	user.POST("/user/cart/items", cartController.AddCartItemController)
+	user.DELETE("/user/cart/items/:id", cartController.RemoveCartItemController)
+	user.POST("/user/cart/checkout", cartController.CheckoutCartController)
+
+	// notification
+	user.GET("/user/notifications", notificationController.GetNotificationsController)
+	user.PUT("/user/notification/:id/read", notificationController.ReadNotificationController)
+	user.GET("/user/notification/preference", notificationController.GetNotificationPreferenceController)
+	user.PUT("/user/notification/preference", notificationController.UpdateNotificationPreferenceController)
+
 	// pulsa paket data
 	user.GET("/user/ppd", ppdController.GetPPDByUser)
 	user.POST("/user/ppd", ppdController.CreateTransactionPPDUser)
+	user.GET("/user/ppd/transaction/:id", ppdController.GetTransactionPPDUser)
+
+	// e-money
+	user.GET("/user/emoney", eMoneyController.GetEMoneyByUserController)
+	user.POST("/user/emoney/inquiry", eMoneyController.AccountInquiryController)
+	user.POST("/user/emoney", eMoneyController.CreateTransactionEMoneyController)
+	user.GET("/user/emoney/transaction/:id", eMoneyController.GetTransactionEMoneyController)
+
+	user.GET("/user/voucher-brand", voucherController.GetVoucherBrandByUserController)
+	user.GET("/user/voucher-brand/:id", voucherController.GetVoucherBrandByIdUserController)
+	user.POST("/user/voucher/inquiry", voucherController.NicknameInquiryController)
+	user.POST("/user/voucher", voucherController.CreateTransactionVoucherController)
+	user.GET("/user/voucher/transaction/:id", voucherController.GetTransactionVoucherController)
 
 	// transaction
 	user.GET("/user/transactions/", transactionController.GetTransactionByUserIdController)
 
 	// ====== ALL ROLE =======
-	all.Use(middleware.JWTWithConfig(jwtConfig))
+	all.Use(middleware.JWTWithConfig(jwtConfig), middlewares.RevokedTokenMiddleware(sessionRepository))
+
+	// Session
+	all.POST("/logout", sessionController.LogoutController)
+	all.POST("/logout/all", sessionController.LogoutAllController)
 
 	// transaction
 	all.GET("/transaction/:id", transactionController.GetTransactionByIdController)
@@ -197,6 +483,11 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	all.POST("/amount", balanceController.GenerateVaController)
 	all.GET("/amount/status/:id", balanceController.GetPayBalanceStatusController)
 
+	// Phone prefixes
+	all.GET("/phone-prefixes", phonePrefixController.GetAllPhonePrefixController)
+	all.GET("/phone-prefix/:id", phonePrefixController.GetPhonePrefixByIdController)
+	all.GET("/phone-provider", phonePrefixController.DetectProviderController)
+
 	// Discount
 	all.GET("/discounts", discountController.GetAllDiscountController)
 	all.GET("/discount/:id", discountController.GetDiscountByIdController)
@@ -208,6 +499,18 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	all.POST("/insurance/inquiry", insuranceController.BillInquiryInsuranceController)
 	all.POST("/insurance/pay", insuranceController.PayBillInquiryInsuranceController)
 
+	// BPJS Ketenagakerjaan
+	all.GET("/bpjs-ketenagakerjaan", ketenagakerjaanController.GetAllKetenagakerjaanController)
+	all.GET("/bpjs-ketenagakerjaan/:id", ketenagakerjaanController.GetKetenagakerjaanByIdController)
+
+	// Tax regions
+	all.GET("/tax-regions", taxRegionController.GetAllTaxRegionController)
+	all.GET("/tax-region/:id", taxRegionController.GetTaxRegionByIdController)
+	all.GET("/finance-companies", financeCompanyController.GetAllFinanceCompanyController)
+	all.GET("/pln-tariffs", plnTariffController.GetAllPlnTariffController)
+	all.GET("/pln-tariff/:id", plnTariffController.GetPlnTariffByIdController)
+	all.GET("/finance-company/:id", financeCompanyController.GetFinanceCompanyByIdController)
+
 	// Electricity
 	all.GET("/electricitys", electricityController.GetAllElectricityController)
 	all.GET("/electricity/:id", electricityController.GetElectricityByIdController)
@@ -216,10 +519,22 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	all.POST("/electricity/postpaid/inquiry", electricityController.BillInquiryPostPaidElectricityController)
 	// Electricity PrePaid (Token)
 	all.POST("/electricity/prepaid/inquiry", electricityController.BillInquiryPrePaidElectricityController)
+	all.POST("/electricity/prepaid/pay", electricityController.PayBillPrePaidElectricityController)
+	all.GET("/electricity/prepaid/denominations", electricityController.GetTokenDenominationsController)
+	all.GET("/electricity/prepaid/meter/:meter_number", electricityController.PrepaidMeterInquiryController)
+	all.POST("/electricity/prepaid/token/:id/resend", electricityController.ResendTokenElectricityController)
+
+	// Bill products
+	all.GET("/bill/products", billController.GetBillProductsController)
+	all.POST("/bill/:code/inquiry", billController.BillInquiryController)
+	all.POST("/bill/:code/pay", billController.PayBillController)
+	all.POST("/bill/inquiry/:id/cancel", billController.CancelInquiryController)
 
 	// PDAM
 	all.GET("/pdams", pdamController.GetAllPdamController)
 	all.GET("/pdam/:id", pdamController.GetPdamByIdController)
+	all.GET("/pdam-regions", pdamRegionController.GetAllPdamRegionController)
+	all.GET("/pdam-region/:id", pdamRegionController.GetPdamRegionByIdController)
 	all.POST("/pdam/inquiry", pdamController.BillInquiryPdamController)
 	all.POST("/pdam/pay", pdamController.PayBillInquiryPdamController)
 
@@ -228,4 +543,9 @@ func Routes(e *echo.Echo, db *gorm.DB) {
 	all.GET("/wifi/:id", wifiController.GetWifiByIdController)
 	all.POST("/wifi/inquiry", wifiController.BillInquiryWifiController)
 	all.POST("/wifi/pay", wifiController.PayBillWifiController)
+	all.GET("/wifi/subscription", ispController.GetWifiSubscriptionController)
+	all.GET("/isps", ispController.GetAllIspController)
+	all.GET("/isp/:id", ispController.GetIspByIdController)
+
+	return jobScheduler
 }
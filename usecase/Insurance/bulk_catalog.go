@@ -0,0 +1,64 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+	"strings"
+)
+
+// NewBulkCatalog lets admins upload and download BPJS Ketenagakerjaan
+// packages, keyed by product type. The monthly contribution is derived from
+// programs and income, so it is exported but not read back.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	packages := func() ([]model.BpjsKetenagakerjaan, error) {
+		var packages []model.BpjsKetenagakerjaan
+		err := bulkRepository.FindAllRepository(&packages)
+		return packages, err
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_BPJS_KETENAGAKERJAAN,
+		Columns: []string{"product_type", "name", "provider_name", "programs", "income", "price"},
+		Key:     []string{"product_type"},
+		Export: func() ([][]string, error) {
+			packages, err := packages()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(packages))
+			for i, bpjs := range packages {
+				rows[i] = []string{bpjs.Type, bpjs.Name, bpjs.ProviderName, bpjs.Programs, bulk.FormatFloat(bpjs.Income), bulk.FormatFloat(bpjs.Price)}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			packages, err := packages()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.BpjsKetenagakerjaan{}
+			for _, bpjs := range packages {
+				existing[bpjs.Type] = bpjs
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				productType := strings.ToLower(row.Required("product_type"))
+				bpjs, update := existing[productType]
+				bpjs.Type = productType
+				bpjs.Name = row.Required("name")
+				bpjs.ProviderName = row.String("provider_name")
+				bpjs.Income = row.Float("income")
+				if bpjs.Income <= 0 {
+					row.Errorf("income must be greater than 0")
+				}
+				programs, err := normalizePrograms(row.String("programs"))
+				row.Error(err)
+				bpjs.Programs = programs
+				bpjs.Price = calculateKetenagakerjaanContribution(programs, bpjs.Income)
+				return &bpjs, update
+			}, nil
+		},
+	}
+}
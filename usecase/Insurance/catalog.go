@@ -0,0 +1,42 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/catalog"
+)
+
+// NewCatalog exposes insurance products to the catalog sync.
+func NewCatalog(insuranceRepository repository.InsuranceRepository) *catalog.Catalog {
+	return &catalog.Catalog{
+		Code: model.CATALOG_INSURANCE,
+		Items: func() ([]model.CatalogItem, error) {
+			products, err := insuranceRepository.GetInsuranceCatalogRepository()
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]model.CatalogItem, len(products))
+			for i, product := range products {
+				items[i] = model.CatalogItem{
+					ID:        product.ID,
+					Code:      product.Code,
+					Name:      product.Name,
+					CostPrice: product.CostPrice,
+					SellPrice: product.Price,
+					IsActive:  product.IsActive == nil || *product.IsActive,
+				}
+			}
+			return items, nil
+		},
+		Deactivate: func(id string) error {
+			inactive := false
+			_, err := insuranceRepository.UpdateInsuranceByIdRepository(id, &model.Insurance{IsActive: &inactive})
+			return err
+		},
+		SetPrice: func(id string, costPrice, sellPrice float64) error {
+			_, err := insuranceRepository.UpdateInsuranceByIdRepository(id, &model.Insurance{CostPrice: costPrice, Price: sellPrice})
+			return err
+		},
+	}
+}
@@ -0,0 +1,212 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+)
+
+// latePenaltyRate is charged per overdue month on each month in arrears.
+const latePenaltyRate = 0.025
+
+var memberRelations = []string{"peserta", "pasangan", "anak"}
+
+const transactionPrefix = "INSURANCE"
+
+// NewProduct plugs BPJS Kesehatan contributions into the biller engine.
+// Setting months on the inquiry pays the arrears of the previous months as
+// well, skipping the months already paid through the app.
+func NewProduct(transactionRepository repository.TransactionRepository) *biller.Product {
+	return &biller.Product{
+		Code:              model.PRODUCT_INSURANCE,
+		Category:          model.PRODUCT_INSURANCE,
+		Name:              "BPJS",
+		TransactionPrefix: transactionPrefix,
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			return priceBill(transactionRepository, inquiry)
+		},
+		Detail: func() interface{} {
+			return &model.BpjsKesehatanBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			insurance := detail.(*model.BpjsKesehatanBill)
+			receipt.Class = insurance.Class
+			receipt.NumberOffamily = insurance.NumberOffamily
+			receipt.Period = breakdownPeriod(insurance.Breakdown, receipt.Period)
+		},
+	}
+}
+
+func priceBill(transactionRepository repository.TransactionRepository, inquiry *biller.Inquiry) (*biller.Bill, error) {
+	months, err := billMonths(inquiry.Payload.Months)
+	if err != nil {
+		return nil, err
+	}
+
+	paid, err := paidPeriods(transactionRepository, inquiry.Payload.CustomerId, inquiry.Now, months)
+	if err != nil {
+		return nil, err
+	}
+
+	class, numberOfFamilyMembers := lookupPolicy(inquiry.Payload.CustomerId)
+	contribution := calculateBPJSKesehatanInsurance(class, numberOfFamilyMembers)
+
+	breakdown := make([]model.BpjsMonth, 0, months)
+	var price, penalty float64
+	for i := months - 1; i >= 0; i-- {
+		period := biller.PeriodAt(inquiry.Now, -i)
+		if paid[period] {
+			continue
+		}
+		month := model.BpjsMonth{
+			Period:       period,
+			Contribution: contribution,
+			Penalty:      contribution * latePenaltyRate * float64(i),
+		}
+		month.Total = month.Contribution + month.Penalty
+		breakdown = append(breakdown, month)
+		price += month.Total
+		penalty += month.Penalty
+	}
+	if len(breakdown) == 0 {
+		return nil, errors.New("the requested months have been paid")
+	}
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Tagihan asuransi %s ", breakdownPeriod(breakdown, inquiry.Payload.Period)),
+		Detail: &model.BpjsKesehatanBill{
+			Period:         inquiry.Payload.Period,
+			CustomerID:     inquiry.Payload.CustomerId,
+			ProviderName:   inquiry.Response.ProductID,
+			Name:           inquiry.User.Name,
+			Class:          strconv.Itoa(class),
+			NumberOffamily: numberOfFamilyMembers,
+			Members:        familyMembers(inquiry.Payload.CustomerId, inquiry.User.Name, numberOfFamilyMembers),
+			Months:         len(breakdown),
+			Breakdown:      breakdown,
+			Penalty:        penalty,
+			Type:           inquiry.Response.ProductID,
+			DiscountId:     inquiry.Discount.ID,
+			Price:          price,
+		},
+	}, nil
+}
+
+// billMonths defaults an unset month count to a single month.
+func billMonths(months int) (int, error) {
+	if months == 0 {
+		return 1, nil
+	}
+	if months < 0 || months > model.BPJS_MAX_MONTHS {
+		return 0, fmt.Errorf("months must be between 1 and %d", model.BPJS_MAX_MONTHS)
+	}
+
+	return months, nil
+}
+
+// breakdownPeriod describes the periods covered by a multi-month bill, e.g.
+// "January-2026 - March-2026", falling back to period for a single month.
+func breakdownPeriod(breakdown []model.BpjsMonth, period string) string {
+	if len(breakdown) < 2 {
+		return period
+	}
+
+	return breakdown[0].Period + " - " + breakdown[len(breakdown)-1].Period
+}
+
+// familyMembers lists the participants covered by the card. The head of the
+// family holds the customer ID; dependants get a numbered card.
+func familyMembers(customerID, name string, count int) []model.BpjsMember {
+	members := make([]model.BpjsMember, 0, count)
+	for i := 0; i < count; i++ {
+		member := model.BpjsMember{
+			CardNumber: customerID,
+			Relation:   memberRelations[len(memberRelations)-1],
+		}
+		if i < len(memberRelations) {
+			member.Relation = memberRelations[i]
+		}
+		if i == 0 {
+			member.Name = name
+		} else {
+			member.CardNumber = fmt.Sprintf("%s%02d", customerID, i)
+		}
+		members = append(members, member)
+	}
+
+	return members
+}
+
+// lookupPolicy stands in for the BPJS participant lookup until the gateway
+// returns it. The class and family size are derived from the card number, so
+// a participant is billed the same contribution every month.
+func lookupPolicy(customerID string) (class, numberOfFamilyMembers int) {
+	sum := biller.Hash(customerID)
+
+	return int(sum%3) + 1, int(sum/3%3) + 1
+}
+
+// paidPeriods returns the periods among the last months up to now that the
+// customer has already paid through the app, on their own or as arrears.
+func paidPeriods(transactionRepository repository.TransactionRepository, customerID string, now time.Time, months int) (map[string]bool, error) {
+	first := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, now.Location()).AddDate(0, 1-months, 0)
+	transactions, err := transactionRepository.GetPaidBillsSinceRepository(transactionPrefix, customerID, first)
+	if err != nil {
+		return nil, err
+	}
+
+	paid := map[string]bool{}
+	for _, transaction := range transactions {
+		data, err := json.Marshal(transaction.ProductDetail)
+		if err != nil {
+			return nil, err
+		}
+		var bill model.BpjsKesehatanBill
+		if err := json.Unmarshal(data, &bill); err != nil {
+			return nil, err
+		}
+
+		if len(bill.Breakdown) == 0 {
+			paid[bill.Period] = true
+		}
+		for _, month := range bill.Breakdown {
+			paid[month.Period] = true
+		}
+	}
+
+	return paid, nil
+}
+
+func calculateBPJSKesehatanInsurance(class int, numberOfFamilyMembers int) float64 {
+	const (
+		kelas1IuranPerOrang = 150000.0
+		kelas2IuranPerOrang = 100000.0
+		kelas3IuranPerOrang = 35000.0
+		kelas3PbpuBantuan   = 7000.0
+	)
+
+	var iuranPerOrang float64
+
+	switch class {
+	case 1:
+		iuranPerOrang = kelas1IuranPerOrang
+	case 2:
+		iuranPerOrang = kelas2IuranPerOrang
+	case 3:
+		iuranPerOrang = kelas3IuranPerOrang
+	}
+
+	totalIuran := iuranPerOrang * float64(numberOfFamilyMembers)
+
+	if class == 3 {
+		totalIuran -= kelas3PbpuBantuan
+	}
+
+	return totalIuran
+}
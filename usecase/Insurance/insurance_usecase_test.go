@@ -3,25 +3,29 @@ package insurance
 import (
 	"BE-Golang/model"
 	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
 	"errors"
-	"math/rand"
 	"strconv"
 	"strings"
 	"testing"
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type InsuranceUsecaseTest struct {
 	suite.Suite
-	insuranceUsecase InsuranceUseCase
-	insuraceRepo     *mocks.InsuranceRepository
-	userRepo         *mocks.UserRepository
-	discountRepo     *mocks.DiscountRepository
-	transactionRepo  *mocks.TransactionRepository
-	billerOyApiRepo  *mocks.BillerOyApiRepository
+	insuranceUsecase   InsuranceUseCase
+	insuraceRepo       *mocks.InsuranceRepository
+	userRepo           *mocks.UserRepository
+	discountRepo       *mocks.DiscountRepository
+	transactionRepo    *mocks.TransactionRepository
+	billerOyApiRepo    *mocks.BillerOyApiRepository
+	savedBillerRepo    *mocks.SavedBillerRepository
+	customerIdRuleRepo *mocks.CustomerIdRuleRepository
+	availabilityRepo   *mocks.ProductAvailabilityRepository
 }
 
 func TestInsuranceUsecase(t *testing.T) {
@@ -34,7 +38,15 @@ func (m *InsuranceUsecaseTest) SetupTest() {
 	m.discountRepo = &mocks.DiscountRepository{}
 	m.transactionRepo = &mocks.TransactionRepository{}
 	m.billerOyApiRepo = &mocks.BillerOyApiRepository{}
-	m.insuranceUsecase = NewInsuranceUseCase(m.insuraceRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo)
+	m.savedBillerRepo = &mocks.SavedBillerRepository{}
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	m.customerIdRuleRepo.On("GetCustomerIdRulesByProductRepository", mock.Anything).Return(nil, nil)
+	m.availabilityRepo = &mocks.ProductAvailabilityRepository{}
+	m.availabilityRepo.On("GetProductAvailabilityRepository", mock.Anything, mock.Anything).Return(nil, nil)
+	m.availabilityRepo.On("AddProductAvailabilityFailureRepository", mock.Anything, mock.Anything).Return(nil)
+	m.availabilityRepo.On("TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	m.availabilityRepo.On("ResetProductAvailabilityFailuresRepository", mock.Anything, mock.Anything).Return(nil)
+	m.insuranceUsecase = NewInsuranceUseCase(m.insuraceRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo, m.savedBillerRepo, m.customerIdRuleRepo, m.availabilityRepo)
 }
 
 func (m *InsuranceUsecaseTest) TestCreateInsuranceUseCaseSuccess() {
@@ -202,40 +214,16 @@ func (m *InsuranceUsecaseTest) TestDeleteInsuranceByIDUseCaseError() {
 
 }
 
-func init() {
-	rand.Seed(time.Now().UnixNano())
-}
-
-func TestGenerateVANumber(t *testing.T) {
-	length := 10
-
-	vaNumber := generateVANumber(length)
-
-	if len(vaNumber) != length {
-		t.Errorf("expected VA number length %d, but got %d", length, len(vaNumber))
-	}
-	// Add more assertions for the VA number if needed.
-}
-
-func TestGenerateRandomClass(t *testing.T) {
-	class := generateRandomClass()
-
-	if class < 1 || class > 3 {
-		t.Errorf("unexpected class value: %d", class)
-	}
-	// Add more assertions for the class if needed.
-}
+func TestLookupPolicyIsStable(t *testing.T) {
+	class, numberOfFamilyMembers := lookupPolicy("0001234567890")
 
-func TestGenerateRandomNumberOfFamilyMembers(t *testing.T) {
-	min := 1
-	max := 3
-
-	numFamilyMembers := generateRandomNumberOfFamilyMembers()
-
-	if numFamilyMembers < min || numFamilyMembers > max {
-		t.Errorf("unexpected number of family members: %d", numFamilyMembers)
-	}
-	// Add more assertions for the number of family members if needed.
+	assert.GreaterOrEqual(t, class, 1)
+	assert.LessOrEqual(t, class, 3)
+	assert.GreaterOrEqual(t, numberOfFamilyMembers, 1)
+	assert.LessOrEqual(t, numberOfFamilyMembers, 3)
+	again, againMembers := lookupPolicy("0001234567890")
+	assert.Equal(t, class, again)
+	assert.Equal(t, numberOfFamilyMembers, againMembers)
 }
 
 func TestCalculateBPJSKesehatanInsurance(t *testing.T) {
@@ -728,3 +716,62 @@ func (m *InsuranceUsecaseTest) TestPayBillInsuranceUseCase_ErrorUpdateUserID() {
 // 	}
 
 // }
+
+func TestPriceBillArrears(t *testing.T) {
+	transactionRepo := &mocks.TransactionRepository{}
+	transactionRepo.On("GetPaidBillsSinceRepository", "INSURANCE", "123", time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC)).Return(nil, nil)
+
+	bill, err := priceBill(transactionRepo, &biller.Inquiry{
+		User:     &model.User{Name: "User"},
+		Payload:  &model.OyBillerApi{CustomerId: "123", Period: "March-2026", Months: 3},
+		Response: &model.OyBillerApiResponse{},
+		Discount: &model.Discount{},
+		Now:      time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	detail := bill.Detail.(*model.BpjsKesehatanBill)
+	contribution := detail.Breakdown[2].Contribution
+	assert.Equal(t, []string{"January-2026", "February-2026", "March-2026"}, []string{
+		detail.Breakdown[0].Period, detail.Breakdown[1].Period, detail.Breakdown[2].Period,
+	})
+	assert.Equal(t, contribution*latePenaltyRate*2, detail.Breakdown[0].Penalty)
+	assert.Equal(t, 0.0, detail.Breakdown[2].Penalty)
+	assert.Equal(t, contribution*3+detail.Penalty, bill.Price)
+	assert.Len(t, detail.Members, detail.NumberOffamily)
+	assert.Equal(t, "123", detail.Members[0].CardNumber)
+}
+
+func TestPriceBillSkipsPaidMonths(t *testing.T) {
+	transactionRepo := &mocks.TransactionRepository{}
+	transactionRepo.On("GetPaidBillsSinceRepository", "INSURANCE", "123", mock.Anything).Return([]*model.Transaction{
+		{ProductDetail: map[string]interface{}{"period": "February-2026", "breakdown": []interface{}{
+			map[string]interface{}{"period": "January-2026"},
+			map[string]interface{}{"period": "February-2026"},
+		}}},
+	}, nil)
+
+	bill, err := priceBill(transactionRepo, &biller.Inquiry{
+		User:     &model.User{Name: "User"},
+		Payload:  &model.OyBillerApi{CustomerId: "123", Period: "March-2026", Months: 3},
+		Response: &model.OyBillerApiResponse{},
+		Discount: &model.Discount{},
+		Now:      time.Date(2026, time.March, 31, 0, 0, 0, 0, time.UTC),
+	})
+	assert.NoError(t, err)
+
+	detail := bill.Detail.(*model.BpjsKesehatanBill)
+	if assert.Len(t, detail.Breakdown, 1) {
+		assert.Equal(t, "March-2026", detail.Breakdown[0].Period)
+	}
+	assert.Equal(t, 1, detail.Months)
+	assert.Equal(t, 0.0, detail.Penalty)
+}
+
+func TestPriceBillRejectsTooManyMonths(t *testing.T) {
+	_, err := priceBill(&mocks.TransactionRepository{}, &biller.Inquiry{
+		Payload: &model.OyBillerApi{Months: model.BPJS_MAX_MONTHS + 1},
+	})
+
+	assert.EqualError(t, err, "months must be between 1 and 12")
+}
@@ -0,0 +1,128 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+// Self-employed (BPU) contribution rates. JKK and JHT are a share of the
+// declared monthly income, JKM is a flat amount.
+const (
+	jkkRate   = 0.01
+	jhtRate   = 0.02
+	jkmAmount = 6800.0
+)
+
+// NewKetenagakerjaanProduct plugs BPJS Ketenagakerjaan contributions into the
+// biller engine. The inquiry's product ID selects a package from the catalog
+// and months pays that many months ahead.
+func NewKetenagakerjaanProduct(bpjsRepository repository.BpjsKetenagakerjaanRepository) *biller.Product {
+	return &biller.Product{
+		Code:              model.PRODUCT_BPJS_KETENAGAKERJAAN,
+		Category:          model.PRODUCT_BPJS_KETENAGAKERJAAN,
+		Name:              "BPJS Ketenagakerjaan",
+		TransactionPrefix: "BPJSTK",
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			return priceKetenagakerjaan(bpjsRepository, inquiry)
+		},
+		Detail: func() interface{} {
+			return &model.BpjsKetenagakerjaanBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			bpjs := detail.(*model.BpjsKetenagakerjaanBill)
+			receipt.ProviderName = bpjs.ProviderName
+			receipt.Period = breakdownPeriod(bpjs.Breakdown, receipt.Period)
+		},
+	}
+}
+
+func priceKetenagakerjaan(bpjsRepository repository.BpjsKetenagakerjaanRepository, inquiry *biller.Inquiry) (*biller.Bill, error) {
+	months, err := billMonths(inquiry.Payload.Months)
+	if err != nil {
+		return nil, err
+	}
+
+	bpjs, err := bpjsRepository.GetBpjsKetenagakerjaanByTypeRepository(inquiry.ProductType)
+	if err != nil {
+		return nil, err
+	}
+	if bpjs == nil {
+		return nil, fmt.Errorf("bpjs ketenagakerjaan package %s not found", inquiry.ProductType)
+	}
+
+	breakdown := make([]model.BpjsMonth, 0, months)
+	for i := 0; i < months; i++ {
+		breakdown = append(breakdown, model.BpjsMonth{
+			Period:       biller.PeriodAt(inquiry.Now, i),
+			Contribution: bpjs.Price,
+			Total:        bpjs.Price,
+		})
+	}
+	price := bpjs.Price * float64(months)
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Iuran BPJS Ketenagakerjaan %s ", breakdownPeriod(breakdown, inquiry.Payload.Period)),
+		Detail: &model.BpjsKetenagakerjaanBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: bpjs.ProviderName,
+			Type:         bpjs.Type,
+			Name:         inquiry.User.Name,
+			Period:       inquiry.Payload.Period,
+			Programs:     bpjs.Programs,
+			Income:       bpjs.Income,
+			Months:       months,
+			Breakdown:    breakdown,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        price,
+		},
+	}, nil
+}
+
+// normalizePrograms lower-cases, de-duplicates and sorts a comma separated
+// program list. JKK and JKM are mandatory for self-employed participants.
+func normalizePrograms(programs string) (string, error) {
+	seen := map[string]bool{}
+	var list []string
+	for _, program := range strings.Split(programs, ",") {
+		program = strings.ToLower(strings.TrimSpace(program))
+		if program == "" || seen[program] {
+			continue
+		}
+		switch program {
+		case model.BPJS_PROGRAM_JKK, model.BPJS_PROGRAM_JKM, model.BPJS_PROGRAM_JHT:
+		default:
+			return "", fmt.Errorf("unknown bpjs ketenagakerjaan program %s", program)
+		}
+		seen[program] = true
+		list = append(list, program)
+	}
+
+	if !seen[model.BPJS_PROGRAM_JKK] || !seen[model.BPJS_PROGRAM_JKM] {
+		return "", errors.New("programs must include jkk and jkm")
+	}
+
+	sort.Strings(list)
+	return strings.Join(list, ","), nil
+}
+
+func calculateKetenagakerjaanContribution(programs string, income float64) float64 {
+	var total float64
+	for _, program := range strings.Split(programs, ",") {
+		switch program {
+		case model.BPJS_PROGRAM_JKK:
+			total += income * jkkRate
+		case model.BPJS_PROGRAM_JKM:
+			total += jkmAmount
+		case model.BPJS_PROGRAM_JHT:
+			total += income * jhtRate
+		}
+	}
+
+	return total
+}
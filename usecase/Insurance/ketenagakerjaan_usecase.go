@@ -0,0 +1,116 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type KetenagakerjaanUseCase interface {
+	CreateKetenagakerjaanUseCase(payload *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)
+	GetAllKetenagakerjaanUseCase(page, limit int) ([]*model.BpjsKetenagakerjaan, error)
+	GetKetenagakerjaanByIdUseCase(id string) (*model.BpjsKetenagakerjaan, error)
+	UpdateKetenagakerjaanByIdUseCase(id string, payload *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error)
+	DeleteKetenagakerjaanByIdUseCase(id string) error
+}
+
+type ketenagakerjaanUseCase struct {
+	bpjsRepository repository.BpjsKetenagakerjaanRepository
+}
+
+func NewKetenagakerjaanUseCase(bpjsRepository repository.BpjsKetenagakerjaanRepository) *ketenagakerjaanUseCase {
+	return &ketenagakerjaanUseCase{
+		bpjsRepository: bpjsRepository,
+	}
+}
+
+func (uc *ketenagakerjaanUseCase) CreateKetenagakerjaanUseCase(payload *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	payload.Type = strings.ToLower(payload.Type)
+	if payload.Type == "" {
+		return nil, errors.New("product type is required")
+	}
+	if payload.Income <= 0 {
+		return nil, errors.New("income must be greater than 0")
+	}
+
+	programs, err := normalizePrograms(payload.Programs)
+	if err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.bpjsRepository.GetBpjsKetenagakerjaanByTypeRepository(payload.Type)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("bpjs ketenagakerjaan %s already exists", payload.Type)
+	}
+
+	payload.Programs = programs
+	payload.Price = calculateKetenagakerjaanContribution(programs, payload.Income)
+
+	bpjs, err := uc.bpjsRepository.CreateBpjsKetenagakerjaanRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating bpjs ketenagakerjaan in database: %w", err)
+	}
+
+	return bpjs, nil
+}
+
+func (uc *ketenagakerjaanUseCase) GetAllKetenagakerjaanUseCase(page, limit int) ([]*model.BpjsKetenagakerjaan, error) {
+	return uc.bpjsRepository.GetAllBpjsKetenagakerjaanRepository(page, limit)
+}
+
+func (uc *ketenagakerjaanUseCase) GetKetenagakerjaanByIdUseCase(id string) (*model.BpjsKetenagakerjaan, error) {
+	bpjs, err := uc.bpjsRepository.GetBpjsKetenagakerjaanByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("bpjs ketenagakerjaan not found")
+	}
+
+	return bpjs, nil
+}
+
+func (uc *ketenagakerjaanUseCase) UpdateKetenagakerjaanByIdUseCase(id string, payload *model.BpjsKetenagakerjaan) (*model.BpjsKetenagakerjaan, error) {
+	bpjs, err := uc.bpjsRepository.GetBpjsKetenagakerjaanByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bpjs ketenagakerjaan: %v", err)
+	}
+
+	if payload.ProviderName != "" {
+		bpjs.ProviderName = payload.ProviderName
+	}
+	if payload.Name != "" {
+		bpjs.Name = payload.Name
+	}
+	if payload.Income > 0 {
+		bpjs.Income = payload.Income
+	}
+	if payload.Programs != "" {
+		programs, err := normalizePrograms(payload.Programs)
+		if err != nil {
+			return nil, err
+		}
+		bpjs.Programs = programs
+	}
+	bpjs.Price = calculateKetenagakerjaanContribution(bpjs.Programs, bpjs.Income)
+	bpjs.UpdatedAt = time.Now()
+
+	updated, err := uc.bpjsRepository.UpdateBpjsKetenagakerjaanByIdRepository(id, bpjs)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update bpjs ketenagakerjaan: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *ketenagakerjaanUseCase) DeleteKetenagakerjaanByIdUseCase(id string) error {
+	err := uc.bpjsRepository.DeleteBpjsKetenagakerjaanByIdRepository(id)
+	if err != nil {
+		return errors.New("bpjs ketenagakerjaan not found")
+	}
+
+	return nil
+}
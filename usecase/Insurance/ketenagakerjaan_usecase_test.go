@@ -0,0 +1,119 @@
+package insurance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type KetenagakerjaanUseCaseTest struct {
+	suite.Suite
+	ketenagakerjaanUseCase KetenagakerjaanUseCase
+	bpjsRepo               *mocks.BpjsKetenagakerjaanRepository
+}
+
+func TestKetenagakerjaanUseCase(t *testing.T) {
+	suite.Run(t, new(KetenagakerjaanUseCaseTest))
+}
+
+func (m *KetenagakerjaanUseCaseTest) SetupTest() {
+	m.bpjsRepo = &mocks.BpjsKetenagakerjaanRepository{}
+	m.ketenagakerjaanUseCase = NewKetenagakerjaanUseCase(m.bpjsRepo)
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestCreateKetenagakerjaanSuccess() {
+	m.bpjsRepo.On("GetBpjsKetenagakerjaanByTypeRepository", "bpu-1").Return(nil, nil)
+	m.bpjsRepo.On("CreateBpjsKetenagakerjaanRepository", mock.Anything).Return(func(bpjs *model.BpjsKetenagakerjaan) *model.BpjsKetenagakerjaan {
+		return bpjs
+	}, nil)
+
+	resp, err := m.ketenagakerjaanUseCase.CreateKetenagakerjaanUseCase(&model.BpjsKetenagakerjaan{
+		Type:     "BPU-1",
+		Programs: "JKM, jht,jkk",
+		Income:   1000000,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "bpu-1", resp.Type)
+	assert.Equal(m.T(), "jht,jkk,jkm", resp.Programs)
+	assert.Equal(m.T(), 36800.0, resp.Price)
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestCreateKetenagakerjaanMissingMandatoryProgram() {
+	_, err := m.ketenagakerjaanUseCase.CreateKetenagakerjaanUseCase(&model.BpjsKetenagakerjaan{
+		Type:     "bpu-1",
+		Programs: "jkk,jht",
+		Income:   1000000,
+	})
+
+	assert.EqualError(m.T(), err, "programs must include jkk and jkm")
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestCreateKetenagakerjaanDuplicate() {
+	m.bpjsRepo.On("GetBpjsKetenagakerjaanByTypeRepository", "bpu-1").Return(&model.BpjsKetenagakerjaan{}, nil)
+
+	_, err := m.ketenagakerjaanUseCase.CreateKetenagakerjaanUseCase(&model.BpjsKetenagakerjaan{
+		Type:     "bpu-1",
+		Programs: "jkk,jkm",
+		Income:   1000000,
+	})
+
+	assert.EqualError(m.T(), err, "bpjs ketenagakerjaan bpu-1 already exists")
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestUpdateKetenagakerjaanRecalculatesPrice() {
+	m.bpjsRepo.On("GetBpjsKetenagakerjaanByIdRepository", "id").Return(&model.BpjsKetenagakerjaan{
+		Type:     "bpu-1",
+		Programs: "jkk,jkm",
+		Income:   1000000,
+		Price:    16800,
+	}, nil)
+	m.bpjsRepo.On("UpdateBpjsKetenagakerjaanByIdRepository", "id", mock.Anything).Return(func(id string, bpjs *model.BpjsKetenagakerjaan) *model.BpjsKetenagakerjaan {
+		return bpjs
+	}, nil)
+
+	resp, err := m.ketenagakerjaanUseCase.UpdateKetenagakerjaanByIdUseCase("id", &model.BpjsKetenagakerjaan{Income: 2000000})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), 26800.0, resp.Price)
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestPriceKetenagakerjaanPaysMonthsAhead() {
+	m.bpjsRepo.On("GetBpjsKetenagakerjaanByTypeRepository", "bpu-1").Return(&model.BpjsKetenagakerjaan{
+		Type:     "bpu-1",
+		Programs: "jkk,jkm",
+		Price:    16800,
+	}, nil)
+
+	bill, err := NewKetenagakerjaanProduct(m.bpjsRepo).Price(&biller.Inquiry{
+		User:        &model.User{Name: "User"},
+		Payload:     &model.OyBillerApi{CustomerId: "123", Period: "November-2026", Months: 3},
+		Response:    &model.OyBillerApiResponse{},
+		Discount:    &model.Discount{},
+		ProductType: "bpu-1",
+		Now:         time.Date(2026, time.November, 30, 0, 0, 0, 0, time.UTC),
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), 50400.0, bill.Price)
+	detail := bill.Detail.(*model.BpjsKetenagakerjaanBill)
+	assert.Equal(m.T(), "November-2026", detail.Breakdown[0].Period)
+	assert.Equal(m.T(), "January-2027", detail.Breakdown[2].Period)
+}
+
+func (m *KetenagakerjaanUseCaseTest) TestPriceKetenagakerjaanUnknownPackage() {
+	m.bpjsRepo.On("GetBpjsKetenagakerjaanByTypeRepository", "bpu-9").Return(nil, nil)
+
+	_, err := NewKetenagakerjaanProduct(m.bpjsRepo).Price(&biller.Inquiry{
+		Payload:     &model.OyBillerApi{},
+		ProductType: "bpu-9",
+	})
+
+	assert.EqualError(m.T(), err, "bpjs ketenagakerjaan package bpu-9 not found")
+}
@@ -0,0 +1,213 @@
+package auth
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/mail"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultPasswordResetTTL  = 15 * time.Minute
+	passwordResetWindow      = time.Hour
+	maxPasswordResetRequests = 3
+	maxPasswordResetAttempts = 5
+)
+
+var ErrInvalidResetCode = errors.New("invalid or expired reset code")
+
+type PasswordResetUseCase interface {
+	RequestPasswordResetUseCase(email string) error
+	ResetPasswordUseCase(payload dto.ResetPasswordDto) (*model.AuthResponse, error)
+	RunPasswordResetCleanupUseCase(now time.Time) error
+}
+
+type passwordResetUseCase struct {
+	passwordResetRepository repository.PasswordResetRepository
+	userRepository          repository.UserRepository
+	sessionUseCase          SessionUseCase
+	codeKey                 []byte
+	sendMail                func(payload model.PayloadMail) error
+	background              func(task func())
+	now                     func() time.Time
+}
+
+func NewPasswordResetUseCase(passwordResetRepository repository.PasswordResetRepository, userRepository repository.UserRepository, sessionUseCase SessionUseCase, codeKey string) *passwordResetUseCase {
+	if codeKey == "" {
+		panic("PASSWORD_RESET_KEY must be set to sign reset codes")
+	}
+
+	return &passwordResetUseCase{
+		passwordResetRepository: passwordResetRepository,
+		userRepository:          userRepository,
+		sessionUseCase:          sessionUseCase,
+		codeKey:                 []byte(codeKey),
+		sendMail:                mail.SendingNotificationMail,
+		background:              func(task func()) { go task() },
+		now:                     time.Now,
+	}
+}
+
+// RequestPasswordResetUseCase emails a reset code to the user with the given
+// email. The code is created and sent in the background and success is
+// reported for every email, so neither the answer nor the time it takes tells
+// callers which emails are registered.
+func (uc *passwordResetUseCase) RequestPasswordResetUseCase(email string) error {
+	email = strings.ToLower(strings.TrimSpace(email))
+	if email == "" {
+		return errors.New("email is required")
+	}
+
+	uc.background(func() {
+		if err := uc.sendResetCode(email); err != nil {
+			log.Printf("failed to send password reset code: %v", err)
+		}
+	})
+
+	return nil
+}
+
+// sendResetCode emails a new reset code to the user with the given email,
+// unless there is no such user or the user has asked too often.
+func (uc *passwordResetUseCase) sendResetCode(email string) error {
+	user, err := uc.userRepository.GetUserByEmail(email)
+	if err != nil {
+		return nil
+	}
+
+	now := uc.now()
+	count, err := uc.passwordResetRepository.CountPasswordResetsSinceRepository(user.ID, now.Add(-passwordResetWindow))
+	if err != nil {
+		return err
+	}
+	if count >= maxPasswordResetRequests {
+		log.Printf("password reset limit reached for user %s", user.ID)
+		return nil
+	}
+
+	code, err := newResetCode()
+	if err != nil {
+		return err
+	}
+	reset := &model.PasswordReset{
+		UserID:    user.ID,
+		ExpiresAt: now.Add(passwordResetTTL()),
+	}
+	reset.ID = uuid.New().String()
+	reset.CodeHash = uc.sign(reset.ID, code)
+	if _, err := uc.passwordResetRepository.CreatePasswordResetRepository(reset); err != nil {
+		return err
+	}
+
+	mailsend := model.PayloadMail{
+		CustomerName:  user.Name,
+		RecipentEmail: user.Email,
+		Subject:       "Reset Password",
+		Description: fmt.Sprintf("Kode reset password anda adalah %s. Kode berlaku selama %d menit dan hanya dapat digunakan satu kali. Abaikan email ini jika anda tidak meminta reset password.",
+			code, int(passwordResetTTL().Minutes())),
+	}
+	if err := uc.sendMail(mailsend); err != nil {
+		log.Printf("failed to send password reset mail to user %s: %v", user.ID, err)
+	}
+
+	return nil
+}
+
+// ResetPasswordUseCase sets a new password using the latest code emailed to
+// the user. Every session of the user is ended and a new one is returned.
+func (uc *passwordResetUseCase) ResetPasswordUseCase(payload dto.ResetPasswordDto) (*model.AuthResponse, error) {
+	if payload.Email == "" || payload.Code == "" || payload.NewPassword == "" {
+		return nil, errors.New("email, code and new_password are required fields")
+	}
+
+	user, err := uc.userRepository.GetUserByEmail(strings.ToLower(strings.TrimSpace(payload.Email)))
+	if err != nil {
+		return nil, ErrInvalidResetCode
+	}
+	reset, err := uc.passwordResetRepository.GetLatestPasswordResetByUserIdRepository(user.ID)
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if reset == nil || reset.UsedAt != nil || !now.Before(reset.ExpiresAt) || reset.Attempts >= maxPasswordResetAttempts {
+		return nil, ErrInvalidResetCode
+	}
+
+	// The attempt is counted before the code is checked, so parallel guesses
+	// each use up one of the attempts.
+	counted, err := uc.passwordResetRepository.AddPasswordResetAttemptRepository(reset.ID, maxPasswordResetAttempts)
+	if err != nil {
+		return nil, err
+	}
+	if !counted || !hmac.Equal([]byte(reset.CodeHash), []byte(uc.sign(reset.ID, payload.Code))) {
+		return nil, ErrInvalidResetCode
+	}
+
+	used, err := uc.passwordResetRepository.MarkPasswordResetUsedRepository(reset.ID, now)
+	if err != nil {
+		return nil, err
+	}
+	if !used {
+		return nil, ErrInvalidResetCode
+	}
+
+	hashedPassword, err := HashPassword(payload.NewPassword)
+	if err != nil {
+		return nil, fmt.Errorf("failed to hash password: %v", err)
+	}
+	user.Password = hashedPassword
+	user.UpdatedAt = now
+	if _, err := uc.userRepository.UpdateUserByIDRepository(user.ID, user); err != nil {
+		return nil, fmt.Errorf("failed to update user password: %v", err)
+	}
+
+	if err := uc.sessionUseCase.LogoutAllUseCase(user.ID); err != nil {
+		return nil, err
+	}
+
+	return uc.sessionUseCase.CreateSessionUseCase(user)
+}
+
+// RunPasswordResetCleanupUseCase deletes codes that expired before the
+// current request window, so they no longer count towards the limit.
+func (uc *passwordResetUseCase) RunPasswordResetCleanupUseCase(now time.Time) error {
+	return uc.passwordResetRepository.DeleteExpiredPasswordResetsRepository(now.Add(-passwordResetWindow))
+}
+
+// sign binds a code to its reset, so the stored hash cannot be matched
+// against a precomputed table of all codes.
+func (uc *passwordResetUseCase) sign(resetID, code string) string {
+	mac := hmac.New(sha256.New, uc.codeKey)
+	mac.Write([]byte(resetID + ":" + code))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func newResetCode() (string, error) {
+	n, err := rand.Int(rand.Reader, big.NewInt(1000000))
+	if err != nil {
+		return "", fmt.Errorf("failed to create reset code: %w", err)
+	}
+
+	return fmt.Sprintf("%06d", n.Int64()), nil
+}
+
+func passwordResetTTL() time.Duration {
+	if config.AppConfig.PasswordResetMinutes > 0 {
+		return time.Duration(config.AppConfig.PasswordResetMinutes) * time.Minute
+	}
+
+	return defaultPasswordResetTTL
+}
@@ -0,0 +1,179 @@
+package auth
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PasswordResetUseCaseTest struct {
+	suite.Suite
+	passwordResetUseCase  *passwordResetUseCase
+	passwordResetRepoMock *mocks.PasswordResetRepository
+	sessionRepoMock       *mocks.SessionRepository
+	userRepoMock          *mocks.UserRepository
+	sent                  []model.PayloadMail
+	now                   time.Time
+}
+
+func TestPasswordResetUseCase(t *testing.T) {
+	suite.Run(t, new(PasswordResetUseCaseTest))
+}
+
+func (s *PasswordResetUseCaseTest) SetupTest() {
+	s.passwordResetRepoMock = &mocks.PasswordResetRepository{}
+	s.sessionRepoMock = &mocks.SessionRepository{}
+	s.userRepoMock = &mocks.UserRepository{}
+	s.sent = nil
+	s.now = time.Now().Truncate(time.Second)
+
+	sessionUseCase := NewSessionUseCase(s.sessionRepoMock, s.userRepoMock)
+	sessionUseCase.now = func() time.Time { return s.now }
+	s.passwordResetUseCase = NewPasswordResetUseCase(s.passwordResetRepoMock, s.userRepoMock, sessionUseCase, "key")
+	s.passwordResetUseCase.now = func() time.Time { return s.now }
+	s.passwordResetUseCase.background = func(task func()) { task() }
+	s.passwordResetUseCase.sendMail = func(payload model.PayloadMail) error {
+		s.sent = append(s.sent, payload)
+		return nil
+	}
+}
+
+func (s *PasswordResetUseCaseTest) user() *model.User {
+	return &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, UserType: model.USER_TYPE, Name: "arby", Email: "arby@mail.com", Password: "old"}
+}
+
+func (s *PasswordResetUseCaseTest) reset(code string) *model.PasswordReset {
+	reset := &model.PasswordReset{UserID: "user", ExpiresAt: s.now.Add(10 * time.Minute)}
+	reset.ID = "reset"
+	reset.CodeHash = s.passwordResetUseCase.sign(reset.ID, code)
+	return reset
+}
+
+func (s *PasswordResetUseCaseTest) TestRequestSendsCode() {
+	var created *model.PasswordReset
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+	s.passwordResetRepoMock.On("CountPasswordResetsSinceRepository", "user", s.now.Add(-passwordResetWindow)).Return(int64(0), nil)
+	s.passwordResetRepoMock.On("CreatePasswordResetRepository", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*model.PasswordReset)
+	}).Return(nil, nil)
+
+	err := s.passwordResetUseCase.RequestPasswordResetUseCase(" Arby@Mail.com ")
+
+	s.Require().NoError(err)
+	s.Require().Len(s.sent, 1)
+	assert.Equal(s.T(), "arby@mail.com", s.sent[0].RecipentEmail)
+	code := regexp.MustCompile(`\d{6}`).FindString(s.sent[0].Description)
+	s.Require().NotEmpty(code)
+	assert.Equal(s.T(), s.passwordResetUseCase.sign(created.ID, code), created.CodeHash)
+	assert.NotContains(s.T(), created.CodeHash, code)
+	assert.Equal(s.T(), s.now.Add(defaultPasswordResetTTL), created.ExpiresAt)
+}
+
+func (s *PasswordResetUseCaseTest) TestRequestUnknownEmail() {
+	s.userRepoMock.On("GetUserByEmail", "nobody@mail.com").Return(nil, errors.New("record not found"))
+
+	err := s.passwordResetUseCase.RequestPasswordResetUseCase("nobody@mail.com")
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.sent)
+	s.passwordResetRepoMock.AssertNotCalled(s.T(), "CreatePasswordResetRepository", mock.Anything)
+}
+
+func (s *PasswordResetUseCaseTest) TestRequestRateLimited() {
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+	s.passwordResetRepoMock.On("CountPasswordResetsSinceRepository", "user", mock.Anything).Return(int64(maxPasswordResetRequests), nil)
+
+	err := s.passwordResetUseCase.RequestPasswordResetUseCase("arby@mail.com")
+
+	assert.NoError(s.T(), err)
+	assert.Empty(s.T(), s.sent)
+	s.passwordResetRepoMock.AssertNotCalled(s.T(), "CreatePasswordResetRepository", mock.Anything)
+}
+
+func (s *PasswordResetUseCaseTest) TestResetPassword() {
+	user := s.user()
+	reset := s.reset("123456")
+	active := &model.Session{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "session"}, UserID: "user", AccessTokenID: "jti", AccessExpiresAt: s.now.Add(time.Minute), ExpiresAt: s.now.Add(time.Hour)}
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(user, nil)
+	s.passwordResetRepoMock.On("GetLatestPasswordResetByUserIdRepository", "user").Return(reset, nil)
+	s.passwordResetRepoMock.On("AddPasswordResetAttemptRepository", reset.ID, maxPasswordResetAttempts).Return(true, nil)
+	s.passwordResetRepoMock.On("MarkPasswordResetUsedRepository", reset.ID, s.now).Return(true, nil)
+	s.userRepoMock.On("UpdateUserByIDRepository", "user", user).Return(user, nil)
+	s.sessionRepoMock.On("GetActiveSessionsByUserIdRepository", "user", s.now).Return([]*model.Session{active}, nil)
+	s.sessionRepoMock.On("RevokeSessionRepository", "session", s.now).Return(true, nil)
+	s.sessionRepoMock.On("CreateSessionRepository", mock.Anything).Return(nil, nil)
+
+	resp, err := s.passwordResetUseCase.ResetPasswordUseCase(dto.ResetPasswordDto{Email: "arby@mail.com", Code: "123456", NewPassword: "new"})
+
+	s.Require().NoError(err)
+	assert.NotEmpty(s.T(), resp.RefreshToken)
+	assert.True(s.T(), ComparePasswords(user.Password, "new"))
+	assert.NotNil(s.T(), active.RevokedAt)
+}
+
+func (s *PasswordResetUseCaseTest) TestResetPasswordWrongCode() {
+	reset := s.reset("123456")
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+	s.passwordResetRepoMock.On("GetLatestPasswordResetByUserIdRepository", "user").Return(reset, nil)
+	s.passwordResetRepoMock.On("AddPasswordResetAttemptRepository", reset.ID, maxPasswordResetAttempts).Return(true, nil)
+
+	_, err := s.passwordResetUseCase.ResetPasswordUseCase(dto.ResetPasswordDto{Email: "arby@mail.com", Code: "654321", NewPassword: "new"})
+
+	assert.ErrorIs(s.T(), err, ErrInvalidResetCode)
+	s.passwordResetRepoMock.AssertCalled(s.T(), "AddPasswordResetAttemptRepository", reset.ID, maxPasswordResetAttempts)
+	s.passwordResetRepoMock.AssertNotCalled(s.T(), "MarkPasswordResetUsedRepository", mock.Anything, mock.Anything)
+	s.userRepoMock.AssertNotCalled(s.T(), "UpdateUserByIDRepository", mock.Anything, mock.Anything)
+}
+
+func (s *PasswordResetUseCaseTest) TestResetPasswordAttemptsUsedUp() {
+	reset := s.reset("123456")
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+	s.passwordResetRepoMock.On("GetLatestPasswordResetByUserIdRepository", "user").Return(reset, nil)
+	s.passwordResetRepoMock.On("AddPasswordResetAttemptRepository", reset.ID, maxPasswordResetAttempts).Return(false, nil)
+
+	_, err := s.passwordResetUseCase.ResetPasswordUseCase(dto.ResetPasswordDto{Email: "arby@mail.com", Code: "123456", NewPassword: "new"})
+
+	assert.ErrorIs(s.T(), err, ErrInvalidResetCode)
+	s.passwordResetRepoMock.AssertNotCalled(s.T(), "MarkPasswordResetUsedRepository", mock.Anything, mock.Anything)
+}
+
+func (s *PasswordResetUseCaseTest) TestResetPasswordCodeAlreadyUsed() {
+	reset := s.reset("123456")
+	s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+	s.passwordResetRepoMock.On("GetLatestPasswordResetByUserIdRepository", "user").Return(reset, nil)
+	s.passwordResetRepoMock.On("AddPasswordResetAttemptRepository", reset.ID, maxPasswordResetAttempts).Return(true, nil)
+	s.passwordResetRepoMock.On("MarkPasswordResetUsedRepository", reset.ID, s.now).Return(false, nil)
+
+	_, err := s.passwordResetUseCase.ResetPasswordUseCase(dto.ResetPasswordDto{Email: "arby@mail.com", Code: "123456", NewPassword: "new"})
+
+	assert.ErrorIs(s.T(), err, ErrInvalidResetCode)
+	s.userRepoMock.AssertNotCalled(s.T(), "UpdateUserByIDRepository", mock.Anything, mock.Anything)
+}
+
+func (s *PasswordResetUseCaseTest) TestResetPasswordRejected() {
+	used := s.reset("123456")
+	used.UsedAt = &s.now
+	expired := s.reset("123456")
+	expired.ExpiresAt = s.now
+	locked := s.reset("123456")
+	locked.Attempts = maxPasswordResetAttempts
+
+	for _, reset := range []*model.PasswordReset{nil, used, expired, locked} {
+		s.SetupTest()
+		s.userRepoMock.On("GetUserByEmail", "arby@mail.com").Return(s.user(), nil)
+		s.passwordResetRepoMock.On("GetLatestPasswordResetByUserIdRepository", "user").Return(reset, nil)
+
+		_, err := s.passwordResetUseCase.ResetPasswordUseCase(dto.ResetPasswordDto{Email: "arby@mail.com", Code: "123456", NewPassword: "new"})
+
+		assert.ErrorIs(s.T(), err, ErrInvalidResetCode)
+		s.passwordResetRepoMock.AssertNotCalled(s.T(), "AddPasswordResetAttemptRepository", mock.Anything, mock.Anything)
+	}
+}
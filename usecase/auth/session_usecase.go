@@ -0,0 +1,220 @@
+package auth
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/middlewares"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+const (
+	defaultAccessTokenTTL  = 15 * time.Minute
+	defaultRefreshTokenTTL = 30 * 24 * time.Hour
+)
+
+var ErrInvalidRefreshToken = errors.New("invalid or expired refresh token")
+
+type SessionUseCase interface {
+	CreateSessionUseCase(user *model.User) (*model.AuthResponse, error)
+	RefreshSessionUseCase(refreshToken string) (*model.AuthResponse, error)
+	LogoutUseCase(userID, sessionID string) error
+	LogoutAllUseCase(userID string) error
+	RunSessionCleanupUseCase(now time.Time) error
+}
+
+type sessionUseCase struct {
+	sessionRepository repository.SessionRepository
+	userRepository    repository.UserRepository
+	now               func() time.Time
+}
+
+func NewSessionUseCase(sessionRepository repository.SessionRepository, userRepository repository.UserRepository) *sessionUseCase {
+	return &sessionUseCase{
+		sessionRepository: sessionRepository,
+		userRepository:    userRepository,
+		now:               time.Now,
+	}
+}
+
+// CreateSessionUseCase logs the user in on a new device.
+func (uc *sessionUseCase) CreateSessionUseCase(user *model.User) (*model.AuthResponse, error) {
+	now := uc.now()
+	session := &model.Session{
+		UserID:    user.ID,
+		ExpiresAt: now.Add(refreshTokenTTL()),
+	}
+	session.ID = uuid.New().String()
+
+	refreshToken, err := uc.issue(session, now)
+	if err != nil {
+		return nil, err
+	}
+	if _, err := uc.sessionRepository.CreateSessionRepository(session); err != nil {
+		return nil, err
+	}
+
+	return uc.response(user, session, refreshToken)
+}
+
+// RefreshSessionUseCase swaps a refresh token for a new access token and a
+// new refresh token. The old refresh token and access token stop working, and
+// of two refreshes racing with the same token only one succeeds.
+func (uc *sessionUseCase) RefreshSessionUseCase(refreshToken string) (*model.AuthResponse, error) {
+	if refreshToken == "" {
+		return nil, errors.New("refresh_token is required")
+	}
+
+	session, err := uc.sessionRepository.GetSessionByRefreshTokenRepository(hashToken(refreshToken))
+	if err != nil {
+		return nil, err
+	}
+	now := uc.now()
+	if session == nil || session.RevokedAt != nil || !now.Before(session.ExpiresAt) {
+		return nil, ErrInvalidRefreshToken
+	}
+	user, err := uc.userRepository.GetUserByIDRepository(session.UserID)
+	if err != nil {
+		return nil, ErrInvalidRefreshToken
+	}
+
+	previous := *session
+	refreshToken, err = uc.issue(session, now)
+	if err != nil {
+		return nil, err
+	}
+	rotated, err := uc.sessionRepository.RotateSessionRepository(session, previous.RefreshTokenHash)
+	if err != nil {
+		return nil, err
+	}
+	if !rotated {
+		return nil, ErrInvalidRefreshToken
+	}
+	uc.revokeAccessToken(&previous, now)
+
+	return uc.response(user, session, refreshToken)
+}
+
+// LogoutUseCase ends one session of the user.
+func (uc *sessionUseCase) LogoutUseCase(userID, sessionID string) error {
+	session, err := uc.sessionRepository.GetSessionByIdRepository(sessionID)
+	if err != nil || session.UserID != userID {
+		return errors.New("session not found")
+	}
+	if session.RevokedAt != nil {
+		return nil
+	}
+
+	return uc.revoke(session, uc.now())
+}
+
+// LogoutAllUseCase ends every session of the user, on every device.
+func (uc *sessionUseCase) LogoutAllUseCase(userID string) error {
+	now := uc.now()
+	sessions, err := uc.sessionRepository.GetActiveSessionsByUserIdRepository(userID, now)
+	if err != nil {
+		return err
+	}
+
+	for _, session := range sessions {
+		if err := uc.revoke(session, now); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// RunSessionCleanupUseCase deletes sessions and denylisted tokens that have
+// expired.
+func (uc *sessionUseCase) RunSessionCleanupUseCase(now time.Time) error {
+	return uc.sessionRepository.DeleteExpiredSessionsRepository(now)
+}
+
+// revoke ends a session and denylists its access token. A session revoked
+// in the meantime is left as it is.
+func (uc *sessionUseCase) revoke(session *model.Session, now time.Time) error {
+	revoked, err := uc.sessionRepository.RevokeSessionRepository(session.ID, now)
+	if err != nil {
+		return fmt.Errorf("failed to revoke session: %w", err)
+	}
+	if revoked {
+		session.RevokedAt = &now
+	}
+
+	return nil
+}
+
+// revokeAccessToken denylists the access token a session was last issued,
+// if it has not expired yet.
+func (uc *sessionUseCase) revokeAccessToken(session *model.Session, now time.Time) {
+	if session.AccessTokenID == "" || !now.Before(session.AccessExpiresAt) {
+		return
+	}
+
+	if err := uc.sessionRepository.RevokeTokenRepository(&model.RevokedToken{ID: session.AccessTokenID, ExpiresAt: session.AccessExpiresAt}); err != nil {
+		log.Printf("failed to revoke token of session %s: %v", session.ID, err)
+	}
+}
+
+// issue gives the session a new access token ID and refresh token, and
+// returns the refresh token. Only its hash is kept.
+func (uc *sessionUseCase) issue(session *model.Session, now time.Time) (string, error) {
+	secret := make([]byte, 32)
+	if _, err := rand.Read(secret); err != nil {
+		return "", fmt.Errorf("failed to create refresh token: %w", err)
+	}
+	refreshToken := base64.RawURLEncoding.EncodeToString(secret)
+
+	session.RefreshTokenHash = hashToken(refreshToken)
+	session.AccessTokenID = uuid.New().String()
+	session.AccessExpiresAt = now.Add(accessTokenTTL())
+
+	return refreshToken, nil
+}
+
+func (uc *sessionUseCase) response(user *model.User, session *model.Session, refreshToken string) (*model.AuthResponse, error) {
+	token, err := middlewares.CreateToken(*user, session.ID, session.AccessTokenID, session.AccessExpiresAt)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create token: %v", err)
+	}
+
+	return &model.AuthResponse{
+		ID:             user.ID,
+		Name:           user.Name,
+		Email:          user.Email,
+		Token:          token,
+		TokenExpiresAt: session.AccessExpiresAt,
+		RefreshToken:   refreshToken,
+	}, nil
+}
+
+func hashToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func accessTokenTTL() time.Duration {
+	if config.AppConfig.AccessTokenMinutes > 0 {
+		return time.Duration(config.AppConfig.AccessTokenMinutes) * time.Minute
+	}
+
+	return defaultAccessTokenTTL
+}
+
+func refreshTokenTTL() time.Duration {
+	if config.AppConfig.RefreshTokenDays > 0 {
+		return time.Duration(config.AppConfig.RefreshTokenDays) * 24 * time.Hour
+	}
+
+	return defaultRefreshTokenTTL
+}
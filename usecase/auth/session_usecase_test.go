@@ -0,0 +1,166 @@
+package auth
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	jwt "github.com/dgrijalva/jwt-go"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SessionUseCaseTest struct {
+	suite.Suite
+	sessionUseCase  *sessionUseCase
+	sessionRepoMock *mocks.SessionRepository
+	userRepoMock    *mocks.UserRepository
+	now             time.Time
+}
+
+func TestSessionUseCase(t *testing.T) {
+	suite.Run(t, new(SessionUseCaseTest))
+}
+
+func (s *SessionUseCaseTest) SetupTest() {
+	s.sessionRepoMock = &mocks.SessionRepository{}
+	s.userRepoMock = &mocks.UserRepository{}
+	s.now = time.Now().Truncate(time.Second)
+	s.sessionUseCase = NewSessionUseCase(s.sessionRepoMock, s.userRepoMock)
+	s.sessionUseCase.now = func() time.Time { return s.now }
+}
+
+func (s *SessionUseCaseTest) claims(token string) jwt.MapClaims {
+	parsed, err := jwt.Parse(token, func(token *jwt.Token) (interface{}, error) {
+		return []byte(config.AppConfig.SecretJWT), nil
+	})
+	s.Require().NoError(err)
+	return parsed.Claims.(jwt.MapClaims)
+}
+
+func (s *SessionUseCaseTest) TestCreateSession() {
+	var created *model.Session
+	s.sessionRepoMock.On("CreateSessionRepository", mock.Anything).Run(func(args mock.Arguments) {
+		created = args.Get(0).(*model.Session)
+	}).Return(nil, nil)
+
+	resp, err := s.sessionUseCase.CreateSessionUseCase(&model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, UserType: model.USER_TYPE, Name: "arby"})
+
+	s.Require().NoError(err)
+	assert.Equal(s.T(), "arby", resp.Name)
+	assert.NotEmpty(s.T(), resp.RefreshToken)
+	assert.Equal(s.T(), hashToken(resp.RefreshToken), created.RefreshTokenHash)
+	assert.Equal(s.T(), s.now.Add(defaultRefreshTokenTTL), created.ExpiresAt)
+	assert.Equal(s.T(), s.now.Add(defaultAccessTokenTTL), resp.TokenExpiresAt)
+
+	claims := s.claims(resp.Token)
+	assert.Equal(s.T(), "user", claims["userId"])
+	assert.Equal(s.T(), created.ID, claims["sid"])
+	assert.Equal(s.T(), created.AccessTokenID, claims["jti"])
+}
+
+func (s *SessionUseCaseTest) TestRefreshSessionRotatesTokens() {
+	session := &model.Session{
+		UserID:           "user",
+		RefreshTokenHash: hashToken("refresh"),
+		AccessTokenID:    "old-jti",
+		AccessExpiresAt:  s.now.Add(5 * time.Minute),
+		ExpiresAt:        s.now.Add(time.Hour),
+	}
+	session.ID = "session"
+	s.sessionRepoMock.On("GetSessionByRefreshTokenRepository", hashToken("refresh")).Return(session, nil)
+	s.userRepoMock.On("GetUserByIDRepository", "user").Return(&model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, UserType: model.USER_TYPE}, nil)
+	s.sessionRepoMock.On("RevokeTokenRepository", &model.RevokedToken{ID: "old-jti", ExpiresAt: s.now.Add(5 * time.Minute)}).Return(nil)
+	s.sessionRepoMock.On("RotateSessionRepository", session, hashToken("refresh")).Return(true, nil)
+
+	resp, err := s.sessionUseCase.RefreshSessionUseCase("refresh")
+
+	s.Require().NoError(err)
+	assert.NotEqual(s.T(), "refresh", resp.RefreshToken)
+	assert.Equal(s.T(), hashToken(resp.RefreshToken), session.RefreshTokenHash)
+	assert.NotEqual(s.T(), "old-jti", session.AccessTokenID)
+	assert.Equal(s.T(), session.AccessTokenID, s.claims(resp.Token)["jti"])
+	s.sessionRepoMock.AssertCalled(s.T(), "RevokeTokenRepository", mock.Anything)
+}
+
+func (s *SessionUseCaseTest) TestRefreshSessionAlreadyRotated() {
+	session := &model.Session{
+		UserID:           "user",
+		RefreshTokenHash: hashToken("refresh"),
+		AccessTokenID:    "old-jti",
+		AccessExpiresAt:  s.now.Add(5 * time.Minute),
+		ExpiresAt:        s.now.Add(time.Hour),
+	}
+	session.ID = "session"
+	s.sessionRepoMock.On("GetSessionByRefreshTokenRepository", hashToken("refresh")).Return(session, nil)
+	s.userRepoMock.On("GetUserByIDRepository", "user").Return(&model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, UserType: model.USER_TYPE}, nil)
+	s.sessionRepoMock.On("RotateSessionRepository", session, hashToken("refresh")).Return(false, nil)
+
+	_, err := s.sessionUseCase.RefreshSessionUseCase("refresh")
+
+	assert.ErrorIs(s.T(), err, ErrInvalidRefreshToken)
+	s.sessionRepoMock.AssertNotCalled(s.T(), "RevokeTokenRepository", mock.Anything)
+}
+
+func (s *SessionUseCaseTest) TestRefreshSessionInvalid() {
+	revokedAt := s.now.Add(-time.Minute)
+	revoked := &model.Session{RevokedAt: &revokedAt, ExpiresAt: s.now.Add(time.Hour)}
+	expired := &model.Session{ExpiresAt: s.now}
+	s.sessionRepoMock.On("GetSessionByRefreshTokenRepository", hashToken("unknown")).Return(nil, nil)
+	s.sessionRepoMock.On("GetSessionByRefreshTokenRepository", hashToken("revoked")).Return(revoked, nil)
+	s.sessionRepoMock.On("GetSessionByRefreshTokenRepository", hashToken("expired")).Return(expired, nil)
+
+	for _, token := range []string{"unknown", "revoked", "expired"} {
+		_, err := s.sessionUseCase.RefreshSessionUseCase(token)
+		assert.ErrorIs(s.T(), err, ErrInvalidRefreshToken, token)
+	}
+
+	_, err := s.sessionUseCase.RefreshSessionUseCase("")
+	assert.EqualError(s.T(), err, "refresh_token is required")
+	s.sessionRepoMock.AssertNotCalled(s.T(), "RotateSessionRepository", mock.Anything, mock.Anything)
+}
+
+func (s *SessionUseCaseTest) TestLogout() {
+	session := &model.Session{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "session"}, UserID: "user", AccessTokenID: "jti", AccessExpiresAt: s.now.Add(time.Minute)}
+	s.sessionRepoMock.On("GetSessionByIdRepository", "session").Return(session, nil)
+	s.sessionRepoMock.On("RevokeSessionRepository", "session", s.now).Return(true, nil)
+
+	assert.NoError(s.T(), s.sessionUseCase.LogoutUseCase("user", "session"))
+	assert.Equal(s.T(), s.now, *session.RevokedAt)
+}
+
+func (s *SessionUseCaseTest) TestLogoutRevokedMeanwhile() {
+	session := &model.Session{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "session"}, UserID: "user", AccessTokenID: "jti", AccessExpiresAt: s.now.Add(time.Minute)}
+	s.sessionRepoMock.On("GetSessionByIdRepository", "session").Return(session, nil)
+	s.sessionRepoMock.On("RevokeSessionRepository", "session", s.now).Return(false, nil)
+
+	assert.NoError(s.T(), s.sessionUseCase.LogoutUseCase("user", "session"))
+	assert.Nil(s.T(), session.RevokedAt)
+}
+
+func (s *SessionUseCaseTest) TestLogoutOtherUsersSession() {
+	s.sessionRepoMock.On("GetSessionByIdRepository", "session").Return(&model.Session{UserID: "other"}, nil)
+	s.sessionRepoMock.On("GetSessionByIdRepository", "missing").Return(nil, errors.New("session with ID missing not found"))
+
+	assert.EqualError(s.T(), s.sessionUseCase.LogoutUseCase("user", "session"), "session not found")
+	assert.EqualError(s.T(), s.sessionUseCase.LogoutUseCase("user", "missing"), "session not found")
+	s.sessionRepoMock.AssertNotCalled(s.T(), "RevokeSessionRepository", mock.Anything, mock.Anything)
+}
+
+func (s *SessionUseCaseTest) TestLogoutAll() {
+	phone := &model.Session{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "phone"}, UserID: "user", AccessTokenID: "phone-jti", AccessExpiresAt: s.now.Add(time.Minute)}
+	laptop := &model.Session{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "laptop"}, UserID: "user", AccessTokenID: "laptop-jti", AccessExpiresAt: s.now.Add(2 * time.Minute)}
+	s.sessionRepoMock.On("GetActiveSessionsByUserIdRepository", "user", s.now).Return([]*model.Session{phone, laptop}, nil)
+	s.sessionRepoMock.On("RevokeSessionRepository", mock.Anything, s.now).Return(true, nil)
+
+	assert.NoError(s.T(), s.sessionUseCase.LogoutAllUseCase("user"))
+
+	s.sessionRepoMock.AssertCalled(s.T(), "RevokeSessionRepository", "phone", s.now)
+	s.sessionRepoMock.AssertCalled(s.T(), "RevokeSessionRepository", "laptop", s.now)
+	assert.NotNil(s.T(), phone.RevokedAt)
+	assert.NotNil(s.T(), laptop.RevokedAt)
+}
@@ -3,7 +3,6 @@ package auth
 import (
 	"BE-Golang/model"
 	"BE-Golang/repository"
-	"BE-Golang/usecase/middlewares"
 	"errors"
 	"fmt"
 	"strings"
@@ -19,10 +18,11 @@ type AuthUsecase interface {
 
 type authUsecase struct {
 	authRepository repository.AuthRepository
+	sessionUseCase SessionUseCase
 }
 
-func NewAuthUsecase(authRepository repository.AuthRepository) *authUsecase {
-	return &authUsecase{authRepository: authRepository}
+func NewAuthUsecase(authRepository repository.AuthRepository, sessionUseCase SessionUseCase) *authUsecase {
+	return &authUsecase{authRepository: authRepository, sessionUseCase: sessionUseCase}
 }
 
 func (s *authUsecase) RegisterUseCase(payload model.User) (*model.AuthResponse, error) {
@@ -54,19 +54,7 @@ func (s *authUsecase) RegisterUseCase(payload model.User) (*model.AuthResponse,
 		return nil, fmt.Errorf("error creating user in database: %w", err)
 	}
 
-	token, err := middlewares.CreateToken(*user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create token: %v", err)
-	}
-
-	resp := &model.AuthResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Token: token,
-	}
-
-	return resp, nil
+	return s.sessionUseCase.CreateSessionUseCase(user)
 }
 
 func (s *authUsecase) LoginUseCase(payload model.User) (*model.AuthResponse, error) {
@@ -90,19 +78,7 @@ func (s *authUsecase) LoginUseCase(payload model.User) (*model.AuthResponse, err
 		return nil, errors.New("invalid email or password")
 	}
 
-	token, err := middlewares.CreateToken(*user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create token: %v", err)
-	}
-
-	resp := &model.AuthResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Token: token,
-	}
-
-	return resp, nil
+	return s.sessionUseCase.CreateSessionUseCase(user)
 }
 
 func (s *authUsecase) RegisterAdminUseCase(payload model.User) (*model.AuthResponse, error) {
@@ -134,19 +110,7 @@ func (s *authUsecase) RegisterAdminUseCase(payload model.User) (*model.AuthRespo
 		return nil, fmt.Errorf("error creating Admin in database: %w", err)
 	}
 
-	token, err := middlewares.CreateToken(*user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create token: %v", err)
-	}
-
-	resp := &model.AuthResponse{
-		ID:    user.ID,
-		Name:  user.Name,
-		Email: user.Email,
-		Token: token,
-	}
-
-	return resp, nil
+	return s.sessionUseCase.CreateSessionUseCase(user)
 }
 
 func ComparePasswords(hashedPassword string, password string) bool {
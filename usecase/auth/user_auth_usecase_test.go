@@ -7,13 +7,15 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type AuthUsecaseTest struct {
 	suite.Suite
-	authUsecase  AuthUsecase
-	authRepoMock *mocks.AuthRepository
+	authUsecase     AuthUsecase
+	authRepoMock    *mocks.AuthRepository
+	sessionRepoMock *mocks.SessionRepository
 }
 
 func TestAuthUsecaseTest(t *testing.T) {
@@ -22,7 +24,11 @@ func TestAuthUsecaseTest(t *testing.T) {
 
 func (s *AuthUsecaseTest) SetupTest() {
 	s.authRepoMock = &mocks.AuthRepository{}
-	s.authUsecase = NewAuthUsecase(s.authRepoMock)
+	s.sessionRepoMock = &mocks.SessionRepository{}
+	s.sessionRepoMock.On("CreateSessionRepository", mock.Anything).Return(func(session *model.Session) *model.Session {
+		return session
+	}, nil)
+	s.authUsecase = NewAuthUsecase(s.authRepoMock, NewSessionUseCase(s.sessionRepoMock, &mocks.UserRepository{}))
 }
 
 func (m *AuthUsecaseTest) TestLoginUseCaseSucccess() {
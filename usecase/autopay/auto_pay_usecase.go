@@ -0,0 +1,402 @@
+package autopay
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/notification"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const runHour = 7
+
+type AutoPayUseCase interface {
+	CreateAutoPayUseCase(userID string, payload dto.AutoPayDto) (*model.AutoPaySubscription, error)
+	GetAutoPaysByUserIdUseCase(userID, status string, page, limit int) ([]*model.AutoPaySubscription, error)
+	GetAutoPayByIdUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error)
+	UpdateAutoPayUseCase(userID, subscriptionID string, payload dto.AutoPayDto) (*model.AutoPaySubscription, error)
+	PauseAutoPayUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error)
+	ResumeAutoPayUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error)
+	CancelAutoPayUseCase(userID, subscriptionID string) error
+	ApproveAutoPayUseCase(userID, subscriptionID string) (*model.Transaction, error)
+	RunDueAutoPaysUseCase(now time.Time) error
+}
+
+type autoPayUseCase struct {
+	autoPayRepository     repository.AutoPayRepository
+	transactionRepository repository.TransactionRepository
+	billerUseCase         biller.BillerUseCase
+	notificationUseCase   notification.NotificationUseCase
+}
+
+func NewAutoPayUseCase(autoPayRepository repository.AutoPayRepository, transactionRepository repository.TransactionRepository, billerUseCase biller.BillerUseCase, notificationUseCase notification.NotificationUseCase) *autoPayUseCase {
+	return &autoPayUseCase{
+		autoPayRepository:     autoPayRepository,
+		transactionRepository: transactionRepository,
+		billerUseCase:         billerUseCase,
+		notificationUseCase:   notificationUseCase,
+	}
+}
+
+func (uc *autoPayUseCase) CreateAutoPayUseCase(userID string, payload dto.AutoPayDto) (*model.AutoPaySubscription, error) {
+	if err := uc.validateCategory(payload.Category); err != nil {
+		return nil, err
+	}
+	if err := validateAutoPay(payload); err != nil {
+		return nil, err
+	}
+
+	productID := strings.ToLower(payload.ProductId)
+
+	existing, err := uc.autoPayRepository.GetAutoPayByCustomerIdRepository(userID, productID, payload.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("auto-pay for this customer ID already exists")
+	}
+
+	subscription := &model.AutoPaySubscription{
+		UserID:     userID,
+		Category:   payload.Category,
+		ProductId:  productID,
+		CustomerId: payload.CustomerId,
+		DiscountId: payload.DiscountId,
+		PayDay:     payload.PayDay,
+		MaxAmount:  payload.MaxAmount,
+		Status:     model.SCHEDULE_STATUS_ACTIVE,
+		NextRunAt:  nextRunAt(payload.PayDay, time.Now()),
+	}
+
+	resp, err := uc.autoPayRepository.CreateAutoPayRepository(subscription)
+	if err != nil {
+		return nil, fmt.Errorf("error creating auto-pay subscription in database: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *autoPayUseCase) GetAutoPaysByUserIdUseCase(userID, status string, page, limit int) ([]*model.AutoPaySubscription, error) {
+	subscriptions, err := uc.autoPayRepository.GetAutoPaysByUserIdRepository(userID, status, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return subscriptions, nil
+}
+
+func (uc *autoPayUseCase) GetAutoPayByIdUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error) {
+	subscription, err := uc.autoPayRepository.GetAutoPayByIdRepository(subscriptionID)
+	if err != nil || subscription.UserID != userID {
+		return nil, errors.New("auto-pay subscription not found")
+	}
+
+	return subscription, nil
+}
+
+func (uc *autoPayUseCase) UpdateAutoPayUseCase(userID, subscriptionID string, payload dto.AutoPayDto) (*model.AutoPaySubscription, error) {
+	subscription, err := uc.GetAutoPayByIdUseCase(userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.PayDay < 1 || payload.PayDay > 28 {
+		return nil, errors.New("pay_day must be between 1 and 28")
+	}
+	if payload.MaxAmount <= 0 {
+		return nil, errors.New("max_amount must be greater than 0")
+	}
+
+	now := time.Now()
+	if payload.PayDay != subscription.PayDay {
+		subscription.NextRunAt = nextRunAt(payload.PayDay, now)
+	}
+
+	subscription.PayDay = payload.PayDay
+	subscription.MaxAmount = payload.MaxAmount
+	subscription.DiscountId = payload.DiscountId
+	subscription.UpdatedAt = now
+
+	resp, err := uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update auto-pay subscription: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *autoPayUseCase) PauseAutoPayUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error) {
+	subscription, err := uc.GetAutoPayByIdUseCase(userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Status != model.SCHEDULE_STATUS_ACTIVE {
+		return nil, errors.New("only active auto-pay subscriptions can be paused")
+	}
+
+	subscription.Status = model.SCHEDULE_STATUS_PAUSED
+	subscription.UpdatedAt = time.Now()
+
+	resp, err := uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause auto-pay subscription: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *autoPayUseCase) ResumeAutoPayUseCase(userID, subscriptionID string) (*model.AutoPaySubscription, error) {
+	subscription, err := uc.GetAutoPayByIdUseCase(userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.Status != model.SCHEDULE_STATUS_PAUSED {
+		return nil, errors.New("only paused auto-pay subscriptions can be resumed")
+	}
+
+	now := time.Now()
+	if subscription.NextRunAt.Before(now) {
+		subscription.NextRunAt = nextRunAt(subscription.PayDay, now)
+	}
+
+	subscription.Status = model.SCHEDULE_STATUS_ACTIVE
+	subscription.UpdatedAt = now
+
+	resp, err := uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume auto-pay subscription: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *autoPayUseCase) CancelAutoPayUseCase(userID, subscriptionID string) error {
+	subscription, err := uc.GetAutoPayByIdUseCase(userID, subscriptionID)
+	if err != nil {
+		return err
+	}
+
+	if subscription.Status == model.SCHEDULE_STATUS_CANCELLED {
+		return errors.New("auto-pay subscription is already cancelled")
+	}
+
+	subscription.Status = model.SCHEDULE_STATUS_CANCELLED
+	subscription.UpdatedAt = time.Now()
+
+	_, err = uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription)
+	if err != nil {
+		return fmt.Errorf("failed to cancel auto-pay subscription: %v", err)
+	}
+
+	return nil
+}
+
+func (uc *autoPayUseCase) ApproveAutoPayUseCase(userID, subscriptionID string) (*model.Transaction, error) {
+	subscription, err := uc.GetAutoPayByIdUseCase(userID, subscriptionID)
+	if err != nil {
+		return nil, err
+	}
+
+	if subscription.LastResult != model.AUTO_PAY_RESULT_AWAITING_APPROVAL {
+		return nil, errors.New("there is no bill awaiting approval")
+	}
+
+	// The inquiry behind the approval may have expired or been paid since, so
+	// the bill is inquired again. The user approved LastAmount; a bill that
+	// has grown past it needs a new approval.
+	inquiry, err := uc.billInquiry(subscription)
+	if err != nil {
+		return nil, err
+	}
+
+	approved := subscription.LastAmount
+	subscription.LastTransactionID = inquiry.ID
+	subscription.LastAmount = inquiry.TotalPrice
+	subscription.UpdatedAt = time.Now()
+
+	if inquiry.TotalPrice > approved {
+		if _, err := uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription); err != nil {
+			return nil, fmt.Errorf("failed to update auto-pay subscription: %v", err)
+		}
+		return nil, fmt.Errorf("the bill is now RP.%s, approve it again to pay", formatAmount(inquiry.TotalPrice))
+	}
+
+	transaction, err := uc.payBill(subscription, inquiry.ID)
+	if err != nil {
+		return nil, err
+	}
+
+	subscription.LastResult = model.AUTO_PAY_RESULT_PAID
+	subscription.LastError = ""
+	subscription.UpdatedAt = time.Now()
+
+	_, err = uc.autoPayRepository.UpdateAutoPayByIdRepository(subscriptionID, subscription)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update auto-pay subscription: %v", err)
+	}
+
+	return transaction, nil
+}
+
+func (uc *autoPayUseCase) RunDueAutoPaysUseCase(now time.Time) error {
+	subscriptions, err := uc.autoPayRepository.GetDueAutoPaysRepository(now)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, subscription := range subscriptions {
+		if err := uc.runAutoPay(subscription, now); err != nil {
+			log.Printf("auto-pay %s: %v", subscription.ID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to process %d of %d auto-pay subscriptions", failed, len(subscriptions))
+	}
+
+	return nil
+}
+
+func (uc *autoPayUseCase) runAutoPay(subscription *model.AutoPaySubscription, now time.Time) error {
+	period := biller.CurrentPeriod(now)
+
+	subscription.LastRunAt = &now
+	subscription.LastPeriod = period
+	subscription.LastError = ""
+	subscription.UpdatedAt = now
+	subscription.NextRunAt = nextRunAt(subscription.PayDay, now)
+
+	existing, err := uc.transactionRepository.GetProductDetailsByPeriodAndCustomerID(model.GetProductDetail{
+		ProductId:  subscription.ProductId,
+		Period:     period,
+		CustomerId: subscription.CustomerId,
+	})
+
+	if err == nil && existing.Status == model.STATUS_SUCCESSFUL {
+		subscription.LastResult = model.AUTO_PAY_RESULT_ALREADY_PAID
+		subscription.LastTransactionID = existing.ID
+		subscription.LastAmount = existing.TotalPrice
+	} else {
+		uc.inquiryAndPay(subscription)
+	}
+
+	_, err = uc.autoPayRepository.UpdateAutoPayByIdRepository(subscription.ID, subscription)
+	if err != nil {
+		return fmt.Errorf("error updating auto-pay subscription in database: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *autoPayUseCase) inquiryAndPay(subscription *model.AutoPaySubscription) {
+	inquiry, err := uc.billInquiry(subscription)
+	if err != nil {
+		subscription.LastResult = model.AUTO_PAY_RESULT_FAILED
+		subscription.LastError = err.Error()
+		uc.notify(subscription, "Autodebet Gagal",
+			fmt.Sprintf("Cek tagihan %s untuk ID pelanggan %s gagal: %s", subscription.ProductId, subscription.CustomerId, err.Error()))
+		return
+	}
+
+	subscription.LastTransactionID = inquiry.ID
+	subscription.LastAmount = inquiry.TotalPrice
+
+	if inquiry.TotalPrice > subscription.MaxAmount {
+		subscription.LastResult = model.AUTO_PAY_RESULT_AWAITING_APPROVAL
+		uc.notify(subscription, "Persetujuan Autodebet Diperlukan",
+			fmt.Sprintf("Tagihan %s untuk ID pelanggan %s sebesar RP.%s melebihi batas autodebet RP.%s. Silakan setujui pembayaran di aplikasi.",
+				subscription.ProductId, subscription.CustomerId, formatAmount(inquiry.TotalPrice), formatAmount(subscription.MaxAmount)))
+		return
+	}
+
+	if _, err := uc.payBill(subscription, inquiry.ID); err != nil {
+		subscription.LastResult = model.AUTO_PAY_RESULT_FAILED
+		subscription.LastError = err.Error()
+		uc.notify(subscription, "Autodebet Gagal",
+			fmt.Sprintf("Pembayaran tagihan %s untuk ID pelanggan %s sebesar RP.%s gagal: %s",
+				subscription.ProductId, subscription.CustomerId, formatAmount(inquiry.TotalPrice), err.Error()))
+		return
+	}
+
+	subscription.LastResult = model.AUTO_PAY_RESULT_PAID
+}
+
+func (uc *autoPayUseCase) billInquiry(subscription *model.AutoPaySubscription) (*model.Transaction, error) {
+	payload := &model.OyBillerApi{
+		CustomerId: subscription.CustomerId,
+		ProductId:  subscription.ProductId,
+		DiscountId: subscription.DiscountId,
+	}
+
+	return uc.billerUseCase.BillInquiryUseCase(subscription.Category, subscription.UserID, payload)
+}
+
+func (uc *autoPayUseCase) payBill(subscription *model.AutoPaySubscription, transactionID string) (*model.Transaction, error) {
+	payload := &model.OyBillerApi{PartnerTxId: transactionID}
+
+	return uc.billerUseCase.PayBillUseCase(subscription.Category, subscription.UserID, payload)
+}
+
+func (uc *autoPayUseCase) notify(subscription *model.AutoPaySubscription, title, message string) {
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_AUTO_PAY,
+		Title:    title,
+		Message:  message,
+	}
+
+	if err := uc.notificationUseCase.SendNotificationUseCase(subscription.UserID, notification); err != nil {
+		log.Printf("auto-pay %s: failed to notify user: %v", subscription.ID, err)
+	}
+}
+
+// Only postpaid bill products can be paid automatically; prepaid purchases
+// have no bill to wait for.
+func (uc *autoPayUseCase) validateCategory(category string) error {
+	product, err := uc.billerUseCase.GetProductUseCase(category)
+	if err != nil || product.Prepaid || product.Yearly {
+		return fmt.Errorf("category %s does not support auto-pay", category)
+	}
+
+	return nil
+}
+
+func validateAutoPay(payload dto.AutoPayDto) error {
+	if payload.ProductId == "" {
+		return errors.New("product_id is required")
+	}
+	if payload.CustomerId == "" {
+		return errors.New("customer_id is required")
+	}
+	if payload.PayDay < 1 || payload.PayDay > 28 {
+		return errors.New("pay_day must be between 1 and 28")
+	}
+	if payload.MaxAmount <= 0 {
+		return errors.New("max_amount must be greater than 0")
+	}
+
+	return nil
+}
+
+// Pay days are capped at 28 so every month has the configured day.
+func nextRunAt(payDay int, from time.Time) time.Time {
+	next := time.Date(from.Year(), from.Month(), payDay, runHour, 0, 0, 0, from.Location())
+	if !next.After(from) {
+		next = next.AddDate(0, 1, 0)
+	}
+
+	return next
+}
+
+func formatAmount(amount float64) string {
+	return strconv.FormatFloat(amount, 'f', -1, 64)
+}
@@ -0,0 +1,292 @@
+package autopay
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type AutoPayUseCaseTest struct {
+	suite.Suite
+	autoPayUseCase      AutoPayUseCase
+	autoPayRepo         *repoMocks.AutoPayRepository
+	transactionRepo     *repoMocks.TransactionRepository
+	billerUseCase       *mocks.BillerUseCase
+	notificationUseCase *mocks.NotificationUseCase
+}
+
+func TestAutoPayUseCase(t *testing.T) {
+	suite.Run(t, new(AutoPayUseCaseTest))
+}
+
+func (m *AutoPayUseCaseTest) SetupTest() {
+	m.autoPayRepo = &repoMocks.AutoPayRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.billerUseCase = &mocks.BillerUseCase{}
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.autoPayUseCase = NewAutoPayUseCase(m.autoPayRepo, m.transactionRepo, m.billerUseCase, m.notificationUseCase)
+}
+
+func (m *AutoPayUseCaseTest) TestCreateAutoPaySuccess() {
+	m.billerUseCase.On("GetProductUseCase", model.PRODUCT_PDAM).Return(&biller.Product{Code: model.PRODUCT_PDAM}, nil)
+	m.autoPayRepo.On("GetAutoPayByCustomerIdRepository", "user", "pdam", "123456").Return(nil, nil)
+	m.autoPayRepo.On("CreateAutoPayRepository", mock.Anything).Return(func(subscription *model.AutoPaySubscription) *model.AutoPaySubscription {
+		return subscription
+	}, nil)
+
+	resp, err := m.autoPayUseCase.CreateAutoPayUseCase("user", dto.AutoPayDto{
+		Category:   model.PRODUCT_PDAM,
+		ProductId:  "PDAM",
+		CustomerId: "123456",
+		PayDay:     5,
+		MaxAmount:  200000,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "pdam", resp.ProductId)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_ACTIVE, resp.Status)
+	assert.Equal(m.T(), 5, resp.NextRunAt.Day())
+	assert.True(m.T(), resp.NextRunAt.After(time.Now()))
+}
+
+func (m *AutoPayUseCaseTest) TestCreateAutoPayInvalidPayDay() {
+	m.billerUseCase.On("GetProductUseCase", model.PRODUCT_PDAM).Return(&biller.Product{Code: model.PRODUCT_PDAM}, nil)
+	_, err := m.autoPayUseCase.CreateAutoPayUseCase("user", dto.AutoPayDto{
+		Category:   model.PRODUCT_PDAM,
+		ProductId:  "pdam",
+		CustomerId: "123456",
+		PayDay:     31,
+		MaxAmount:  200000,
+	})
+
+	assert.EqualError(m.T(), err, "pay_day must be between 1 and 28")
+}
+
+func (m *AutoPayUseCaseTest) TestCreateAutoPayInvalidCategory() {
+	m.billerUseCase.On("GetProductUseCase", "pulsa").Return(nil, errors.New("bill product pulsa not found"))
+	_, err := m.autoPayUseCase.CreateAutoPayUseCase("user", dto.AutoPayDto{
+		Category:   "pulsa",
+		ProductId:  "pulsa",
+		CustomerId: "123456",
+		PayDay:     5,
+		MaxAmount:  200000,
+	})
+
+	assert.Error(m.T(), err)
+}
+
+func (m *AutoPayUseCaseTest) TestCreateAutoPayRejectsPrepaid() {
+	m.billerUseCase.On("GetProductUseCase", "electricity_prepaid").Return(&biller.Product{Code: "electricity_prepaid", Prepaid: true}, nil)
+
+	_, err := m.autoPayUseCase.CreateAutoPayUseCase("user", dto.AutoPayDto{
+		Category:   "electricity_prepaid",
+		ProductId:  "plnpre",
+		CustomerId: "123456",
+		PayDay:     5,
+		MaxAmount:  200000,
+	})
+
+	assert.EqualError(m.T(), err, "category electricity_prepaid does not support auto-pay")
+}
+
+func (m *AutoPayUseCaseTest) TestCreateAutoPayAlreadyExists() {
+	m.billerUseCase.On("GetProductUseCase", model.PRODUCT_PDAM).Return(&biller.Product{Code: model.PRODUCT_PDAM}, nil)
+	m.autoPayRepo.On("GetAutoPayByCustomerIdRepository", "user", "pdam", "123456").Return(&model.AutoPaySubscription{}, nil)
+
+	_, err := m.autoPayUseCase.CreateAutoPayUseCase("user", dto.AutoPayDto{
+		Category:   model.PRODUCT_PDAM,
+		ProductId:  "pdam",
+		CustomerId: "123456",
+		PayDay:     5,
+		MaxAmount:  200000,
+	})
+
+	assert.EqualError(m.T(), err, "auto-pay for this customer ID already exists")
+}
+
+func (m *AutoPayUseCaseTest) TestRunDueAutoPaysUnderCap() {
+	now := time.Date(2026, time.March, 5, 7, 0, 0, 0, time.Local)
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		Category:       model.PRODUCT_PDAM,
+		ProductId:      "pdam",
+		CustomerId:     "123456",
+		PayDay:         5,
+		MaxAmount:      200000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+	}
+	inquiry := &model.Transaction{ID: "PDAM-1", TotalPrice: 150000}
+
+	m.autoPayRepo.On("GetDueAutoPaysRepository", now).Return([]*model.AutoPaySubscription{subscription}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", model.GetProductDetail{ProductId: "pdam", Period: "March-2026", CustomerId: "123456"}).Return(nil, errors.New("record not found"))
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_PDAM, "user", &model.OyBillerApi{CustomerId: "123456", ProductId: "pdam"}).Return(inquiry, nil)
+	m.billerUseCase.On("PayBillUseCase", model.PRODUCT_PDAM, "user", &model.OyBillerApi{PartnerTxId: "PDAM-1"}).Return(inquiry, nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	err := m.autoPayUseCase.RunDueAutoPaysUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_PAID, subscription.LastResult)
+	assert.Equal(m.T(), "March-2026", subscription.LastPeriod)
+	assert.Equal(m.T(), "PDAM-1", subscription.LastTransactionID)
+	assert.Equal(m.T(), time.Date(2026, time.April, 5, 7, 0, 0, 0, time.Local), subscription.NextRunAt)
+}
+
+func (m *AutoPayUseCaseTest) TestRunDueAutoPaysOverCapAwaitsApproval() {
+	now := time.Date(2026, time.March, 5, 7, 0, 0, 0, time.Local)
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		Category:       model.PRODUCT_ELECTRICITY,
+		ProductId:      "plnpost",
+		CustomerId:     "123456",
+		PayDay:         5,
+		MaxAmount:      100000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+	}
+	inquiry := &model.Transaction{ID: "POSTPAID-1", TotalPrice: 150000}
+
+	m.autoPayRepo.On("GetDueAutoPaysRepository", now).Return([]*model.AutoPaySubscription{subscription}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(nil, errors.New("record not found"))
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_ELECTRICITY, "user", mock.Anything).Return(inquiry, nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	err := m.autoPayUseCase.RunDueAutoPaysUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_AWAITING_APPROVAL, subscription.LastResult)
+	assert.Equal(m.T(), 150000.0, subscription.LastAmount)
+	m.billerUseCase.AssertNotCalled(m.T(), "PayBillUseCase", mock.Anything, mock.Anything, mock.Anything)
+	m.notificationUseCase.AssertCalled(m.T(), "SendNotificationUseCase", "user", mock.Anything)
+}
+
+func (m *AutoPayUseCaseTest) TestRunDueAutoPaysAlreadyPaid() {
+	now := time.Date(2026, time.March, 5, 7, 0, 0, 0, time.Local)
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		Category:       model.PRODUCT_WIFI,
+		ProductId:      "indihome",
+		CustomerId:     "123456",
+		PayDay:         5,
+		MaxAmount:      500000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+	}
+	existing := &model.Transaction{ID: "WIFI-1", Status: model.STATUS_SUCCESSFUL, TotalPrice: 350000}
+
+	m.autoPayRepo.On("GetDueAutoPaysRepository", now).Return([]*model.AutoPaySubscription{subscription}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(existing, nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	err := m.autoPayUseCase.RunDueAutoPaysUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_ALREADY_PAID, subscription.LastResult)
+	assert.Equal(m.T(), "WIFI-1", subscription.LastTransactionID)
+	m.billerUseCase.AssertNotCalled(m.T(), "BillInquiryUseCase", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *AutoPayUseCaseTest) TestRunDueAutoPaysPayFailed() {
+	now := time.Date(2026, time.March, 5, 7, 0, 0, 0, time.Local)
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		Category:       model.PRODUCT_INSURANCE,
+		ProductId:      "bpjsks",
+		CustomerId:     "123456",
+		PayDay:         5,
+		MaxAmount:      500000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+	}
+	inquiry := &model.Transaction{ID: "INSURANCE-1", TotalPrice: 150000}
+
+	m.autoPayRepo.On("GetDueAutoPaysRepository", now).Return([]*model.AutoPaySubscription{subscription}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(nil, errors.New("record not found"))
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_INSURANCE, "user", mock.Anything).Return(inquiry, nil)
+	m.billerUseCase.On("PayBillUseCase", model.PRODUCT_INSURANCE, "user", mock.Anything).Return(nil, errors.New("your balance is not enough"))
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	err := m.autoPayUseCase.RunDueAutoPaysUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_FAILED, subscription.LastResult)
+	assert.Equal(m.T(), "your balance is not enough", subscription.LastError)
+}
+
+func (m *AutoPayUseCaseTest) TestApproveAutoPaySuccess() {
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey:    model.UUIDPrimaryKey{ID: "id"},
+		UserID:            "user",
+		Category:          model.PRODUCT_PDAM,
+		ProductId:         "pdam",
+		CustomerId:        "123456",
+		LastResult:        model.AUTO_PAY_RESULT_AWAITING_APPROVAL,
+		LastTransactionID: "PDAM-1",
+		LastAmount:        250000,
+	}
+	transaction := &model.Transaction{ID: "PDAM-2", Status: model.STATUS_SUCCESSFUL}
+
+	m.autoPayRepo.On("GetAutoPayByIdRepository", "id").Return(subscription, nil)
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_PDAM, "user", mock.Anything).Return(&model.Transaction{ID: "PDAM-2", TotalPrice: 250000}, nil)
+	m.billerUseCase.On("PayBillUseCase", model.PRODUCT_PDAM, "user", &model.OyBillerApi{PartnerTxId: "PDAM-2"}).Return(transaction, nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	resp, err := m.autoPayUseCase.ApproveAutoPayUseCase("user", "id")
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), transaction, resp)
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_PAID, subscription.LastResult)
+	assert.Equal(m.T(), "PDAM-2", subscription.LastTransactionID)
+}
+
+func (m *AutoPayUseCaseTest) TestApproveAutoPayBillGrew() {
+	subscription := &model.AutoPaySubscription{
+		UUIDPrimaryKey:    model.UUIDPrimaryKey{ID: "id"},
+		UserID:            "user",
+		Category:          model.PRODUCT_PDAM,
+		LastResult:        model.AUTO_PAY_RESULT_AWAITING_APPROVAL,
+		LastTransactionID: "PDAM-1",
+		LastAmount:        250000,
+	}
+
+	m.autoPayRepo.On("GetAutoPayByIdRepository", "id").Return(subscription, nil)
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_PDAM, "user", mock.Anything).Return(&model.Transaction{ID: "PDAM-2", TotalPrice: 300000}, nil)
+	m.autoPayRepo.On("UpdateAutoPayByIdRepository", "id", subscription).Return(subscription, nil)
+
+	_, err := m.autoPayUseCase.ApproveAutoPayUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "the bill is now RP.300000, approve it again to pay")
+	assert.Equal(m.T(), model.AUTO_PAY_RESULT_AWAITING_APPROVAL, subscription.LastResult)
+	assert.Equal(m.T(), 300000.0, subscription.LastAmount)
+	m.billerUseCase.AssertNotCalled(m.T(), "PayBillUseCase", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *AutoPayUseCaseTest) TestApproveAutoPayNothingPending() {
+	subscription := &model.AutoPaySubscription{UserID: "user", LastResult: model.AUTO_PAY_RESULT_PAID}
+
+	m.autoPayRepo.On("GetAutoPayByIdRepository", "id").Return(subscription, nil)
+
+	_, err := m.autoPayUseCase.ApproveAutoPayUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "there is no bill awaiting approval")
+}
+
+func (m *AutoPayUseCaseTest) TestPauseAutoPayNotOwner() {
+	m.autoPayRepo.On("GetAutoPayByIdRepository", "id").Return(&model.AutoPaySubscription{UserID: "other"}, nil)
+
+	_, err := m.autoPayUseCase.PauseAutoPayUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "auto-pay subscription not found")
+}
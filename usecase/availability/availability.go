@@ -0,0 +1,189 @@
+package availability
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	// failureThreshold consecutive provider failures switch maintenance on.
+	failureThreshold = 5
+	// cooldown is how long circuit breaker maintenance lasts. The first call
+	// after it is a trial: one more failure switches maintenance back on.
+	cooldown = 5 * time.Minute
+)
+
+// window is a daily downtime in minutes after midnight WIB. A window whose
+// end is before its start runs past midnight.
+type window struct {
+	start, end int
+}
+
+// parseWindows reads windows written as "HH:MM-HH:MM" separated by commas.
+func parseWindows(windows string) ([]window, error) {
+	var parsed []window
+	for _, part := range strings.Split(windows, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		bounds := strings.Split(part, "-")
+		if len(bounds) != 2 {
+			return nil, fmt.Errorf("invalid window %q, use HH:MM-HH:MM", part)
+		}
+		start, err := time.Parse("15:04", strings.TrimSpace(bounds[0]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid window %q, use HH:MM-HH:MM", part)
+		}
+		end, err := time.Parse("15:04", strings.TrimSpace(bounds[1]))
+		if err != nil {
+			return nil, fmt.Errorf("invalid window %q, use HH:MM-HH:MM", part)
+		}
+
+		w := window{start: start.Hour()*60 + start.Minute(), end: end.Hour()*60 + end.Minute()}
+		if w.start == w.end {
+			return nil, fmt.Errorf("window %q is empty", part)
+		}
+		parsed = append(parsed, w)
+	}
+
+	return parsed, nil
+}
+
+func formatWindows(windows []window) string {
+	parts := make([]string, len(windows))
+	for i, w := range windows {
+		parts[i] = fmt.Sprintf("%02d:%02d-%02d:%02d", w.start/60, w.start%60, w.end/60, w.end%60)
+	}
+	return strings.Join(parts, ",")
+}
+
+// until returns when the window ends if now is inside it.
+func (w window) until(now time.Time) (time.Time, bool) {
+	now = now.In(model.WIB)
+	minute := now.Hour()*60 + now.Minute()
+	midnight := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, model.WIB)
+	end := midnight.Add(time.Duration(w.end) * time.Minute)
+
+	switch {
+	case w.start < w.end:
+		return end, minute >= w.start && minute < w.end
+	case minute >= w.start:
+		return end.AddDate(0, 0, 1), true
+	default:
+		return end, minute < w.end
+	}
+}
+
+// unavailable reports whether an availability blocks sales at now, and until
+// when. A nil time means the end is not known.
+func unavailable(availability model.ProductAvailability, now time.Time) (bool, *time.Time) {
+	if availability.Maintenance {
+		until := availability.MaintenanceUntil
+		if until == nil || now.Before(*until) {
+			return true, until
+		}
+	}
+
+	windows, err := parseWindows(availability.Windows)
+	if err != nil {
+		log.Printf("availability of %s %s: %v", availability.Product, availability.Provider, err)
+		return false, nil
+	}
+	for _, w := range windows {
+		if until, ok := w.until(now); ok {
+			return true, &until
+		}
+	}
+
+	return false, nil
+}
+
+// Status tells whether a provider of a product can be sold at now. The
+// availability of the whole product applies to each of its providers.
+func Status(availabilityRepository repository.ProductAvailabilityRepository, product, provider string, now time.Time) (model.Availability, error) {
+	availabilities, err := availabilityRepository.GetProductAvailabilityRepository(product, normalizeProvider(provider))
+	if err != nil {
+		return model.Availability{}, err
+	}
+
+	status := model.Availability{Available: true}
+	for _, availability := range availabilities {
+		down, until := unavailable(availability, now)
+		if !down {
+			continue
+		}
+
+		if status.Available {
+			status = model.Availability{Until: until, Message: availability.Message}
+			continue
+		}
+		// The latest end wins; an unknown end outlasts any known one.
+		if status.Until != nil && (until == nil || until.After(*status.Until)) {
+			status.Until = until
+		}
+		if status.Message == "" {
+			status.Message = availability.Message
+		}
+	}
+
+	return status, nil
+}
+
+// Check returns a *model.UnavailableError naming the product as name when
+// it cannot be sold at now.
+func Check(availabilityRepository repository.ProductAvailabilityRepository, product, provider, name string, now time.Time) error {
+	status, err := Status(availabilityRepository, product, provider, now)
+	if err != nil {
+		return err
+	}
+	if status.Available {
+		return nil
+	}
+
+	return &model.UnavailableError{Name: name, Until: status.Until, Message: status.Message}
+}
+
+// RecordFailure counts a failed provider call. failureThreshold failures in
+// a row switch on circuit breaker maintenance, unless an admin already put
+// the provider in maintenance.
+func RecordFailure(availabilityRepository repository.ProductAvailabilityRepository, product, provider string, now time.Time) {
+	provider = normalizeProvider(provider)
+	if err := availabilityRepository.AddProductAvailabilityFailureRepository(product, provider); err != nil {
+		log.Printf("availability of %s %s: %v", product, provider, err)
+		return
+	}
+
+	until := now.Add(cooldown)
+	tripped, err := availabilityRepository.TripProductAvailabilityRepository(product, provider, failureThreshold, until, "the provider is not responding")
+	if err != nil {
+		log.Printf("availability of %s %s: %v", product, provider, err)
+	} else if tripped {
+		log.Printf("circuit breaker: %s %s is in maintenance until %s after %d failures", product, provider, until.Format(time.RFC3339), failureThreshold)
+	}
+}
+
+// RecordSuccess resets the failure count and ends circuit breaker
+// maintenance. Manual maintenance is left to admins.
+func RecordSuccess(availabilityRepository repository.ProductAvailabilityRepository, product, provider string) {
+	provider = normalizeProvider(provider)
+	if err := availabilityRepository.ResetProductAvailabilityFailuresRepository(product, provider); err != nil {
+		log.Printf("availability of %s %s: %v", product, provider, err)
+	}
+}
+
+func clearMaintenance(availability *model.ProductAvailability) {
+	availability.Maintenance = false
+	availability.MaintenanceSource = ""
+	availability.MaintenanceUntil = nil
+	availability.Message = ""
+}
+
+func normalizeProvider(provider string) string {
+	return strings.ToLower(strings.TrimSpace(provider))
+}
@@ -0,0 +1,138 @@
+package availability
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func wib(hour, minute int) time.Time {
+	return time.Date(2023, 6, 1, hour, minute, 0, 0, model.WIB)
+}
+
+func TestParseWindows(t *testing.T) {
+	windows, err := parseWindows(" 23:00-01:00, 12:00 - 12:15 ,")
+	assert.NoError(t, err)
+	assert.Equal(t, []window{{start: 23 * 60, end: 60}, {start: 12 * 60, end: 12*60 + 15}}, windows)
+	assert.Equal(t, "23:00-01:00,12:00-12:15", formatWindows(windows))
+
+	for _, invalid := range []string{"23:00", "25:00-01:00", "10:00-10:00", "a-b"} {
+		_, err := parseWindows(invalid)
+		assert.Error(t, err, invalid)
+	}
+}
+
+func TestWindowUntil(t *testing.T) {
+	daytime := window{start: 12 * 60, end: 12*60 + 15}
+	until, ok := daytime.until(wib(12, 10))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(wib(12, 15)))
+	_, ok = daytime.until(wib(12, 15))
+	assert.False(t, ok)
+
+	overnight := window{start: 23 * 60, end: 60}
+	until, ok = overnight.until(wib(23, 30))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(wib(1, 0).AddDate(0, 0, 1)))
+	until, ok = overnight.until(wib(0, 30))
+	assert.True(t, ok)
+	assert.True(t, until.Equal(wib(1, 0)))
+	_, ok = overnight.until(wib(1, 0))
+	assert.False(t, ok)
+
+	// Windows are in WIB whatever the zone of now.
+	until, ok = overnight.until(wib(23, 30).UTC())
+	assert.True(t, ok)
+	assert.True(t, until.Equal(wib(1, 0).AddDate(0, 0, 1)))
+}
+
+func TestStatus(t *testing.T) {
+	later := wib(18, 0)
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("GetProductAvailabilityRepository", "pln-postpaid", "pln").Return([]model.ProductAvailability{
+		{Product: "pln-postpaid", Windows: "23:00-01:00"},
+		{Product: "pln-postpaid", Provider: "pln", Maintenance: true, MaintenanceUntil: &later, Message: "PLN maintenance"},
+	}, nil)
+
+	status, err := Status(mockRepo, "pln-postpaid", " PLN ", wib(10, 0))
+	assert.NoError(t, err)
+	assert.False(t, status.Available)
+	assert.True(t, status.Until.Equal(later))
+	assert.Equal(t, "PLN maintenance", status.Message)
+
+	status, err = Status(mockRepo, "pln-postpaid", "pln", wib(23, 30))
+	assert.NoError(t, err)
+	assert.False(t, status.Available)
+	assert.True(t, status.Until.Equal(wib(1, 0).AddDate(0, 0, 1)))
+	assert.Empty(t, status.Message)
+
+	status, err = Status(mockRepo, "pln-postpaid", "pln", wib(20, 0))
+	assert.NoError(t, err)
+	assert.Equal(t, model.Availability{Available: true}, status)
+}
+
+func TestCheck(t *testing.T) {
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("GetProductAvailabilityRepository", model.AVAILABILITY_BANK, "bca").Return([]model.ProductAvailability{
+		{Product: model.AVAILABILITY_BANK, Provider: "bca", Windows: "23:45-00:15", Message: "daily cut-off"},
+	}, nil)
+
+	err := Check(mockRepo, model.AVAILABILITY_BANK, "BCA", "Virtual account BCA", wib(23, 50))
+	var unavailable *model.UnavailableError
+	assert.True(t, errors.As(err, &unavailable))
+	assert.Equal(t, "Virtual account BCA is temporarily unavailable until 02 Jun 2023 00:15 WIB: daily cut-off", err.Error())
+
+	assert.NoError(t, Check(mockRepo, model.AVAILABILITY_BANK, "bca", "Virtual account BCA", wib(0, 15)))
+
+	mockRepo = mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("GetProductAvailabilityRepository", model.AVAILABILITY_BANK, "bni").Return(nil, errors.New("database error"))
+	err = Check(mockRepo, model.AVAILABILITY_BANK, "bni", "Virtual account BNI", wib(10, 0))
+	assert.EqualError(t, err, "database error")
+}
+
+func TestRecordFailure(t *testing.T) {
+	now := wib(10, 0)
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("AddProductAvailabilityFailureRepository", model.AVAILABILITY_PPD, "telkomsel").Return(nil)
+	mockRepo.On("TripProductAvailabilityRepository", model.AVAILABILITY_PPD, "telkomsel", failureThreshold, now.Add(cooldown), "the provider is not responding").Return(true, nil)
+
+	RecordFailure(mockRepo, model.AVAILABILITY_PPD, "Telkomsel", now)
+}
+
+func TestRecordFailureCountError(t *testing.T) {
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("AddProductAvailabilityFailureRepository", "bpjs", "bpjs").Return(errors.New("database error"))
+
+	RecordFailure(mockRepo, "bpjs", "bpjs", wib(10, 0))
+
+	mockRepo.AssertNotCalled(t, "TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func TestBreakerEndsAfterCooldown(t *testing.T) {
+	now := wib(10, 0)
+	until := now.Add(cooldown)
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("GetProductAvailabilityRepository", model.AVAILABILITY_PPD, "telkomsel").Return([]model.ProductAvailability{
+		{Product: model.AVAILABILITY_PPD, Provider: "telkomsel", Maintenance: true, MaintenanceSource: model.MAINTENANCE_CIRCUIT_BREAKER, MaintenanceUntil: &until, Failures: failureThreshold},
+	}, nil)
+
+	status, err := Status(mockRepo, model.AVAILABILITY_PPD, "telkomsel", now)
+	assert.NoError(t, err)
+	assert.False(t, status.Available)
+
+	status, err = Status(mockRepo, model.AVAILABILITY_PPD, "telkomsel", until)
+	assert.NoError(t, err)
+	assert.True(t, status.Available)
+}
+
+func TestRecordSuccess(t *testing.T) {
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	mockRepo.On("ResetProductAvailabilityFailuresRepository", model.AVAILABILITY_PPD, "telkomsel").Return(nil)
+
+	RecordSuccess(mockRepo, model.AVAILABILITY_PPD, " Telkomsel ")
+}
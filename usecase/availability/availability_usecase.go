@@ -0,0 +1,136 @@
+package availability
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type AvailabilityUseCase interface {
+	CreateAvailabilityUseCase(payload *model.ProductAvailability) (*model.ProductAvailability, error)
+	GetAllAvailabilityUseCase(product string, page, limit int) ([]*model.ProductAvailability, error)
+	GetAvailabilityByIdUseCase(id string) (*model.ProductAvailability, error)
+	UpdateAvailabilityByIdUseCase(id string, payload *model.ProductAvailability) (*model.ProductAvailability, error)
+	SetMaintenanceUseCase(id string, payload dto.MaintenanceDto) (*model.ProductAvailability, error)
+	DeleteAvailabilityByIdUseCase(id string) error
+}
+
+type availabilityUseCase struct {
+	availabilityRepository repository.ProductAvailabilityRepository
+	now                    func() time.Time
+}
+
+func NewAvailabilityUseCase(availabilityRepository repository.ProductAvailabilityRepository) *availabilityUseCase {
+	return &availabilityUseCase{
+		availabilityRepository: availabilityRepository,
+		now:                    time.Now,
+	}
+}
+
+func (uc *availabilityUseCase) CreateAvailabilityUseCase(payload *model.ProductAvailability) (*model.ProductAvailability, error) {
+	payload.Product = strings.ToLower(strings.TrimSpace(payload.Product))
+	payload.Provider = normalizeProvider(payload.Provider)
+	if payload.Product == "" {
+		return nil, errors.New("product is required")
+	}
+
+	windows, err := parseWindows(payload.Windows)
+	if err != nil {
+		return nil, err
+	}
+	payload.Windows = formatWindows(windows)
+
+	existing, err := uc.availabilityRepository.GetProductAvailabilityByProviderRepository(payload.Product, payload.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("availability of %s already exists", describe(payload))
+	}
+
+	payload.Failures = 0
+	if err := uc.applyMaintenance(payload, dto.MaintenanceDto{Maintenance: payload.Maintenance, Until: payload.MaintenanceUntil, Message: payload.Message}); err != nil {
+		return nil, err
+	}
+
+	availability, err := uc.availabilityRepository.CreateProductAvailabilityRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating product availability in database: %w", err)
+	}
+
+	return availability, nil
+}
+
+func (uc *availabilityUseCase) GetAllAvailabilityUseCase(product string, page, limit int) ([]*model.ProductAvailability, error) {
+	return uc.availabilityRepository.GetAllProductAvailabilityRepository(strings.ToLower(product), page, limit)
+}
+
+func (uc *availabilityUseCase) GetAvailabilityByIdUseCase(id string) (*model.ProductAvailability, error) {
+	return uc.availabilityRepository.GetProductAvailabilityByIdRepository(id)
+}
+
+// UpdateAvailabilityByIdUseCase replaces the availability windows. Maintenance
+// is switched with SetMaintenanceUseCase.
+func (uc *availabilityUseCase) UpdateAvailabilityByIdUseCase(id string, payload *model.ProductAvailability) (*model.ProductAvailability, error) {
+	availability, err := uc.availabilityRepository.GetProductAvailabilityByIdRepository(id)
+	if err != nil {
+		return nil, err
+	}
+
+	windows, err := parseWindows(payload.Windows)
+	if err != nil {
+		return nil, err
+	}
+	availability.Windows = formatWindows(windows)
+
+	return uc.availabilityRepository.SaveProductAvailabilityRepository(availability)
+}
+
+// SetMaintenanceUseCase switches manual maintenance on or off. Switching it
+// off also ends circuit breaker maintenance and resets the failure count.
+func (uc *availabilityUseCase) SetMaintenanceUseCase(id string, payload dto.MaintenanceDto) (*model.ProductAvailability, error) {
+	availability, err := uc.availabilityRepository.GetProductAvailabilityByIdRepository(id)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.applyMaintenance(availability, payload); err != nil {
+		return nil, err
+	}
+	if !payload.Maintenance {
+		availability.Failures = 0
+	}
+
+	return uc.availabilityRepository.SaveProductAvailabilityRepository(availability)
+}
+
+func (uc *availabilityUseCase) DeleteAvailabilityByIdUseCase(id string) error {
+	return uc.availabilityRepository.DeleteProductAvailabilityByIdRepository(id)
+}
+
+func (uc *availabilityUseCase) applyMaintenance(availability *model.ProductAvailability, payload dto.MaintenanceDto) error {
+	if !payload.Maintenance {
+		clearMaintenance(availability)
+		return nil
+	}
+	if payload.Until != nil && !payload.Until.After(uc.now()) {
+		return errors.New("maintenance must end in the future")
+	}
+
+	availability.Maintenance = true
+	availability.MaintenanceSource = model.MAINTENANCE_MANUAL
+	availability.MaintenanceUntil = payload.Until
+	availability.Message = strings.TrimSpace(payload.Message)
+	return nil
+}
+
+func describe(availability *model.ProductAvailability) string {
+	if availability.Provider == "" {
+		return availability.Product
+	}
+	return availability.Product + " " + availability.Provider
+}
@@ -0,0 +1,95 @@
+package availability
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func newTestUseCase(t *testing.T) (*availabilityUseCase, *mocks.ProductAvailabilityRepository) {
+	mockRepo := mocks.NewProductAvailabilityRepository(t)
+	uc := NewAvailabilityUseCase(mockRepo)
+	uc.now = func() time.Time { return wib(10, 0) }
+	return uc, mockRepo
+}
+
+func TestCreateAvailabilityUseCase(t *testing.T) {
+	uc, mockRepo := newTestUseCase(t)
+	mockRepo.On("GetProductAvailabilityByProviderRepository", model.AVAILABILITY_BANK, "bca").Return(nil, nil)
+	mockRepo.On("CreateProductAvailabilityRepository", mock.Anything).Return(func(availability *model.ProductAvailability) *model.ProductAvailability {
+		return availability
+	}, nil)
+
+	until := wib(12, 0)
+	result, err := uc.CreateAvailabilityUseCase(&model.ProductAvailability{
+		Product:          " Bank ",
+		Provider:         "BCA",
+		Windows:          "23:45-00:15",
+		Maintenance:      true,
+		MaintenanceUntil: &until,
+		Message:          "core banking upgrade",
+		Failures:         3,
+	})
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.AVAILABILITY_BANK, result.Product)
+	assert.Equal(t, "bca", result.Provider)
+	assert.Equal(t, model.MAINTENANCE_MANUAL, result.MaintenanceSource)
+	assert.Equal(t, 0, result.Failures)
+}
+
+func TestCreateAvailabilityUseCase_Invalid(t *testing.T) {
+	uc, mockRepo := newTestUseCase(t)
+
+	_, err := uc.CreateAvailabilityUseCase(&model.ProductAvailability{Provider: "bca"})
+	assert.EqualError(t, err, "product is required")
+
+	_, err = uc.CreateAvailabilityUseCase(&model.ProductAvailability{Product: "bank", Windows: "23:45"})
+	assert.EqualError(t, err, `invalid window "23:45", use HH:MM-HH:MM`)
+
+	mockRepo.On("GetProductAvailabilityByProviderRepository", "bank", "bni").Return(&model.ProductAvailability{}, nil).Once()
+	_, err = uc.CreateAvailabilityUseCase(&model.ProductAvailability{Product: "bank", Provider: "bni"})
+	assert.EqualError(t, err, "availability of bank bni already exists")
+
+	past := wib(9, 0)
+	mockRepo.On("GetProductAvailabilityByProviderRepository", "bank", "bri").Return(nil, nil).Once()
+	_, err = uc.CreateAvailabilityUseCase(&model.ProductAvailability{Product: "bank", Provider: "bri", Maintenance: true, MaintenanceUntil: &past})
+	assert.EqualError(t, err, "maintenance must end in the future")
+}
+
+func TestUpdateAvailabilityByIdUseCase(t *testing.T) {
+	uc, mockRepo := newTestUseCase(t)
+	availability := &model.ProductAvailability{Product: "bank", Provider: "bca", Maintenance: true, MaintenanceSource: model.MAINTENANCE_MANUAL}
+	mockRepo.On("GetProductAvailabilityByIdRepository", "1").Return(availability, nil)
+	mockRepo.On("SaveProductAvailabilityRepository", availability).Return(availability, nil)
+
+	result, err := uc.UpdateAvailabilityByIdUseCase("1", &model.ProductAvailability{Windows: "22:00 - 22:30", Maintenance: false})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "22:00-22:30", result.Windows)
+	assert.True(t, result.Maintenance)
+}
+
+func TestSetMaintenanceUseCase(t *testing.T) {
+	uc, mockRepo := newTestUseCase(t)
+	availability := &model.ProductAvailability{Product: model.AVAILABILITY_PPD, Provider: "xl", Failures: 2}
+	mockRepo.On("GetProductAvailabilityByIdRepository", "1").Return(availability, nil)
+	mockRepo.On("SaveProductAvailabilityRepository", availability).Return(availability, nil)
+
+	result, err := uc.SetMaintenanceUseCase("1", dto.MaintenanceDto{Maintenance: true, Message: " XL upgrade "})
+	assert.NoError(t, err)
+	assert.True(t, result.Maintenance)
+	assert.Equal(t, model.MAINTENANCE_MANUAL, result.MaintenanceSource)
+	assert.Nil(t, result.MaintenanceUntil)
+	assert.Equal(t, "XL upgrade", result.Message)
+	assert.Equal(t, 2, result.Failures)
+
+	result, err = uc.SetMaintenanceUseCase("1", dto.MaintenanceDto{Maintenance: false})
+	assert.NoError(t, err)
+	assert.Equal(t, &model.ProductAvailability{Product: model.AVAILABILITY_PPD, Provider: "xl"}, result)
+}
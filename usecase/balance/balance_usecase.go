@@ -3,6 +3,7 @@ package balance
 import (
 	"BE-Golang/model"
 	"BE-Golang/repository"
+	"BE-Golang/usecase/availability"
 	"fmt"
 	"strconv"
 	"time"
@@ -21,14 +22,18 @@ type balanceUsecase struct {
 	userRepository        repository.UserRepository
 	transactionRepository repository.TransactionRepository
 	virtualAgregatorOyApi repository.VirtualAgregatorOyApi
+	availability          repository.ProductAvailabilityRepository
+	now                   func() time.Time
 }
 
-func NewBalanceUsecase(balanceRepository repository.BalanceRepository, userRepository repository.UserRepository, transactionRepository repository.TransactionRepository, virtualAgregatorOyApi repository.VirtualAgregatorOyApi) *balanceUsecase {
+func NewBalanceUsecase(balanceRepository repository.BalanceRepository, userRepository repository.UserRepository, transactionRepository repository.TransactionRepository, virtualAgregatorOyApi repository.VirtualAgregatorOyApi, availabilityRepository repository.ProductAvailabilityRepository) *balanceUsecase {
 	return &balanceUsecase{
 		balanceRepository:     balanceRepository,
 		userRepository:        userRepository,
 		transactionRepository: transactionRepository,
 		virtualAgregatorOyApi: virtualAgregatorOyApi,
+		availability:          availabilityRepository,
+		now:                   time.Now,
 	}
 }
 
@@ -43,10 +48,17 @@ func (uc *balanceUsecase) GenerateVaUseCase(userId string, payload model.Generat
 	payload.IsOpen = true
 	payload.SingleUse = false
 
+	now := uc.now()
+	if err := availability.Check(uc.availability, model.AVAILABILITY_BANK, payload.BankCode, "Virtual account "+payload.BankCode, now); err != nil {
+		return nil, err
+	}
+
 	resp, err := uc.virtualAgregatorOyApi.GenerateVaApi(payload)
 	if err != nil {
+		availability.RecordFailure(uc.availability, model.AVAILABILITY_BANK, payload.BankCode, now)
 		return nil, err
 	}
+	availability.RecordSuccess(uc.availability, model.AVAILABILITY_BANK, payload.BankCode)
 
 	insert := &model.VaNumber{
 		UserId:         user.ID,
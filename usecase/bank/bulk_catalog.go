@@ -0,0 +1,52 @@
+package bank
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+)
+
+// NewBulkCatalog lets admins upload and download banks, keyed by bank code.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	banks := func() ([]model.Bank, error) {
+		var banks []model.Bank
+		err := bulkRepository.FindAllRepository(&banks)
+		return banks, err
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_BANK,
+		Columns: []string{"bank_code", "name", "image"},
+		Key:     []string{"bank_code"},
+		Export: func() ([][]string, error) {
+			banks, err := banks()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(banks))
+			for i, bank := range banks {
+				rows[i] = []string{bank.BankCode, bank.Name, bank.Image}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			banks, err := banks()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.Bank{}
+			for _, bank := range banks {
+				existing[bank.BankCode] = bank
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				bank, update := existing[row.Required("bank_code")]
+				bank.BankCode = row.String("bank_code")
+				bank.Name = row.Required("name")
+				bank.Image = row.String("image")
+				return &bank, update
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,585 @@
+package biller
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/availability"
+	"BE-Golang/usecase/mail"
+	"BE-Golang/usecase/users"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+)
+
+// defaultInquiryTTL is how long an unpaid bill inquiry can be paid when
+// INQUIRY_TTL_MINUTES is not set. After it the amount must be fetched again.
+const defaultInquiryTTL = 24 * time.Hour
+
+var ErrInquiryExpired = errors.New("this bill inquiry has expired, check the bill again")
+
+// internalProductTypes are the product types the app sets on its own
+// transactions. A bill's product_id comes from the client and must never
+// pass for one of them.
+var internalProductTypes = map[string]bool{
+	model.PULSA_TYPE:      true,
+	model.PAKET_DATA_TYPE: true,
+	model.PRODUCT_EMONEY:  true,
+	model.PRODUCT_VOUCHER: true,
+	"transfer":            true,
+	"topup":               true,
+}
+
+type BillerUseCase interface {
+	GetProductsUseCase() []*Product
+	GetProductStatusesUseCase() []ProductStatus
+	GetProductUseCase(code string) (*Product, error)
+	GetProductByTransactionIdUseCase(transactionID string) (*Product, error)
+	BillInquiryUseCase(code, userId string, payload *model.OyBillerApi) (*model.Transaction, error)
+	PayBillUseCase(code, userId string, payload *model.OyBillerApi) (*model.Transaction, error)
+	SettleBillUseCase(user *model.User, transactionID string, adminFee, totalPrice float64) (*model.Transaction, error)
+	BillStatusUseCase(code string, payload *model.OyBillerApi) (*model.OyBillerApiResponse, error)
+	ResendReceiptUseCase(code, userId, transactionID string) error
+	CancelInquiryUseCase(userId, transactionID string) (*model.Transaction, error)
+	RunExpireInquiriesUseCase(now time.Time) error
+}
+
+type billerUseCase struct {
+	userRepository        repository.UserRepository
+	discountRepository    repository.DiscountRepository
+	transactionRepository repository.TransactionRepository
+	billerOyApi           repository.BillerOyApiRepository
+	savedBillerRepository repository.SavedBillerRepository
+	customerIdRules       repository.CustomerIdRuleRepository
+	availability          repository.ProductAvailabilityRepository
+	products              map[string]*Product
+	codes                 []string
+	sendMail              func(payload model.PayloadMail)
+	now                   func() time.Time
+}
+
+func NewBillerUseCase(userRepository repository.UserRepository, discountRepository repository.DiscountRepository, transactionRepository repository.TransactionRepository, billerOyApiRepository repository.BillerOyApiRepository, savedBillerRepository repository.SavedBillerRepository, customerIdRuleRepository repository.CustomerIdRuleRepository, availabilityRepository repository.ProductAvailabilityRepository, products ...*Product) *billerUseCase {
+	uc := &billerUseCase{
+		userRepository:        userRepository,
+		discountRepository:    discountRepository,
+		transactionRepository: transactionRepository,
+		billerOyApi:           billerOyApiRepository,
+		savedBillerRepository: savedBillerRepository,
+		customerIdRules:       customerIdRuleRepository,
+		availability:          availabilityRepository,
+		products:              map[string]*Product{},
+		sendMail:              mail.SendingMail,
+		now:                   time.Now,
+	}
+
+	prefixes := map[string]string{}
+	for _, product := range products {
+		// Transactions are matched to their product by ID prefix, so two
+		// products sharing one would settle each other's bills.
+		if code, ok := prefixes[product.TransactionPrefix]; ok && code != product.Code {
+			panic(fmt.Sprintf("products %s and %s share the transaction prefix %s", code, product.Code, product.TransactionPrefix))
+		}
+		prefixes[product.TransactionPrefix] = product.Code
+
+		if _, ok := uc.products[product.Code]; !ok {
+			uc.codes = append(uc.codes, product.Code)
+		}
+		uc.products[product.Code] = product
+	}
+
+	return uc
+}
+
+func (uc *billerUseCase) GetProductsUseCase() []*Product {
+	products := make([]*Product, 0, len(uc.codes))
+	for _, code := range uc.codes {
+		products = append(products, uc.products[code])
+	}
+
+	return products
+}
+
+// GetProductStatusesUseCase lists the products with whether each can be
+// bought right now.
+func (uc *billerUseCase) GetProductStatusesUseCase() []ProductStatus {
+	now := uc.now()
+	statuses := make([]ProductStatus, 0, len(uc.codes))
+	for _, product := range uc.GetProductsUseCase() {
+		status, err := availability.Status(uc.availability, product.Code, "", now)
+		if err != nil {
+			log.Printf("availability of %s: %v", product.Code, err)
+			status = model.Availability{Available: true}
+		}
+		statuses = append(statuses, ProductStatus{Product: product, Availability: status})
+	}
+
+	return statuses
+}
+
+func (uc *billerUseCase) GetProductUseCase(code string) (*Product, error) {
+	product, ok := uc.products[strings.ToLower(code)]
+	if !ok {
+		return nil, fmt.Errorf("bill product %s not found", code)
+	}
+
+	return product, nil
+}
+
+func (uc *billerUseCase) GetProductByTransactionIdUseCase(transactionID string) (*Product, error) {
+	for _, code := range uc.codes {
+		if product := uc.products[code]; product.owns(transactionID) {
+			return product, nil
+		}
+	}
+
+	return nil, fmt.Errorf("no bill product for transaction %s", transactionID)
+}
+
+func (uc *billerUseCase) BillInquiryUseCase(code, userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	product, err := uc.GetProductUseCase(code)
+	if err != nil {
+		return nil, err
+	}
+
+	productType := strings.ToLower(payload.ProductId)
+	if internalProductTypes[productType] {
+		return nil, &model.ValidationError{Errors: []model.FieldError{
+			{Field: "product_id", Rule: "oneof", Message: fmt.Sprintf("%s is not a %s product", payload.ProductId, product.Name)},
+		}}
+	}
+	if product.NormalizeCustomerId != nil {
+		payload.CustomerId = product.NormalizeCustomerId(payload.CustomerId)
+	}
+	if err := availability.Check(uc.availability, product.Code, productType, product.Name, uc.now()); err != nil {
+		return nil, err
+	}
+	if err := uc.validateCustomerId(product, productType, payload.CustomerId); err != nil {
+		return nil, err
+	}
+
+	payload.PartnerTxId = fmt.Sprintf("%s-%s", product.TransactionPrefix, GenerateVANumber(16))
+	if !product.Prepaid {
+		payload.Period = product.BillingPeriod(uc.now())
+	}
+
+	user, err := uc.userRepository.GetUserByIDRepository(userId)
+	if err != nil {
+		return nil, errors.New("unauthorized")
+	}
+
+	if !product.Prepaid {
+		if existing, err := uc.openBill(productType, payload.Period, payload.CustomerId); err != nil || existing != nil {
+			return existing, err
+		}
+	}
+
+	discount, err := uc.discountRepository.GetDiscountByIdRepository(payload.DiscountId)
+	if err != nil {
+		return nil, errors.New("discount Not Found")
+	}
+
+	response, err := uc.billerOyApi.BillInquryRepository(payload)
+	if err != nil {
+		availability.RecordFailure(uc.availability, product.Code, productType, uc.now())
+		return nil, err
+	}
+	availability.RecordSuccess(uc.availability, product.Code, productType)
+
+	bill, err := product.Price(&Inquiry{
+		User:        user,
+		Payload:     payload,
+		Response:    response,
+		Discount:    discount,
+		ProductType: productType,
+		Now:         uc.now(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// A bill may be for an earlier period than the current one, e.g. an
+	// installment that fell due last month, so look it up by its own period
+	// too before creating it again.
+	if !product.Prepaid {
+		summary, _, err := product.decode(bill.Detail)
+		if err != nil {
+			return nil, err
+		}
+		if summary.Period != "" && summary.Period != payload.Period {
+			if existing, err := uc.openBill(productType, summary.Period, payload.CustomerId); err != nil || existing != nil {
+				return existing, err
+			}
+		}
+	}
+
+	status := model.STATUS_UNPAID
+	var expiresAt *time.Time
+	if product.Prepaid {
+		status = model.STATUS_PROCESSING
+	} else {
+		expiry := uc.now().Add(inquiryTTL())
+		expiresAt = &expiry
+	}
+
+	transaction := &model.Transaction{
+		ID:            response.PartnerTxID,
+		UserID:        userId,
+		Status:        status,
+		ProductType:   productType,
+		Description:   bill.Description,
+		DiscountPrice: float64(discount.DiscountPrice),
+		AdminFee:      response.AdminFee,
+		Price:         bill.Price,
+		TotalPrice:    bill.Price + response.AdminFee - float64(discount.DiscountPrice),
+		ProductDetail: bill.Detail,
+		ExpiresAt:     expiresAt,
+	}
+
+	_, err = uc.transactionRepository.CreateTransactionByUserIdRepository(transaction)
+	if err != nil {
+		return nil, fmt.Errorf("error creating %s in database: %w", product.Name, err)
+	}
+
+	return transaction, nil
+}
+
+// openBill returns the customer's open bill for the period, or nil when a new
+// one may be created. A bill that has been paid is an error.
+func (uc *billerUseCase) openBill(productType, period, customerID string) (*model.Transaction, error) {
+	existing, err := uc.transactionRepository.GetProductDetailsByPeriodAndCustomerID(model.GetProductDetail{
+		ProductId:  productType,
+		Period:     period,
+		CustomerId: customerID,
+	})
+	if err != nil || existing == nil {
+		return nil, nil
+	}
+	if existing.Status == model.STATUS_SUCCESSFUL {
+		return nil, errors.New("this month's bill has been paid")
+	}
+	if uc.expire(existing) {
+		return nil, nil
+	}
+
+	return existing, nil
+}
+
+// validateCustomerId runs the product's own customer ID check and the rules
+// admins configured for the product, before any provider is called. Failures
+// are returned together as a *model.ValidationError.
+func (uc *billerUseCase) validateCustomerId(product *Product, provider, customerID string) error {
+	if customerID == "" {
+		return &model.ValidationError{Errors: []model.FieldError{
+			{Field: "customer_id", Rule: "required", Message: "customer ID is required"},
+		}}
+	}
+
+	var fieldErrors []model.FieldError
+	validate := product.ValidateCustomerId
+	if validate == nil {
+		validate = ValidateCustomerId
+	}
+	if err := validate(customerID); err != nil {
+		fieldErrors = append(fieldErrors, model.FieldError{Field: "customer_id", Rule: "format", Message: err.Error()})
+	} else if product.ValidateForProvider != nil {
+		providerErrors, err := product.ValidateForProvider(provider, customerID)
+		if err != nil {
+			return err
+		}
+		fieldErrors = append(fieldErrors, providerErrors...)
+	}
+
+	rules, err := uc.customerIdRules.GetCustomerIdRulesByProductRepository(product.Code)
+	if err != nil {
+		return err
+	}
+	fieldErrors = append(fieldErrors, CheckCustomerId(rules, provider, customerID)...)
+
+	if len(fieldErrors) > 0 {
+		return &model.ValidationError{Errors: fieldErrors}
+	}
+
+	return nil
+}
+
+func (uc *billerUseCase) PayBillUseCase(code, userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	product, err := uc.GetProductUseCase(code)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(payload.PartnerTxId)
+	if err != nil {
+		return nil, err
+	} else if !product.owns(transaction.ID) {
+		return nil, fmt.Errorf("transaction %s is not a %s bill", transaction.ID, product.Name)
+	} else if transaction.UserID != userId {
+		return nil, fmt.Errorf("bill transaction with ID %s not found", transaction.ID)
+	} else if transaction.Status == model.STATUS_SUCCESSFUL {
+		return nil, errors.New("this month's bill has been paid")
+	} else if transaction.Status == model.STATUS_CANCELLED {
+		return nil, errors.New("this bill inquiry has been cancelled")
+	} else if transaction.Status == model.STATUS_EXPIRED || uc.expire(transaction) {
+		return nil, ErrInquiryExpired
+	} else if transaction.Status != model.STATUS_UNPAID {
+		return nil, errors.New("this bill can no longer be paid")
+	}
+
+	user, err := uc.userRepository.GetUserByIDRepository(userId)
+	if err != nil {
+		return nil, err
+	}
+
+	if user.Amount < transaction.TotalPrice {
+		uc.failUnpaid(transaction.ID)
+		return nil, users.ErrBalanceNotEnough
+	}
+
+	summary, detail, err := product.decode(transaction.ProductDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	updateTransaction := &model.Transaction{
+		Status:     model.STATUS_SUCCESSFUL,
+		AdminFee:   transaction.AdminFee,
+		TotalPrice: transaction.TotalPrice,
+		UpdatedAt:  time.Now(),
+	}
+	if product.Settle != nil {
+		settled, err := product.Settle(transaction.ID, detail)
+		if err != nil {
+			return nil, err
+		}
+		if settled != nil {
+			detail = settled
+			updateTransaction.ProductDetail = settled
+		}
+	}
+
+	resp, err := uc.transactionRepository.PayTransactionRepository(transaction.ID, userId, updateTransaction)
+	if errors.Is(err, users.ErrBalanceNotEnough) {
+		uc.failUnpaid(transaction.ID)
+		return nil, err
+	} else if err != nil {
+		return nil, fmt.Errorf("error Updating Transactions in database: %w", err)
+	}
+
+	productDetail := transaction.ProductDetail
+	if updateTransaction.ProductDetail != nil {
+		productDetail = updateTransaction.ProductDetail
+	}
+
+	transactionresp := &model.Transaction{
+		ID:            transaction.ID,
+		UserID:        transaction.UserID,
+		Status:        resp.Status,
+		ProductType:   transaction.ProductType,
+		Description:   transaction.Description,
+		DiscountPrice: transaction.DiscountPrice,
+		AdminFee:      transaction.AdminFee,
+		Price:         transaction.Price,
+		TotalPrice:    transaction.TotalPrice,
+		ProductDetail: productDetail,
+	}
+
+	transactionresp.UpdatedAt = resp.UpdatedAt
+	uc.paid(product, user, transactionresp, summary, detail)
+
+	return transactionresp, nil
+}
+
+// SettleBillUseCase marks an unpaid bill as paid with the given admin fee and
+// total, e.g. from the cart. The caller has already debited the user. Like
+// PayBillUseCase it runs the product's Settle step, saves the biller and
+// mails the receipt.
+func (uc *billerUseCase) SettleBillUseCase(user *model.User, transactionID string, adminFee, totalPrice float64) (*model.Transaction, error) {
+	product, err := uc.GetProductByTransactionIdUseCase(transactionID)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != user.ID {
+		return nil, fmt.Errorf("bill transaction with ID %s not found", transactionID)
+	}
+
+	summary, detail, err := product.decode(transaction.ProductDetail)
+	if err != nil {
+		return nil, err
+	}
+
+	settle := &model.Transaction{
+		Status:     model.STATUS_SUCCESSFUL,
+		AdminFee:   adminFee,
+		TotalPrice: totalPrice,
+		UpdatedAt:  uc.now(),
+	}
+	if product.Settle != nil {
+		settled, err := product.Settle(transactionID, detail)
+		if err != nil {
+			return nil, err
+		}
+		if settled != nil {
+			detail = settled
+			settle.ProductDetail = settled
+		}
+	}
+
+	if _, err := uc.transactionRepository.SettleTransactionRepository(transactionID, settle); err != nil {
+		return nil, err
+	}
+
+	transaction.Status = settle.Status
+	transaction.AdminFee = adminFee
+	transaction.TotalPrice = totalPrice
+	transaction.UpdatedAt = settle.UpdatedAt
+	if settle.ProductDetail != nil {
+		transaction.ProductDetail = settle.ProductDetail
+	}
+	uc.paid(product, user, transaction, summary, detail)
+
+	return transaction, nil
+}
+
+// failUnpaid marks a bill the user could not afford as failed, unless it was
+// settled or closed in the meantime.
+func (uc *billerUseCase) failUnpaid(id string) {
+	if err := uc.transactionRepository.CloseUnpaidTransactionRepository(id, model.STATUS_FAIL); err != nil {
+		log.Printf("failed to mark transaction %s as failed: %v", id, err)
+	}
+}
+
+// paid saves the biller of a paid bill for the user and mails the receipt.
+func (uc *billerUseCase) paid(product *Product, user *model.User, transaction *model.Transaction, summary Summary, detail interface{}) {
+	if summary.CustomerID != "" {
+		paidAt := time.Now()
+		_, err := uc.savedBillerRepository.UpsertSavedBillerRepository(&model.SavedBiller{
+			UserID:         transaction.UserID,
+			Category:       product.Category,
+			ProductId:      transaction.ProductType,
+			CustomerId:     summary.CustomerID,
+			ProviderName:   summary.ProviderName,
+			LastPaidPeriod: summary.Period,
+			LastPaidAmount: transaction.TotalPrice,
+			LastPaidAt:     &paidAt,
+		})
+		if err != nil {
+			log.Printf("failed to save biller for transaction %s: %v", transaction.ID, err)
+		}
+	}
+
+	uc.sendMail(product.receipt(transaction, user, summary, detail))
+}
+
+// ResendReceiptUseCase mails the receipt of a paid bill to its owner again,
+// e.g. when the PLN token email got lost.
+func (uc *billerUseCase) ResendReceiptUseCase(code, userId, transactionID string) error {
+	product, err := uc.GetProductUseCase(code)
+	if err != nil {
+		return err
+	}
+
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != userId || !product.owns(transaction.ID) {
+		return fmt.Errorf("%s transaction %s not found", product.Name, transactionID)
+	}
+	if transaction.Status != model.STATUS_SUCCESSFUL {
+		return fmt.Errorf("transaction %s has not been paid", transactionID)
+	}
+
+	user, err := uc.userRepository.GetUserByIDRepository(userId)
+	if err != nil {
+		return err
+	}
+
+	summary, detail, err := product.decode(transaction.ProductDetail)
+	if err != nil {
+		return err
+	}
+	uc.sendMail(product.receipt(transaction, user, summary, detail))
+
+	return nil
+}
+
+func (uc *billerUseCase) BillStatusUseCase(code string, payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	product, err := uc.GetProductUseCase(code)
+	if err != nil {
+		return nil, err
+	}
+
+	response, err := uc.billerOyApi.BillInquryRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("failed to retrieve %s: %v", product.Name, err)
+	}
+
+	return response, nil
+}
+
+// CancelInquiryUseCase cancels an unpaid bill inquiry of the user.
+func (uc *billerUseCase) CancelInquiryUseCase(userId, transactionID string) (*model.Transaction, error) {
+	if _, err := uc.GetProductByTransactionIdUseCase(transactionID); err != nil {
+		return nil, fmt.Errorf("bill transaction with ID %s not found", transactionID)
+	}
+
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != userId {
+		return nil, fmt.Errorf("bill transaction with ID %s not found", transactionID)
+	}
+
+	switch {
+	case transaction.Status == model.STATUS_CANCELLED:
+		return transaction, nil
+	case transaction.Status == model.STATUS_EXPIRED || uc.expire(transaction):
+		return nil, ErrInquiryExpired
+	case transaction.Status != model.STATUS_UNPAID:
+		return nil, errors.New("only unpaid bill inquiries can be cancelled")
+	}
+
+	if err := uc.transactionRepository.CloseUnpaidTransactionRepository(transaction.ID, model.STATUS_CANCELLED); err != nil {
+		return nil, err
+	}
+	transaction.Status = model.STATUS_CANCELLED
+
+	return transaction, nil
+}
+
+// RunExpireInquiriesUseCase expires unpaid bill inquiries past their expiry,
+// so the next inquiry fetches the amount from the provider again.
+func (uc *billerUseCase) RunExpireInquiriesUseCase(now time.Time) error {
+	expired, err := uc.transactionRepository.ExpireUnpaidTransactionsRepository(now, now.Add(-inquiryTTL()))
+	if err != nil {
+		return err
+	}
+	if expired > 0 {
+		log.Printf("expired %d unpaid bill inquiries", expired)
+	}
+
+	return nil
+}
+
+// expire marks an unpaid inquiry past its expiry as expired and reports
+// whether it did. Inquiries without an expiry are left to
+// RunExpireInquiriesUseCase.
+func (uc *billerUseCase) expire(transaction *model.Transaction) bool {
+	if transaction.Status != model.STATUS_UNPAID || transaction.ExpiresAt == nil || uc.now().Before(*transaction.ExpiresAt) {
+		return false
+	}
+
+	if err := uc.transactionRepository.CloseUnpaidTransactionRepository(transaction.ID, model.STATUS_EXPIRED); err != nil {
+		log.Printf("failed to expire transaction %s: %v", transaction.ID, err)
+	}
+	transaction.Status = model.STATUS_EXPIRED
+
+	return true
+}
+
+func inquiryTTL() time.Duration {
+	if config.AppConfig.InquiryTTLMinutes > 0 {
+		return time.Duration(config.AppConfig.InquiryTTLMinutes) * time.Minute
+	}
+
+	return defaultInquiryTTL
+}
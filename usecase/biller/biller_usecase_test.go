@@ -0,0 +1,554 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/users"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BillerUseCaseTest struct {
+	suite.Suite
+	billerUseCase      *billerUseCase
+	userRepo           *mocks.UserRepository
+	discountRepo       *mocks.DiscountRepository
+	transactionRepo    *mocks.TransactionRepository
+	billerRepo         *mocks.BillerOyApiRepository
+	savedBillerRepo    *mocks.SavedBillerRepository
+	customerIdRuleRepo *mocks.CustomerIdRuleRepository
+	availabilityRepo   *mocks.ProductAvailabilityRepository
+	sent               []model.PayloadMail
+}
+
+type testDetail struct {
+	CustomerID   string `json:"customer_id"`
+	ProviderName string `json:"provider_name"`
+	Period       string `json:"period"`
+	Meter        string `json:"meter"`
+}
+
+var testProduct = &Product{
+	Code:              "water",
+	Category:          "water",
+	Name:              "WATER",
+	TransactionPrefix: "WATER",
+	Price: func(inquiry *Inquiry) (*Bill, error) {
+		return &Bill{
+			Price:       inquiry.Response.Amount,
+			Description: "water bill",
+			Detail: testDetail{
+				CustomerID:   inquiry.Payload.CustomerId,
+				ProviderName: "PAM",
+				Period:       inquiry.Payload.Period,
+				Meter:        "10",
+			},
+		}, nil
+	},
+	Detail: func() interface{} { return &testDetail{} },
+	Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+		receipt.ProviderName = detail.(*testDetail).ProviderName
+	},
+}
+
+var testPrepaidProduct = &Product{
+	Code:              "water_prepaid",
+	Category:          "water",
+	Name:              "WATER",
+	TransactionPrefix: "WATERPRE",
+	Prepaid:           true,
+	Price: func(inquiry *Inquiry) (*Bill, error) {
+		return &Bill{Price: inquiry.Payload.Amount, Detail: testDetail{ProviderName: "PAM"}}, nil
+	},
+}
+
+func TestBillerUseCase(t *testing.T) {
+	suite.Run(t, new(BillerUseCaseTest))
+}
+
+func (m *BillerUseCaseTest) SetupTest() {
+	m.userRepo = &mocks.UserRepository{}
+	m.discountRepo = &mocks.DiscountRepository{}
+	m.transactionRepo = &mocks.TransactionRepository{}
+	m.billerRepo = &mocks.BillerOyApiRepository{}
+	m.savedBillerRepo = &mocks.SavedBillerRepository{}
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	m.customerIdRuleRepo.On("GetCustomerIdRulesByProductRepository", mock.Anything).Return(nil, nil)
+	m.availabilityRepo = &mocks.ProductAvailabilityRepository{}
+	m.availabilityRepo.On("GetProductAvailabilityRepository", mock.Anything, mock.Anything).Return(nil, nil)
+	m.availabilityRepo.On("AddProductAvailabilityFailureRepository", mock.Anything, mock.Anything).Return(nil)
+	m.availabilityRepo.On("TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	m.availabilityRepo.On("ResetProductAvailabilityFailuresRepository", mock.Anything, mock.Anything).Return(nil)
+	m.sent = nil
+	m.billerUseCase = NewBillerUseCase(m.userRepo, m.discountRepo, m.transactionRepo, m.billerRepo, m.savedBillerRepo, m.customerIdRuleRepo, m.availabilityRepo, testProduct, testPrepaidProduct)
+	m.billerUseCase.sendMail = func(payload model.PayloadMail) { m.sent = append(m.sent, payload) }
+	m.billerUseCase.now = func() time.Time { return time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC) }
+}
+
+func (m *BillerUseCaseTest) TestGetProducts() {
+	products := m.billerUseCase.GetProductsUseCase()
+
+	assert.Equal(m.T(), []*Product{testProduct, testPrepaidProduct}, products)
+}
+
+func (m *BillerUseCaseTest) TestNewBillerUseCaseRejectsSharedPrefix() {
+	other := &Product{Code: "gas", TransactionPrefix: testProduct.TransactionPrefix}
+
+	assert.PanicsWithValue(m.T(), "products water and gas share the transaction prefix WATER", func() {
+		NewBillerUseCase(m.userRepo, m.discountRepo, m.transactionRepo, m.billerRepo, m.savedBillerRepo, m.customerIdRuleRepo, m.availabilityRepo, testProduct, other)
+	})
+}
+
+func (m *BillerUseCaseTest) TestGetProductNotFound() {
+	_, err := m.billerUseCase.GetProductUseCase("gas")
+
+	assert.EqualError(m.T(), err, "bill product gas not found")
+}
+
+func (m *BillerUseCaseTest) TestGetProductByTransactionId() {
+	product, err := m.billerUseCase.GetProductByTransactionIdUseCase("WATERPRE-123")
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), testPrepaidProduct, product)
+
+	_, err = m.billerUseCase.GetProductByTransactionIdUseCase("PPD-123")
+	assert.Error(m.T(), err)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryInvalidCustomerId() {
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{CustomerId: "1239"})
+
+	assert.EqualError(m.T(), err, "invalid customer ID")
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryUnavailable() {
+	until := time.Date(2026, time.March, 10, 2, 0, 0, 0, time.UTC)
+	m.availabilityRepo = &mocks.ProductAvailabilityRepository{}
+	m.billerUseCase.availability = m.availabilityRepo
+	m.availabilityRepo.On("GetProductAvailabilityRepository", "water", "pam_jaya").Return([]model.ProductAvailability{
+		{Product: "water", Provider: "pam_jaya", Maintenance: true, MaintenanceUntil: &until, Message: "system upgrade"},
+	}, nil)
+
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{ProductId: "PAM_JAYA", CustomerId: "12345678"})
+
+	var unavailable *model.UnavailableError
+	assert.True(m.T(), errors.As(err, &unavailable))
+	assert.EqualError(m.T(), err, "WATER is temporarily unavailable until 10 Mar 2026 09:00 WIB: system upgrade")
+	m.billerRepo.AssertNotCalled(m.T(), "BillInquryRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryCustomerIdRequired() {
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{})
+
+	var validation *model.ValidationError
+	assert.True(m.T(), errors.As(err, &validation))
+	assert.Equal(m.T(), []model.FieldError{
+		{Field: "customer_id", Rule: "required", Message: "customer ID is required"},
+	}, validation.Errors)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryCustomerIdRules() {
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	m.billerUseCase.customerIdRules = m.customerIdRuleRepo
+	m.customerIdRuleRepo.On("GetCustomerIdRulesByProductRepository", "water").Return([]model.CustomerIdRule{
+		{Product: "water", MinLength: 8, NumericOnly: true},
+		{Product: "water", Provider: "pam_jaya", Prefixes: "10,11"},
+		{Product: "water", Provider: "pam_bandung", MaxLength: 4},
+	}, nil)
+
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{ProductId: "PAM_JAYA", CustomerId: "12a4"})
+
+	var validation *model.ValidationError
+	assert.True(m.T(), errors.As(err, &validation))
+	var rules []string
+	for _, fieldError := range validation.Errors {
+		rules = append(rules, fieldError.Rule)
+	}
+	assert.Equal(m.T(), []string{"min_length", "numeric_only", "prefix"}, rules)
+	m.billerRepo.AssertNotCalled(m.T(), "BillInquryRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryValidatesForProvider() {
+	var checked []string
+	product := *testProduct
+	product.ValidateForProvider = func(provider, customerID string) ([]model.FieldError, error) {
+		checked = append(checked, provider+":"+customerID)
+		return []model.FieldError{{Field: "customer_id", Rule: "region", Message: "customer ID does not belong to PAM Jaya"}}, nil
+	}
+	m.billerUseCase.products["water"] = &product
+
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{ProductId: "PAM_JAYA", CustomerId: "12345678"})
+
+	var validation *model.ValidationError
+	assert.True(m.T(), errors.As(err, &validation))
+	assert.Equal(m.T(), "region", validation.Errors[0].Rule)
+	assert.Equal(m.T(), []string{"pam_jaya:12345678"}, checked)
+	m.billerRepo.AssertNotCalled(m.T(), "BillInquryRepository", mock.Anything)
+
+	// A malformed customer ID is not checked against the provider.
+	_, err = m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{ProductId: "PAM_JAYA", CustomerId: "1239"})
+
+	assert.EqualError(m.T(), err, "invalid customer ID")
+	assert.Len(m.T(), checked, 1)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryRejectsInternalProductType() {
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{ProductId: "PULSA", CustomerId: "12345678"})
+
+	var validation *model.ValidationError
+	assert.True(m.T(), errors.As(err, &validation))
+	assert.Equal(m.T(), "product_id", validation.Errors[0].Field)
+	m.billerRepo.AssertNotCalled(m.T(), "BillInquryRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryNormalizesCustomerId() {
+	var validated string
+	product := *testProduct
+	product.NormalizeCustomerId = strings.TrimSpace
+	product.ValidateCustomerId = func(customerID string) error {
+		validated = customerID
+		return errors.New("invalid customer ID")
+	}
+	m.billerUseCase.products["water"] = &product
+	payload := &model.OyBillerApi{CustomerId: " 123 "}
+
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", payload)
+
+	assert.Error(m.T(), err)
+	assert.Equal(m.T(), "123", validated)
+	assert.Equal(m.T(), "123", payload.CustomerId)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryReturnsExistingBill() {
+	existing := &model.Transaction{ID: "WATER-1", Status: model.STATUS_UNPAID}
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", model.GetProductDetail{
+		ProductId:  "pam",
+		Period:     "March-2026",
+		CustomerId: "123",
+	}).Return(existing, nil)
+
+	resp, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{CustomerId: "123", ProductId: "PAM"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), existing, resp)
+	m.billerRepo.AssertNotCalled(m.T(), "BillInquryRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryAlreadyPaid() {
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(&model.Transaction{Status: model.STATUS_SUCCESSFUL}, nil)
+
+	_, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{CustomerId: "123", ProductId: "pam"})
+
+	assert.EqualError(m.T(), err, "this month's bill has been paid")
+}
+
+func (m *BillerUseCaseTest) TestBillInquirySuccess() {
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(nil, errors.New("record not found"))
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{DiscountPrice: 1000}, nil)
+	m.billerRepo.On("BillInquryRepository", mock.Anything).Return(&model.OyBillerApiResponse{OyBillerData: model.OyBillerData{PartnerTxID: "WATER-1", Amount: 50000, AdminFee: 2500}}, nil)
+	m.transactionRepo.On("CreateTransactionByUserIdRepository", mock.Anything).Return(&model.Transaction{}, nil)
+
+	resp, err := m.billerUseCase.BillInquiryUseCase("WATER", "user", &model.OyBillerApi{CustomerId: "123", ProductId: "pam"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_UNPAID, resp.Status)
+	assert.Equal(m.T(), float64(51500), resp.TotalPrice)
+	assert.Equal(m.T(), "March-2026", resp.ProductDetail.(testDetail).Period)
+	assert.Equal(m.T(), time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC), *resp.ExpiresAt)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryReturnsExistingBillForEarlierPeriod() {
+	product := *testProduct
+	product.Price = func(inquiry *Inquiry) (*Bill, error) {
+		return &Bill{Price: 50000, Detail: testDetail{CustomerID: "123", Period: "February-2026"}}, nil
+	}
+	m.billerUseCase.products["water"] = &product
+	existing := &model.Transaction{ID: "WATER-1", Status: model.STATUS_UNPAID}
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", model.GetProductDetail{ProductId: "pam", Period: "March-2026", CustomerId: "123"}).Return(nil, errors.New("record not found"))
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", model.GetProductDetail{ProductId: "pam", Period: "February-2026", CustomerId: "123"}).Return(existing, nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	m.billerRepo.On("BillInquryRepository", mock.Anything).Return(&model.OyBillerApiResponse{OyBillerData: model.OyBillerData{PartnerTxID: "WATER-2"}}, nil)
+
+	resp, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{CustomerId: "123", ProductId: "pam"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), existing, resp)
+	m.transactionRepo.AssertNotCalled(m.T(), "CreateTransactionByUserIdRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryRefreshesExpiredBill() {
+	expiresAt := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(&model.Transaction{ID: "WATER-1", Status: model.STATUS_UNPAID, TotalPrice: 40000, ExpiresAt: &expiresAt}, nil)
+	m.transactionRepo.On("CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_EXPIRED).Return(nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	m.billerRepo.On("BillInquryRepository", mock.Anything).Return(&model.OyBillerApiResponse{OyBillerData: model.OyBillerData{PartnerTxID: "WATER-2", Amount: 50000, AdminFee: 2500}}, nil)
+	m.transactionRepo.On("CreateTransactionByUserIdRepository", mock.Anything).Return(&model.Transaction{}, nil)
+
+	resp, err := m.billerUseCase.BillInquiryUseCase("water", "user", &model.OyBillerApi{CustomerId: "123", ProductId: "pam"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "WATER-2", resp.ID)
+	assert.Equal(m.T(), float64(52500), resp.TotalPrice)
+	m.transactionRepo.AssertCalled(m.T(), "CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_EXPIRED)
+}
+
+func (m *BillerUseCaseTest) TestBillInquiryPrepaidSkipsPeriod() {
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{}, nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	m.billerRepo.On("BillInquryRepository", mock.Anything).Return(&model.OyBillerApiResponse{OyBillerData: model.OyBillerData{PartnerTxID: "WATERPRE-1"}}, nil)
+	m.transactionRepo.On("CreateTransactionByUserIdRepository", mock.Anything).Return(&model.Transaction{}, nil)
+
+	resp, err := m.billerUseCase.BillInquiryUseCase("water_prepaid", "user", &model.OyBillerApi{CustomerId: "123", Amount: 20000})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_PROCESSING, resp.Status)
+	assert.Equal(m.T(), float64(20000), resp.TotalPrice)
+	assert.Nil(m.T(), resp.ExpiresAt)
+	m.transactionRepo.AssertNotCalled(m.T(), "GetProductDetailsByPeriodAndCustomerID", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillExpired() {
+	expiresAt := time.Date(2026, time.March, 9, 0, 0, 0, 0, time.UTC)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_UNPAID, ExpiresAt: &expiresAt}, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-2").Return(&model.Transaction{ID: "WATER-2", UserID: "user", Status: model.STATUS_EXPIRED}, nil)
+	m.transactionRepo.On("CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_EXPIRED).Return(nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+	assert.ErrorIs(m.T(), err, ErrInquiryExpired)
+
+	_, err = m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-2"})
+	assert.ErrorIs(m.T(), err, ErrInquiryExpired)
+	m.userRepo.AssertNotCalled(m.T(), "GetUserByIDRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillCancelled() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_CANCELLED}, nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.EqualError(m.T(), err, "this bill inquiry has been cancelled")
+}
+
+func (m *BillerUseCaseTest) TestCancelInquiry() {
+	expiresAt := time.Date(2026, time.March, 11, 0, 0, 0, 0, time.UTC)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_UNPAID, ExpiresAt: &expiresAt}, nil)
+	m.transactionRepo.On("CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_CANCELLED).Return(nil)
+
+	resp, err := m.billerUseCase.CancelInquiryUseCase("user", "WATER-1")
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_CANCELLED, resp.Status)
+}
+
+func (m *BillerUseCaseTest) TestCancelInquiryNotCancellable() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "other"}, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-2").Return(&model.Transaction{ID: "WATER-2", UserID: "user", Status: model.STATUS_SUCCESSFUL}, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-3").Return(&model.Transaction{ID: "WATER-3", UserID: "user", Status: model.STATUS_EXPIRED}, nil)
+
+	_, err := m.billerUseCase.CancelInquiryUseCase("user", "PPD-1")
+	assert.EqualError(m.T(), err, "bill transaction with ID PPD-1 not found")
+
+	_, err = m.billerUseCase.CancelInquiryUseCase("user", "WATER-1")
+	assert.EqualError(m.T(), err, "bill transaction with ID WATER-1 not found")
+
+	_, err = m.billerUseCase.CancelInquiryUseCase("user", "WATER-2")
+	assert.EqualError(m.T(), err, "only unpaid bill inquiries can be cancelled")
+
+	_, err = m.billerUseCase.CancelInquiryUseCase("user", "WATER-3")
+	assert.ErrorIs(m.T(), err, ErrInquiryExpired)
+	m.transactionRepo.AssertNotCalled(m.T(), "CloseUnpaidTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestRunExpireInquiries() {
+	now := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	m.transactionRepo.On("ExpireUnpaidTransactionsRepository", now, now.Add(-defaultInquiryTTL)).Return(int64(2), nil)
+
+	assert.NoError(m.T(), m.billerUseCase.RunExpireInquiriesUseCase(now))
+}
+
+func (m *BillerUseCaseTest) TestPayBillBalanceNotEnough() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_UNPAID, TotalPrice: 50000}, nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Amount: 1000}, nil)
+	m.transactionRepo.On("CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_FAIL).Return(nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.Equal(m.T(), users.ErrBalanceNotEnough, err)
+	m.transactionRepo.AssertNotCalled(m.T(), "PayTransactionRepository", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillBalanceSpentMeanwhile() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_UNPAID, TotalPrice: 50000}, nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Amount: 60000}, nil)
+	m.transactionRepo.On("PayTransactionRepository", "WATER-1", "user", mock.Anything).Return(nil, users.ErrBalanceNotEnough)
+	m.transactionRepo.On("CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_FAIL).Return(nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.Equal(m.T(), users.ErrBalanceNotEnough, err)
+	m.transactionRepo.AssertCalled(m.T(), "CloseUnpaidTransactionRepository", "WATER-1", model.STATUS_FAIL)
+}
+
+func (m *BillerUseCaseTest) TestPayBillPaidMeanwhile() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "user", Status: model.STATUS_UNPAID, TotalPrice: 50000}, nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Amount: 60000}, nil)
+	m.transactionRepo.On("PayTransactionRepository", "WATER-1", "user", mock.Anything).Return(nil, errors.New("transaction is no longer unpaid or has expired"))
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.Error(m.T(), err)
+	assert.Empty(m.T(), m.sent)
+	m.savedBillerRepo.AssertNotCalled(m.T(), "UpsertSavedBillerRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillOtherUser() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "other", Status: model.STATUS_UNPAID}, nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.EqualError(m.T(), err, "bill transaction with ID WATER-1 not found")
+	m.userRepo.AssertNotCalled(m.T(), "GetUserByIDRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillSuccess() {
+	transaction := &model.Transaction{
+		ID:          "WATER-1",
+		UserID:      "user",
+		Status:      model.STATUS_UNPAID,
+		ProductType: "pam",
+		TotalPrice:  50000,
+		ProductDetail: map[string]interface{}{
+			"customer_id":   "123",
+			"provider_name": "PAM",
+			"period":        "March-2026",
+		},
+	}
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(transaction, nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Amount: 60000, Email: "user@mail.com"}, nil)
+	m.transactionRepo.On("PayTransactionRepository", "WATER-1", "user", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_SUCCESSFUL && t.TotalPrice == 50000
+	})).Return(&model.Transaction{Status: model.STATUS_SUCCESSFUL}, nil)
+	m.savedBillerRepo.On("UpsertSavedBillerRepository", mock.MatchedBy(func(b *model.SavedBiller) bool {
+		return b.Category == "water" && b.CustomerId == "123" && b.LastPaidPeriod == "March-2026"
+	})).Return(&model.SavedBiller{}, nil)
+
+	resp, err := m.billerUseCase.PayBillUseCase("water", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_SUCCESSFUL, resp.Status)
+	if assert.Len(m.T(), m.sent, 1) {
+		assert.Equal(m.T(), "WATER", m.sent[0].ProductType)
+		assert.Equal(m.T(), "PAM", m.sent[0].ProviderName)
+		assert.Equal(m.T(), "123", m.sent[0].CustomerId)
+	}
+}
+
+func (m *BillerUseCaseTest) TestSettleBill() {
+	product := *testProduct
+	product.Settle = func(transactionID string, detail interface{}) (interface{}, error) {
+		settled := *detail.(*testDetail)
+		settled.Meter = "REF-1"
+		return &settled, nil
+	}
+	m.billerUseCase.products["water"] = &product
+	user := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, Email: "user@mail.com"}
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{
+		ID:            "WATER-1",
+		UserID:        "user",
+		Status:        model.STATUS_UNPAID,
+		ProductDetail: map[string]interface{}{"customer_id": "123", "provider_name": "PAM", "period": "March-2026"},
+	}, nil)
+	m.transactionRepo.On("SettleTransactionRepository", "WATER-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_SUCCESSFUL && t.AdminFee == 1250 && t.TotalPrice == 21250 && t.ProductDetail.(*testDetail).Meter == "REF-1"
+	})).Return(&model.Transaction{}, nil)
+	m.savedBillerRepo.On("UpsertSavedBillerRepository", mock.Anything).Return(&model.SavedBiller{}, nil)
+
+	resp, err := m.billerUseCase.SettleBillUseCase(user, "WATER-1", 1250, 21250)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_SUCCESSFUL, resp.Status)
+	assert.Equal(m.T(), float64(21250), resp.TotalPrice)
+	m.savedBillerRepo.AssertNumberOfCalls(m.T(), "UpsertSavedBillerRepository", 1)
+	if assert.Len(m.T(), m.sent, 1) {
+		assert.Equal(m.T(), float64(21250), m.sent[0].TotalPrice)
+	}
+}
+
+func (m *BillerUseCaseTest) TestSettleBillOtherUser() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", UserID: "other", Status: model.STATUS_UNPAID}, nil)
+
+	_, err := m.billerUseCase.SettleBillUseCase(&model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}}, "WATER-1", 1250, 21250)
+
+	assert.EqualError(m.T(), err, "bill transaction with ID WATER-1 not found")
+	m.transactionRepo.AssertNotCalled(m.T(), "SettleTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestPayBillRejectsOtherProduct() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{ID: "WATER-1", Status: model.STATUS_UNPAID}, nil)
+
+	_, err := m.billerUseCase.PayBillUseCase("water_prepaid", "user", &model.OyBillerApi{PartnerTxId: "WATER-1"})
+
+	assert.EqualError(m.T(), err, "transaction WATER-1 is not a WATER bill")
+	m.userRepo.AssertNotCalled(m.T(), "GetUserByIDRepository", mock.Anything)
+}
+
+func (m *BillerUseCaseTest) TestResendReceipt() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{
+		ID:            "WATER-1",
+		UserID:        "user",
+		Status:        model.STATUS_SUCCESSFUL,
+		ProductDetail: map[string]interface{}{"customer_id": "123", "provider_name": "PAM"},
+	}, nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Email: "user@mail.com"}, nil)
+
+	err := m.billerUseCase.ResendReceiptUseCase("water", "user", "WATER-1")
+
+	assert.NoError(m.T(), err)
+	if assert.Len(m.T(), m.sent, 1) {
+		assert.Equal(m.T(), "user@mail.com", m.sent[0].RecipentEmail)
+		assert.Equal(m.T(), "PAM", m.sent[0].ProviderName)
+	}
+}
+
+func (m *BillerUseCaseTest) TestResendReceiptOtherUser() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{
+		ID:     "WATER-1",
+		UserID: "someone",
+		Status: model.STATUS_SUCCESSFUL,
+	}, nil)
+
+	err := m.billerUseCase.ResendReceiptUseCase("water", "user", "WATER-1")
+
+	assert.EqualError(m.T(), err, "WATER transaction WATER-1 not found")
+	assert.Empty(m.T(), m.sent)
+}
+
+func (m *BillerUseCaseTest) TestResendReceiptUnpaid() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "WATER-1").Return(&model.Transaction{
+		ID:     "WATER-1",
+		UserID: "user",
+		Status: model.STATUS_UNPAID,
+	}, nil)
+
+	err := m.billerUseCase.ResendReceiptUseCase("water", "user", "WATER-1")
+
+	assert.EqualError(m.T(), err, "transaction WATER-1 has not been paid")
+}
+
+func TestBillingPeriod(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+
+	assert.Equal(t, "March-2026", (&Product{}).BillingPeriod(now))
+	assert.Equal(t, "2026", (&Product{Yearly: true}).BillingPeriod(now))
+	assert.Equal(t, "January-2027", PeriodAt(time.Date(2026, time.October, 31, 0, 0, 0, 0, time.UTC), 3))
+}
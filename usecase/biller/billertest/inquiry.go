@@ -0,0 +1,19 @@
+// Package billertest provides fixtures for testing biller products.
+package billertest
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/biller"
+)
+
+// Inquiry returns an inquiry by the user "User" for the bill of customerID in
+// period, with an empty gateway response and no discount.
+func Inquiry(productType, customerID, period string) *biller.Inquiry {
+	return &biller.Inquiry{
+		User:        &model.User{Name: "User"},
+		Payload:     &model.OyBillerApi{CustomerId: customerID, Period: period},
+		Response:    &model.OyBillerApiResponse{},
+		Discount:    &model.Discount{},
+		ProductType: productType,
+	}
+}
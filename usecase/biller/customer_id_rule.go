@@ -0,0 +1,155 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"fmt"
+	"regexp"
+	"strings"
+	"sync"
+)
+
+// checksums are the check digit algorithms a customer ID rule can require.
+var checksums = map[string]func(digits string) bool{
+	model.CHECKSUM_LUHN:  luhn,
+	model.CHECKSUM_MOD11: mod11,
+}
+
+// CheckCustomerId applies the rules of a product to a customer ID and returns
+// every check it fails. Rules for another provider are skipped.
+func CheckCustomerId(rules []model.CustomerIdRule, provider, customerID string) []model.FieldError {
+	var fieldErrors []model.FieldError
+	fail := func(rule, message string) {
+		fieldErrors = append(fieldErrors, model.FieldError{Field: "customer_id", Rule: rule, Message: message})
+	}
+
+	for _, rule := range rules {
+		if rule.Provider != "" && rule.Provider != provider {
+			continue
+		}
+
+		if rule.MinLength > 0 && len(customerID) < rule.MinLength {
+			fail("min_length", fmt.Sprintf("customer ID must be at least %d characters", rule.MinLength))
+		}
+		if rule.MaxLength > 0 && len(customerID) > rule.MaxLength {
+			fail("max_length", fmt.Sprintf("customer ID must be at most %d characters", rule.MaxLength))
+		}
+		numeric := isNumeric(customerID)
+		if rule.NumericOnly && !numeric {
+			fail("numeric_only", "customer ID must contain digits only")
+		}
+		if prefixes := SplitPrefixes(rule.Prefixes); len(prefixes) > 0 && !hasPrefix(customerID, prefixes) {
+			fail("prefix", fmt.Sprintf("customer ID must start with %s", strings.Join(prefixes, ", ")))
+		}
+		if check, ok := checksums[rule.Checksum]; ok && (!numeric || !check(customerID)) {
+			fail("checksum", "customer ID has an invalid check digit")
+		}
+	}
+
+	return fieldErrors
+}
+
+// patterns caches the compiled customer ID patterns of PDAM regions and ISPs,
+// so an inquiry does not compile its provider's pattern again.
+var patterns sync.Map
+
+// MatchPattern reports whether a customer ID matches an admin-managed
+// pattern.
+func MatchPattern(pattern, customerID string) (bool, error) {
+	if re, ok := patterns.Load(pattern); ok {
+		return re.(*regexp.Regexp).MatchString(customerID), nil
+	}
+
+	re, err := regexp.Compile(pattern)
+	if err != nil {
+		return false, err
+	}
+	patterns.Store(pattern, re)
+
+	return re.MatchString(customerID), nil
+}
+
+// SplitPrefixes reads the comma-separated prefixes of a rule.
+func SplitPrefixes(prefixes string) []string {
+	var list []string
+	for _, prefix := range strings.Split(prefixes, ",") {
+		if prefix = strings.TrimSpace(prefix); prefix != "" {
+			list = append(list, prefix)
+		}
+	}
+
+	return list
+}
+
+func hasPrefix(customerID string, prefixes []string) bool {
+	for _, prefix := range prefixes {
+		if strings.HasPrefix(customerID, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func isNumeric(s string) bool {
+	if s == "" {
+		return false
+	}
+	for _, r := range s {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// luhn checks the last digit with the Luhn algorithm used by card-like
+// account numbers.
+func luhn(digits string) bool {
+	if len(digits) < 2 {
+		return false
+	}
+
+	sum := 0
+	double := false
+	for i := len(digits) - 1; i >= 0; i-- {
+		d := int(digits[i] - '0')
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+
+	return sum%10 == 0
+}
+
+// mod11 checks the last digit against the weighted modulus 11 of the others,
+// with weights 2 to 7 repeating from the right. Remainders of 10 and 11 map
+// to a check digit of 0.
+func mod11(digits string) bool {
+	if len(digits) < 2 {
+		return false
+	}
+
+	body := digits[:len(digits)-1]
+	sum := 0
+	weight := 2
+	for i := len(body) - 1; i >= 0; i-- {
+		sum += int(body[i]-'0') * weight
+		weight++
+		if weight > 7 {
+			weight = 2
+		}
+	}
+
+	check := 11 - sum%11
+	if check >= 10 {
+		check = 0
+	}
+
+	return int(digits[len(digits)-1]-'0') == check
+}
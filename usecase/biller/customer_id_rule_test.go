@@ -0,0 +1,61 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLuhn(t *testing.T) {
+	assert.True(t, luhn("79927398713"))
+	assert.False(t, luhn("79927398710"))
+	assert.False(t, luhn("7"))
+}
+
+func TestMod11(t *testing.T) {
+	assert.True(t, mod11("1234560"))
+	assert.True(t, mod11("12345674"))
+	assert.False(t, mod11("12345675"))
+}
+
+func TestCheckCustomerId(t *testing.T) {
+	rules := []model.CustomerIdRule{
+		{Product: "pdam", MinLength: 8, MaxLength: 10, NumericOnly: true},
+		{Product: "pdam", Provider: "pdam_jakarta", Prefixes: "12, 13", Checksum: model.CHECKSUM_MOD11},
+	}
+
+	assert.Empty(t, CheckCustomerId(rules, "pdam_jakarta", "12345674"))
+	assert.Empty(t, CheckCustomerId(rules, "pdam_bandung", "98765432"))
+
+	fieldErrors := CheckCustomerId(rules, "pdam_jakarta", "98765432")
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "prefix", Message: "customer ID must start with 12, 13"},
+		{Field: "customer_id", Rule: "checksum", Message: "customer ID has an invalid check digit"},
+	}, fieldErrors)
+
+	fieldErrors = CheckCustomerId(rules, "pdam_bandung", "12AB")
+	assert.Equal(t, []string{"min_length", "numeric_only"}, []string{fieldErrors[0].Rule, fieldErrors[1].Rule})
+}
+
+func TestValidationErrorMessage(t *testing.T) {
+	err := &model.ValidationError{Errors: []model.FieldError{
+		{Field: "customer_id", Rule: "min_length", Message: "customer ID must be at least 8 characters"},
+		{Field: "customer_id", Rule: "numeric_only", Message: "customer ID must contain digits only"},
+	}}
+
+	assert.EqualError(t, err, "customer ID must be at least 8 characters; customer ID must contain digits only")
+}
+
+func TestMatchPattern(t *testing.T) {
+	matched, err := MatchPattern(`^[0-9]{8}$`, "12345678")
+	assert.NoError(t, err)
+	assert.True(t, matched)
+
+	matched, err = MatchPattern(`^[0-9]{8}$`, "1234567")
+	assert.NoError(t, err)
+	assert.False(t, matched)
+
+	_, err = MatchPattern(`[`, "1234567")
+	assert.Error(t, err)
+}
@@ -0,0 +1,137 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type CustomerIdRuleUseCase interface {
+	CreateCustomerIdRuleUseCase(payload *model.CustomerIdRule) (*model.CustomerIdRule, error)
+	GetAllCustomerIdRuleUseCase(product string, page, limit int) ([]*model.CustomerIdRule, error)
+	GetCustomerIdRuleByIdUseCase(id string) (*model.CustomerIdRule, error)
+	UpdateCustomerIdRuleByIdUseCase(id string, payload *model.CustomerIdRule) (*model.CustomerIdRule, error)
+	DeleteCustomerIdRuleByIdUseCase(id string) error
+}
+
+type customerIdRuleUseCase struct {
+	customerIdRuleRepository repository.CustomerIdRuleRepository
+	billerUseCase            BillerUseCase
+}
+
+func NewCustomerIdRuleUseCase(customerIdRuleRepository repository.CustomerIdRuleRepository, billerUseCase BillerUseCase) *customerIdRuleUseCase {
+	return &customerIdRuleUseCase{
+		customerIdRuleRepository: customerIdRuleRepository,
+		billerUseCase:            billerUseCase,
+	}
+}
+
+func (uc *customerIdRuleUseCase) CreateCustomerIdRuleUseCase(payload *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	payload.Product = strings.ToLower(payload.Product)
+	payload.Provider = strings.ToLower(payload.Provider)
+	if _, err := uc.billerUseCase.GetProductUseCase(payload.Product); err != nil {
+		return nil, err
+	}
+	if err := validateCustomerIdRule(payload); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.customerIdRuleRepository.GetCustomerIdRuleRepository(payload.Product, payload.Provider)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("customer ID rule for %s already exists", ruleName(payload))
+	}
+
+	rule, err := uc.customerIdRuleRepository.CreateCustomerIdRuleRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating customer ID rule in database: %w", err)
+	}
+
+	return rule, nil
+}
+
+func (uc *customerIdRuleUseCase) GetAllCustomerIdRuleUseCase(product string, page, limit int) ([]*model.CustomerIdRule, error) {
+	return uc.customerIdRuleRepository.GetAllCustomerIdRuleRepository(strings.ToLower(product), page, limit)
+}
+
+func (uc *customerIdRuleUseCase) GetCustomerIdRuleByIdUseCase(id string) (*model.CustomerIdRule, error) {
+	rule, err := uc.customerIdRuleRepository.GetCustomerIdRuleByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("customer ID rule not found")
+	}
+
+	return rule, nil
+}
+
+// UpdateCustomerIdRuleByIdUseCase replaces the checks of a rule. Its product
+// and provider cannot change.
+func (uc *customerIdRuleUseCase) UpdateCustomerIdRuleByIdUseCase(id string, payload *model.CustomerIdRule) (*model.CustomerIdRule, error) {
+	rule, err := uc.customerIdRuleRepository.GetCustomerIdRuleByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update customer ID rule: %v", err)
+	}
+
+	rule.MinLength = payload.MinLength
+	rule.MaxLength = payload.MaxLength
+	rule.NumericOnly = payload.NumericOnly
+	rule.Prefixes = payload.Prefixes
+	rule.Checksum = payload.Checksum
+	rule.Description = payload.Description
+	if err := validateCustomerIdRule(rule); err != nil {
+		return nil, err
+	}
+	rule.UpdatedAt = time.Now()
+
+	updated, err := uc.customerIdRuleRepository.UpdateCustomerIdRuleByIdRepository(id, rule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update customer ID rule: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *customerIdRuleUseCase) DeleteCustomerIdRuleByIdUseCase(id string) error {
+	err := uc.customerIdRuleRepository.DeleteCustomerIdRuleByIdRepository(id)
+	if err != nil {
+		return errors.New("customer ID rule not found")
+	}
+
+	return nil
+}
+
+func validateCustomerIdRule(rule *model.CustomerIdRule) error {
+	if rule.MinLength < 0 || rule.MaxLength < 0 {
+		return errors.New("min_length and max_length cannot be negative")
+	}
+	if rule.MaxLength > 0 && rule.MinLength > rule.MaxLength {
+		return errors.New("min_length cannot be greater than max_length")
+	}
+
+	rule.Prefixes = strings.Join(SplitPrefixes(rule.Prefixes), ",")
+	rule.Checksum = strings.ToLower(rule.Checksum)
+	if _, ok := checksums[rule.Checksum]; rule.Checksum != "" && !ok {
+		return fmt.Errorf("checksum must be %s or %s", model.CHECKSUM_LUHN, model.CHECKSUM_MOD11)
+	}
+	if rule.Checksum != "" && !rule.NumericOnly {
+		return errors.New("checksum rules require numeric_only")
+	}
+
+	if rule.MinLength == 0 && rule.MaxLength == 0 && !rule.NumericOnly && rule.Prefixes == "" && rule.Checksum == "" {
+		return errors.New("rule must check at least one of length, numeric_only, prefixes or checksum")
+	}
+
+	return nil
+}
+
+func ruleName(rule *model.CustomerIdRule) string {
+	if rule.Provider == "" {
+		return rule.Product
+	}
+
+	return rule.Product + " " + rule.Provider
+}
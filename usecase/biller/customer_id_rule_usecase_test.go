@@ -0,0 +1,75 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CustomerIdRuleUseCaseTest struct {
+	suite.Suite
+	customerIdRuleUseCase CustomerIdRuleUseCase
+	customerIdRuleRepo    *mocks.CustomerIdRuleRepository
+}
+
+func TestCustomerIdRuleUseCase(t *testing.T) {
+	suite.Run(t, new(CustomerIdRuleUseCaseTest))
+}
+
+func (m *CustomerIdRuleUseCaseTest) SetupTest() {
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	billerUseCase := NewBillerUseCase(nil, nil, nil, nil, nil, m.customerIdRuleRepo, nil, testProduct)
+	m.customerIdRuleUseCase = NewCustomerIdRuleUseCase(m.customerIdRuleRepo, billerUseCase)
+}
+
+func (m *CustomerIdRuleUseCaseTest) TestCreateCustomerIdRuleSuccess() {
+	m.customerIdRuleRepo.On("GetCustomerIdRuleRepository", "water", "pam").Return(nil, nil)
+	m.customerIdRuleRepo.On("CreateCustomerIdRuleRepository", mock.Anything).Return(func(rule *model.CustomerIdRule) *model.CustomerIdRule {
+		return rule
+	}, nil)
+
+	resp, err := m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{
+		Product:     "WATER",
+		Provider:    "PAM",
+		NumericOnly: true,
+		Prefixes:    " 10, ,11",
+		Checksum:    "LUHN",
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "water", resp.Product)
+	assert.Equal(m.T(), "10,11", resp.Prefixes)
+	assert.Equal(m.T(), model.CHECKSUM_LUHN, resp.Checksum)
+}
+
+func (m *CustomerIdRuleUseCaseTest) TestCreateCustomerIdRuleUnknownProduct() {
+	_, err := m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "gas", MinLength: 5})
+
+	assert.EqualError(m.T(), err, "bill product gas not found")
+}
+
+func (m *CustomerIdRuleUseCaseTest) TestCreateCustomerIdRuleInvalid() {
+	_, err := m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "water", MinLength: 10, MaxLength: 8})
+	assert.EqualError(m.T(), err, "min_length cannot be greater than max_length")
+
+	_, err = m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "water", NumericOnly: true, Checksum: "crc"})
+	assert.EqualError(m.T(), err, "checksum must be luhn or mod11")
+
+	_, err = m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "water", Checksum: "luhn"})
+	assert.EqualError(m.T(), err, "checksum rules require numeric_only")
+
+	_, err = m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "water"})
+	assert.Error(m.T(), err)
+}
+
+func (m *CustomerIdRuleUseCaseTest) TestCreateCustomerIdRuleDuplicate() {
+	m.customerIdRuleRepo.On("GetCustomerIdRuleRepository", "water", "").Return(&model.CustomerIdRule{}, nil)
+
+	_, err := m.customerIdRuleUseCase.CreateCustomerIdRuleUseCase(&model.CustomerIdRule{Product: "water", MinLength: 5})
+
+	assert.EqualError(m.T(), err, "customer ID rule for water already exists")
+}
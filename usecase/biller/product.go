@@ -0,0 +1,191 @@
+package biller
+
+import (
+	"BE-Golang/model"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Product describes a bill category handled by the biller engine. The engine
+// owns the shared inquiry, payment and receipt flow; a product only declares
+// what differs between categories.
+type Product struct {
+	// Code identifies the product in routes and lookups, e.g. "pdam".
+	Code string `json:"code"`
+	// Category is stored on saved billers and auto-pay subscriptions.
+	Category string `json:"category"`
+	// Name is shown on receipts and in error messages.
+	Name string `json:"name"`
+	// TransactionPrefix is prepended to the generated transaction ID.
+	TransactionPrefix string `json:"transaction_prefix"`
+	// Prepaid products have no billing period, so inquiries are never
+	// matched against an existing bill and start out as processing.
+	Prepaid bool `json:"prepaid"`
+	// Yearly products are billed once a year, so their period is the year.
+	Yearly bool `json:"yearly"`
+
+	// NormalizeCustomerId optionally rewrites the customer ID the user typed
+	// into its canonical form before it is validated or looked up.
+	NormalizeCustomerId func(customerID string) string `json:"-"`
+	// ValidateCustomerId checks the customer ID before anything is looked up.
+	// When nil, ValidateCustomerId is used.
+	ValidateCustomerId func(customerID string) error `json:"-"`
+	// ValidateForProvider optionally checks a well-formed customer ID against
+	// the provider picked on inquiry, e.g. that a tax object number belongs to
+	// the region. Like ValidateCustomerId it runs before the provider is
+	// called; an error is returned only when the provider cannot be looked up.
+	ValidateForProvider func(provider, customerID string) ([]model.FieldError, error) `json:"-"`
+	// Price is the pricing source. It returns the bill price together with
+	// the product detail stored on the transaction.
+	Price func(inquiry *Inquiry) (*Bill, error) `json:"-"`
+	// Detail returns an empty value of the product detail schema, used to
+	// decode a stored transaction back into the product's own type.
+	Detail func() interface{} `json:"-"`
+	// Receipt copies product specific fields onto the payment receipt.
+	Receipt func(detail interface{}, receipt *model.PayloadMail) `json:"-"`
+	// Settle optionally returns a new product detail to store once the bill
+	// is paid, e.g. with the provider's reference number. Returning nil keeps
+	// the stored detail; an error leaves the bill unpaid.
+	Settle func(transactionID string, detail interface{}) (interface{}, error) `json:"-"`
+}
+
+// ProductStatus is a product as listed to users, with whether it can be
+// bought right now.
+type ProductStatus struct {
+	*Product
+	Availability model.Availability `json:"availability"`
+}
+
+// Inquiry is everything a product may use to price a bill.
+type Inquiry struct {
+	User        *model.User
+	Payload     *model.OyBillerApi
+	Response    *model.OyBillerApiResponse
+	Discount    *model.Discount
+	ProductType string
+	Now         time.Time
+}
+
+// Bill is the product's answer to an inquiry.
+type Bill struct {
+	Price       float64
+	Description string
+	Detail      interface{}
+}
+
+// Summary holds the product detail fields every bill product shares.
+type Summary struct {
+	CustomerID   string `json:"customer_id"`
+	ProviderName string `json:"provider_name"`
+	Period       string `json:"period"`
+}
+
+// ValidateCustomerId is the default customer ID check used by the Oy sandbox,
+// which rejects every customer ID ending in 9.
+func ValidateCustomerId(customerID string) error {
+	if customerID == "" || customerID[len(customerID)-1] == '9' {
+		return errors.New("invalid customer ID")
+	}
+
+	return nil
+}
+
+// owns reports whether the transaction was created by this product.
+func (p *Product) owns(transactionID string) bool {
+	return strings.HasPrefix(transactionID, p.TransactionPrefix+"-")
+}
+
+// decode reads a stored product detail back into the shared summary and, when
+// the product declares one, its own detail type.
+func (p *Product) decode(productDetail interface{}) (Summary, interface{}, error) {
+	var summary Summary
+	jsonData, err := json.Marshal(productDetail)
+	if err != nil {
+		return summary, nil, fmt.Errorf("error serializing transaction response to JSON: %w", err)
+	}
+	if err := json.Unmarshal(jsonData, &summary); err != nil {
+		return summary, nil, fmt.Errorf("error Unmarshal: %w", err)
+	}
+
+	var detail interface{}
+	if p.Detail != nil {
+		detail = p.Detail()
+		if err := json.Unmarshal(jsonData, detail); err != nil {
+			return summary, nil, fmt.Errorf("error Unmarshal: %w", err)
+		}
+	}
+
+	return summary, detail, nil
+}
+
+// receipt builds the payment receipt mailed to the user.
+func (p *Product) receipt(transaction *model.Transaction, user *model.User, summary Summary, detail interface{}) model.PayloadMail {
+	receipt := model.PayloadMail{
+		OrderId:       transaction.ID,
+		CustomerName:  user.Name,
+		CustomerId:    summary.CustomerID,
+		Status:        transaction.Status,
+		ProductType:   p.Name,
+		Period:        summary.Period,
+		RecipentEmail: user.Email,
+		TransactionAt: transaction.UpdatedAt,
+		Description:   transaction.Description,
+		DiscountPrice: transaction.DiscountPrice,
+		AdminFee:      transaction.AdminFee,
+		Price:         transaction.Price,
+		TotalPrice:    transaction.TotalPrice,
+	}
+	if p.Receipt != nil {
+		p.Receipt(detail, &receipt)
+	}
+
+	return receipt
+}
+
+// CurrentPeriod returns the billing period for t, e.g. "March-2026".
+func CurrentPeriod(t time.Time) string {
+	return t.Month().String() + "-" + strconv.Itoa(t.Year())
+}
+
+// BillingPeriod returns the period a bill of this product is issued for at t.
+func (p *Product) BillingPeriod(t time.Time) string {
+	if p.Yearly {
+		return strconv.Itoa(t.Year())
+	}
+
+	return CurrentPeriod(t)
+}
+
+// PeriodAt returns the billing period the given number of months away from
+// t, e.g. PeriodAt(t, -1) is the period before CurrentPeriod(t).
+func PeriodAt(t time.Time, months int) string {
+	first := time.Date(t.Year(), t.Month(), 1, 0, 0, 0, 0, t.Location())
+	return CurrentPeriod(first.AddDate(0, months, 0))
+}
+
+// Hash derives stable sandbox attributes from a customer ID, so the same
+// customer is billed the same way every time until the gateway returns them.
+func Hash(s string) uint32 {
+	h := fnv.New32a()
+	h.Write([]byte(s))
+
+	return h.Sum32()
+}
+
+func GenerateVANumber(length int) string {
+	charset := "0123456789"
+	rand.Seed(time.Now().UnixNano())
+
+	vaNumber := make([]byte, length)
+	for i := 0; i < length; i++ {
+		vaNumber[i] = charset[rand.Intn(len(charset))]
+	}
+
+	return string(vaNumber)
+}
@@ -0,0 +1,255 @@
+package bulk
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"strings"
+)
+
+// maxRows caps the data rows of one upload.
+const maxRows = 5000
+
+type BulkUseCase interface {
+	ExportCatalogUseCase(code, format string, w io.Writer) error
+	ImportCatalogUseCase(code, filename string, file io.Reader, dryRun bool) (*model.BulkImportResult, error)
+	AdjustPriceUseCase(code string, payload dto.BulkPriceAdjustmentDto) (*model.BulkPriceAdjustmentResult, error)
+}
+
+type bulkUseCase struct {
+	bulkRepository repository.BulkRepository
+	catalogs       map[string]*Catalog
+}
+
+func NewBulkUseCase(bulkRepository repository.BulkRepository, catalogs ...*Catalog) *bulkUseCase {
+	uc := &bulkUseCase{
+		bulkRepository: bulkRepository,
+		catalogs:       map[string]*Catalog{},
+	}
+	for _, catalog := range catalogs {
+		uc.catalogs[catalog.Code] = catalog
+	}
+
+	return uc
+}
+
+func (uc *bulkUseCase) ExportCatalogUseCase(code, format string, w io.Writer) error {
+	catalog, err := uc.catalog(code)
+	if err != nil {
+		return err
+	}
+
+	rows, err := catalog.Export()
+	if err != nil {
+		return err
+	}
+
+	return WriteSheet(format, w, append([][]string{catalog.Columns}, rows...))
+}
+
+// ImportCatalogUseCase validates every row of an upload and, unless it is a
+// dry run, saves them in one transaction. When any row is invalid nothing is
+// saved and the result lists the problems.
+func (uc *bulkUseCase) ImportCatalogUseCase(code, filename string, file io.Reader, dryRun bool) (*model.BulkImportResult, error) {
+	catalog, err := uc.catalog(code)
+	if err != nil {
+		return nil, err
+	}
+
+	sheet, err := ReadSheet(filename, file)
+	if err != nil {
+		return nil, err
+	}
+	header, err := readHeader(catalog, sheet)
+	if err != nil {
+		return nil, err
+	}
+	if len(sheet)-1 > maxRows {
+		return nil, fmt.Errorf("sheet has more than %d rows", maxRows)
+	}
+
+	parse, err := catalog.Parser()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.BulkImportResult{
+		Catalog: catalog.Code,
+		DryRun:  dryRun,
+		Errors:  []model.BulkRowError{},
+	}
+	var records []interface{}
+	seen := map[string]int{}
+	for i, values := range sheet[1:] {
+		row := newRow(i+2, header, values)
+		if row.empty() {
+			continue
+		}
+		result.Rows++
+
+		key := row.key(catalog.Key)
+		if line, ok := seen[key]; ok {
+			row.Errorf("same %s as row %d", strings.Join(catalog.Key, " and "), line)
+		} else {
+			seen[key] = row.Line
+		}
+
+		record, update := parse(row)
+		if len(row.errors) > 0 {
+			for _, message := range row.errors {
+				result.Errors = append(result.Errors, model.BulkRowError{Row: row.Line, Message: message})
+			}
+			continue
+		}
+
+		records = append(records, record)
+		if update {
+			result.Updated++
+		} else {
+			result.Created++
+		}
+	}
+
+	if result.Rows == 0 {
+		return nil, errors.New("sheet has no rows")
+	}
+	if len(result.Errors) > 0 || dryRun {
+		return result, nil
+	}
+
+	if err := uc.bulkRepository.SaveAllRepository(records); err != nil {
+		return nil, fmt.Errorf("nothing was imported: %v", err)
+	}
+
+	return result, nil
+}
+
+// AdjustPriceUseCase moves the sell prices of a provider's products by a
+// percentage or a fixed amount, all or none of them.
+func (uc *bulkUseCase) AdjustPriceUseCase(code string, payload dto.BulkPriceAdjustmentDto) (*model.BulkPriceAdjustmentResult, error) {
+	catalog, err := uc.catalog(code)
+	if err != nil {
+		return nil, err
+	}
+	if catalog.Prices == nil {
+		return nil, fmt.Errorf("catalog %s has no sell prices to adjust", catalog.Code)
+	}
+	if (payload.Percentage == 0) == (payload.Amount == 0) {
+		return nil, errors.New("either percentage or amount is required")
+	}
+	if payload.Percentage <= -100 {
+		return nil, errors.New("percentage must be greater than -100")
+	}
+	if payload.RoundTo < 0 {
+		return nil, errors.New("round_to must not be negative")
+	}
+
+	items, err := catalog.Prices(strings.TrimSpace(payload.Provider))
+	if err != nil {
+		return nil, err
+	}
+	if len(items) == 0 {
+		return nil, errors.New("no products to adjust")
+	}
+
+	result := &model.BulkPriceAdjustmentResult{
+		Catalog: catalog.Code,
+		DryRun:  payload.DryRun,
+		Changes: []model.BulkPriceChange{},
+	}
+	var records []interface{}
+	for _, item := range items {
+		price := adjustPrice(item.Price, payload)
+		if price <= 0 {
+			return nil, fmt.Errorf("price of %s would drop to %.0f", item.Code, price)
+		}
+		if price < item.CostPrice {
+			return nil, fmt.Errorf("price of %s would drop below its cost price %.0f", item.Code, item.CostPrice)
+		}
+		if price == item.Price {
+			continue
+		}
+
+		result.Changes = append(result.Changes, model.BulkPriceChange{
+			Code:     item.Code,
+			Name:     item.Name,
+			OldPrice: item.Price,
+			NewPrice: price,
+		})
+		records = append(records, item.WithPrice(price))
+	}
+
+	if payload.DryRun || len(records) == 0 {
+		return result, nil
+	}
+
+	if err := uc.bulkRepository.SaveAllRepository(records); err != nil {
+		return nil, fmt.Errorf("no price was adjusted: %v", err)
+	}
+
+	return result, nil
+}
+
+func (uc *bulkUseCase) catalog(code string) (*Catalog, error) {
+	catalog, ok := uc.catalogs[code]
+	if !ok {
+		return nil, fmt.Errorf("catalog %s not found", code)
+	}
+
+	return catalog, nil
+}
+
+// readHeader checks that the first row of a sheet names every column of the
+// catalog and no other.
+func readHeader(catalog *Catalog, sheet [][]string) ([]string, error) {
+	if len(sheet) == 0 {
+		return nil, errors.New("sheet is empty")
+	}
+
+	known := map[string]bool{}
+	for _, column := range catalog.Columns {
+		known[column] = true
+	}
+
+	header := make([]string, len(sheet[0]))
+	found := map[string]bool{}
+	for i, name := range sheet[0] {
+		column := strings.ToLower(strings.TrimSpace(name))
+		if column == "" {
+			continue
+		}
+		if !known[column] {
+			return nil, fmt.Errorf("unknown column %s", name)
+		}
+		if found[column] {
+			return nil, fmt.Errorf("column %s appears twice", column)
+		}
+		found[column] = true
+		header[i] = column
+	}
+
+	for _, column := range catalog.Columns {
+		if !found[column] {
+			return nil, fmt.Errorf("sheet has no %s column", column)
+		}
+	}
+
+	return header, nil
+}
+
+func adjustPrice(price float64, payload dto.BulkPriceAdjustmentDto) float64 {
+	if payload.Percentage != 0 {
+		price = price * (100 + payload.Percentage) / 100
+	} else {
+		price += payload.Amount
+	}
+
+	if payload.RoundTo > 0 {
+		return math.Round(price/payload.RoundTo) * payload.RoundTo
+	}
+	return math.Round(price)
+}
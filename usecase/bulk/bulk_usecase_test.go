@@ -0,0 +1,167 @@
+package bulk
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"bytes"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type fakeProduct struct {
+	Code  string
+	Price float64
+}
+
+// fakeCatalog is a catalog of code and price columns holding TSEL10.
+func fakeCatalog() *Catalog {
+	existing := map[string]fakeProduct{"TSEL10": {Code: "TSEL10", Price: 10500}}
+
+	return &Catalog{
+		Code:    "fake",
+		Columns: []string{"code", "price"},
+		Key:     []string{"code"},
+		Export: func() ([][]string, error) {
+			return [][]string{{"TSEL10", "10500"}}, nil
+		},
+		Parser: func() (Parser, error) {
+			return func(row *Row) (interface{}, bool) {
+				product, update := existing[row.Required("code")]
+				product.Code = row.String("code")
+				product.Price = row.Float("price")
+				if product.Price <= 0 {
+					row.Errorf("price must be greater than 0")
+				}
+				return &product, update
+			}, nil
+		},
+		Prices: func(provider string) ([]PricedItem, error) {
+			return []PricedItem{
+				{Code: "TSEL10", Price: 10500, CostPrice: 10200, WithPrice: func(price float64) interface{} {
+					return &fakeProduct{Code: "TSEL10", Price: price}
+				}},
+				{Code: "TSEL20", Price: 21000, CostPrice: 20100, WithPrice: func(price float64) interface{} {
+					return &fakeProduct{Code: "TSEL20", Price: price}
+				}},
+			}, nil
+		},
+	}
+}
+
+func TestExportCatalogUseCase(t *testing.T) {
+	service := NewBulkUseCase(mocks.NewBulkRepository(t), fakeCatalog())
+
+	var sheet bytes.Buffer
+	err := service.ExportCatalogUseCase("fake", model.BULK_FORMAT_CSV, &sheet)
+
+	assert.NoError(t, err)
+	assert.Equal(t, "code,price\nTSEL10,10500\n", sheet.String())
+}
+
+func TestImportCatalogUseCase(t *testing.T) {
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	mockBulkRepo.On("SaveAllRepository", []interface{}{
+		&fakeProduct{Code: "TSEL10", Price: 11000},
+		&fakeProduct{Code: "TSEL20", Price: 21000},
+	}).Return(nil)
+
+	service := NewBulkUseCase(mockBulkRepo, fakeCatalog())
+
+	result, err := service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("Price, Code\n11000,TSEL10\n,\n21000,TSEL20\n"), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, &model.BulkImportResult{Catalog: "fake", Rows: 2, Created: 1, Updated: 1, Errors: []model.BulkRowError{}}, result)
+}
+
+func TestImportCatalogUseCaseInvalidRows(t *testing.T) {
+	service := NewBulkUseCase(mocks.NewBulkRepository(t), fakeCatalog())
+
+	result, err := service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code,price\nTSEL10,11000\n,ten\nTSEL10,0\n"), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.BulkRowError{
+		{Row: 3, Message: "code is required"},
+		{Row: 3, Message: "price must be a number"},
+		{Row: 3, Message: "price must be greater than 0"},
+		{Row: 4, Message: "same code as row 2"},
+		{Row: 4, Message: "price must be greater than 0"},
+	}, result.Errors)
+}
+
+func TestImportCatalogUseCaseDryRun(t *testing.T) {
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	service := NewBulkUseCase(mockBulkRepo, fakeCatalog())
+
+	result, err := service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code,price\nTSEL20,21000\n"), true)
+
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, 1, result.Created)
+	mockBulkRepo.AssertNotCalled(t, "SaveAllRepository", mock.Anything)
+}
+
+func TestImportCatalogUseCaseRollback(t *testing.T) {
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	mockBulkRepo.On("SaveAllRepository", mock.Anything).Return(errors.New("failed to save record 1: duplicate key"))
+
+	service := NewBulkUseCase(mockBulkRepo, fakeCatalog())
+
+	_, err := service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code,price\nTSEL20,21000\n"), false)
+
+	assert.EqualError(t, err, "nothing was imported: failed to save record 1: duplicate key")
+}
+
+func TestImportCatalogUseCaseInvalidSheet(t *testing.T) {
+	service := NewBulkUseCase(mocks.NewBulkRepository(t), fakeCatalog())
+
+	_, err := service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader(""), false)
+	assert.EqualError(t, err, "sheet is empty")
+
+	_, err = service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code\nTSEL10\n"), false)
+	assert.EqualError(t, err, "sheet has no price column")
+
+	_, err = service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code,price,stock\n"), false)
+	assert.EqualError(t, err, "unknown column stock")
+
+	_, err = service.ImportCatalogUseCase("fake", "catalog.csv", strings.NewReader("code,price\n"), false)
+	assert.EqualError(t, err, "sheet has no rows")
+
+	_, err = service.ImportCatalogUseCase("ppd", "catalog.csv", strings.NewReader("code,price\n"), false)
+	assert.EqualError(t, err, "catalog ppd not found")
+}
+
+func TestAdjustPriceUseCase(t *testing.T) {
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	mockBulkRepo.On("SaveAllRepository", []interface{}{
+		&fakeProduct{Code: "TSEL10", Price: 11000},
+		&fakeProduct{Code: "TSEL20", Price: 22000},
+	}).Return(nil)
+
+	service := NewBulkUseCase(mockBulkRepo, fakeCatalog())
+
+	result, err := service.AdjustPriceUseCase("fake", dto.BulkPriceAdjustmentDto{Percentage: 5, RoundTo: 500})
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.BulkPriceChange{
+		{Code: "TSEL10", OldPrice: 10500, NewPrice: 11000},
+		{Code: "TSEL20", OldPrice: 21000, NewPrice: 22000},
+	}, result.Changes)
+}
+
+func TestAdjustPriceUseCaseInvalid(t *testing.T) {
+	service := NewBulkUseCase(mocks.NewBulkRepository(t), fakeCatalog())
+
+	_, err := service.AdjustPriceUseCase("fake", dto.BulkPriceAdjustmentDto{})
+	assert.EqualError(t, err, "either percentage or amount is required")
+
+	_, err = service.AdjustPriceUseCase("fake", dto.BulkPriceAdjustmentDto{Percentage: 5, Amount: 500})
+	assert.EqualError(t, err, "either percentage or amount is required")
+
+	_, err = service.AdjustPriceUseCase("fake", dto.BulkPriceAdjustmentDto{Amount: -500, DryRun: true})
+	assert.EqualError(t, err, "price of TSEL10 would drop below its cost price 10200")
+}
@@ -0,0 +1,49 @@
+package bulk
+
+import "strconv"
+
+// Catalog describes a table admins can download and upload as a sheet.
+// Uploads must have every column of Columns; cells are taken as they are,
+// except an empty is_active, which keeps a product's current state.
+type Catalog struct {
+	Code    string
+	Columns []string
+	// Key are the columns that identify a record. Rows matching an existing
+	// record update it and an upload cannot hold the same key twice.
+	Key []string
+	// Export returns every record as a row of Columns.
+	Export func() ([][]string, error)
+	// Parser loads what rows are checked against and returns the function
+	// that turns a row into the model pointer to save.
+	Parser func() (Parser, error)
+	// Prices lists the products of a provider for bulk price adjustment. It
+	// is nil for catalogs without sell prices.
+	Prices func(provider string) ([]PricedItem, error)
+}
+
+// Parser turns a row into the record to save and reports whether it updates
+// an existing record. Problems are recorded on the row.
+type Parser func(row *Row) (record interface{}, update bool)
+
+// PricedItem is a product whose sell price can be adjusted in bulk.
+type PricedItem struct {
+	Code      string
+	Name      string
+	Price     float64
+	CostPrice float64
+	// WithPrice returns the record to save with its sell price set to price.
+	WithPrice func(price float64) interface{}
+}
+
+func FormatFloat(value float64) string {
+	return strconv.FormatFloat(value, 'f', -1, 64)
+}
+
+func FormatInt(value int) string {
+	return strconv.Itoa(value)
+}
+
+// FormatBool formats an is_active flag; nil is active.
+func FormatBool(value *bool) string {
+	return strconv.FormatBool(value == nil || *value)
+}
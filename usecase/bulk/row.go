@@ -0,0 +1,108 @@
+package bulk
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+// Row is a data row of an uploaded sheet. Its getters record a problem
+// instead of failing, so every problem of a row is reported at once.
+type Row struct {
+	Line   int
+	cells  map[string]string
+	errors []string
+}
+
+func newRow(line int, header, values []string) *Row {
+	row := &Row{Line: line, cells: map[string]string{}}
+	for i, column := range header {
+		if column != "" && i < len(values) {
+			row.cells[column] = strings.TrimSpace(values[i])
+		}
+	}
+	return row
+}
+
+func (r *Row) String(column string) string {
+	return r.cells[column]
+}
+
+// Required returns a cell that must not be empty.
+func (r *Row) Required(column string) string {
+	value := r.cells[column]
+	if value == "" {
+		r.Errorf("%s is required", column)
+	}
+	return value
+}
+
+// Float returns a number cell; empty cells are 0.
+func (r *Row) Float(column string) float64 {
+	value := r.cells[column]
+	if value == "" {
+		return 0
+	}
+
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil {
+		r.Errorf("%s must be a number", column)
+	}
+	return number
+}
+
+// Int returns a whole number cell; empty cells are 0.
+func (r *Row) Int(column string) int {
+	value := r.cells[column]
+	if value == "" {
+		return 0
+	}
+
+	number, err := strconv.Atoi(value)
+	if err != nil {
+		r.Errorf("%s must be a whole number", column)
+	}
+	return number
+}
+
+// Bool returns a true/false cell, or current when the cell is empty.
+func (r *Row) Bool(column string, current *bool) *bool {
+	value := r.cells[column]
+	if value == "" {
+		return current
+	}
+
+	flag, err := strconv.ParseBool(value)
+	if err != nil {
+		r.Errorf("%s must be true or false", column)
+		return current
+	}
+	return &flag
+}
+
+func (r *Row) Error(err error) {
+	if err != nil {
+		r.errors = append(r.errors, err.Error())
+	}
+}
+
+func (r *Row) Errorf(format string, args ...interface{}) {
+	r.errors = append(r.errors, fmt.Sprintf(format, args...))
+}
+
+func (r *Row) empty() bool {
+	for _, value := range r.cells {
+		if value != "" {
+			return false
+		}
+	}
+	return true
+}
+
+func (r *Row) key(columns []string) string {
+	values := make([]string, len(columns))
+	for i, column := range columns {
+		values[i] = strings.ToLower(r.cells[column])
+	}
+	return strings.Join(values, "|")
+}
@@ -0,0 +1,50 @@
+package bulk
+
+import (
+	"BE-Golang/model"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+// ReadSheet reads the rows of an uploaded .csv or .xlsx file. Only the first
+// worksheet of a workbook is read.
+func ReadSheet(filename string, file io.Reader) ([][]string, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case "." + model.BULK_FORMAT_CSV:
+		reader := csv.NewReader(file)
+		reader.FieldsPerRecord = -1
+		rows, err := reader.ReadAll()
+		if err != nil {
+			return nil, fmt.Errorf("invalid csv file: %w", err)
+		}
+		return rows, nil
+	case "." + model.BULK_FORMAT_XLSX:
+		rows, err := readXlsx(file)
+		if err != nil {
+			return nil, fmt.Errorf("invalid xlsx file: %w", err)
+		}
+		return rows, nil
+	}
+
+	return nil, errors.New("file must be a .csv or .xlsx sheet")
+}
+
+// WriteSheet writes rows in format, csv or xlsx.
+func WriteSheet(format string, w io.Writer, rows [][]string) error {
+	switch format {
+	case model.BULK_FORMAT_CSV:
+		writer := csv.NewWriter(w)
+		if err := writer.WriteAll(rows); err != nil {
+			return err
+		}
+		return writer.Error()
+	case model.BULK_FORMAT_XLSX:
+		return writeXlsx(w, rows)
+	}
+
+	return fmt.Errorf("format must be %s or %s", model.BULK_FORMAT_CSV, model.BULK_FORMAT_XLSX)
+}
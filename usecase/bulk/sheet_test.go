@@ -0,0 +1,89 @@
+package bulk
+
+import (
+	"BE-Golang/model"
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestWriteAndReadXlsx(t *testing.T) {
+	rows := [][]string{
+		{"code", "name", "price"},
+		{"TSEL10", "Telkomsel <10K> & more", "10500"},
+		{"014", "", "1444.7"},
+	}
+
+	var sheet bytes.Buffer
+	assert.NoError(t, WriteSheet(model.BULK_FORMAT_XLSX, &sheet, rows))
+	assert.Contains(t, sheet.String(), "PK")
+
+	result, err := ReadSheet("catalog.XLSX", &sheet)
+
+	assert.NoError(t, err)
+	assert.Equal(t, rows, result)
+}
+
+func TestReadSheetCSV(t *testing.T) {
+	result, err := ReadSheet("catalog.csv", strings.NewReader("code,name\nTSEL10\n"))
+
+	assert.NoError(t, err)
+	assert.Equal(t, [][]string{{"code", "name"}, {"TSEL10"}}, result)
+}
+
+func TestReadSheetInvalid(t *testing.T) {
+	_, err := ReadSheet("catalog.json", strings.NewReader("[]"))
+	assert.EqualError(t, err, "file must be a .csv or .xlsx sheet")
+
+	_, err = ReadSheet("catalog.xlsx", strings.NewReader("code,name"))
+	assert.Error(t, err)
+
+	assert.EqualError(t, WriteSheet("pdf", &bytes.Buffer{}, nil), "format must be csv or xlsx")
+}
+
+func TestXlsxColumn(t *testing.T) {
+	for index, name := range map[int]string{0: "A", 25: "Z", 26: "AA", 27: "AB", 701: "ZZ", 702: "AAA"} {
+		assert.Equal(t, name, xlsxColumnName(index))
+
+		column, err := xlsxColumn(name + "12")
+		assert.NoError(t, err)
+		assert.Equal(t, index, column)
+	}
+}
+
+func TestXlsxColumnLimit(t *testing.T) {
+	column, err := xlsxColumn("XFD1")
+	assert.NoError(t, err)
+	assert.Equal(t, maxXlsxColumns-1, column)
+
+	_, err = xlsxColumn("XFE1")
+	assert.EqualError(t, err, "cell XFE1 is past column XFD")
+
+	_, err = xlsxColumn("ZZZZZZZZZZZZZZZZ1")
+	assert.Error(t, err)
+}
+
+func TestReadXlsxRowLimit(t *testing.T) {
+	rows := make([][]string, maxRows+2)
+	for i := range rows {
+		rows[i] = []string{"TSEL10"}
+	}
+	var sheet bytes.Buffer
+	assert.NoError(t, WriteSheet(model.BULK_FORMAT_XLSX, &sheet, rows))
+
+	_, err := ReadSheet("catalog.xlsx", &sheet)
+
+	assert.EqualError(t, err, "invalid xlsx file: sheet has more than 5000 rows")
+}
+
+func TestReadXlsxPartLimit(t *testing.T) {
+	var sheet bytes.Buffer
+	assert.NoError(t, WriteSheet(model.BULK_FORMAT_XLSX, &sheet, [][]string{{strings.Repeat("a", maxXlsxPartSize)}}))
+	assert.Less(t, sheet.Len(), maxXlsxSize)
+
+	_, err := ReadSheet("catalog.xlsx", &sheet)
+
+	assert.EqualError(t, err, "invalid xlsx file: error reading xl/worksheets/sheet1.xml: xl/worksheets/sheet1.xml is larger than 32 MB uncompressed")
+}
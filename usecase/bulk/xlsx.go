@@ -0,0 +1,356 @@
+package bulk
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math"
+	"path"
+	"strconv"
+	"strings"
+)
+
+// The catalogs only need plain cell values, so workbooks are read and
+// written here with archive/zip and encoding/xml instead of a spreadsheet
+// library.
+
+const (
+	maxXlsxSize = 10 << 20
+	// maxXlsxPartSize caps each uncompressed part, as XML compresses well
+	// enough for a small upload to unpack into gigabytes.
+	maxXlsxPartSize = 32 << 20
+	// maxXlsxColumns is the column count of a spreadsheet, A to XFD.
+	maxXlsxColumns = 16384
+	// maxXlsxCells caps the cells of a sheet, counting the empty cells
+	// before the last one of each row.
+	maxXlsxCells = 1 << 20
+)
+
+type xlsxText struct {
+	Text string `xml:"t"`
+	Runs []struct {
+		Text string `xml:"t"`
+	} `xml:"r"`
+}
+
+func (t xlsxText) String() string {
+	if len(t.Runs) == 0 {
+		return t.Text
+	}
+
+	var text strings.Builder
+	for _, run := range t.Runs {
+		text.WriteString(run.Text)
+	}
+	return text.String()
+}
+
+type xlsxWorkbook struct {
+	Sheets []struct {
+		RelationshipID string `xml:"http://schemas.openxmlformats.org/officeDocument/2006/relationships id,attr"`
+	} `xml:"sheets>sheet"`
+}
+
+type xlsxRelationships struct {
+	Relationships []struct {
+		ID     string `xml:"Id,attr"`
+		Target string `xml:"Target,attr"`
+	} `xml:"Relationship"`
+}
+
+type xlsxSharedStrings struct {
+	Items []xlsxText `xml:"si"`
+}
+
+type xlsxRow struct {
+	Cells []struct {
+		Ref    string   `xml:"r,attr"`
+		Type   string   `xml:"t,attr"`
+		Value  string   `xml:"v"`
+		Inline xlsxText `xml:"is"`
+	} `xml:"c"`
+}
+
+func readXlsx(file io.Reader) ([][]string, error) {
+	data, err := ioutil.ReadAll(io.LimitReader(file, maxXlsxSize+1))
+	if err != nil {
+		return nil, err
+	}
+	if len(data) > maxXlsxSize {
+		return nil, errors.New("file is larger than 10 MB")
+	}
+
+	archive, err := zip.NewReader(bytes.NewReader(data), int64(len(data)))
+	if err != nil {
+		return nil, err
+	}
+	files := map[string]*zip.File{}
+	for _, f := range archive.File {
+		files[f.Name] = f
+	}
+
+	sheetPath, err := firstSheetPath(files)
+	if err != nil {
+		return nil, err
+	}
+
+	var shared xlsxSharedStrings
+	if _, ok := files["xl/sharedStrings.xml"]; ok {
+		if err := decodeXlsxPart(files, "xl/sharedStrings.xml", &shared); err != nil {
+			return nil, err
+		}
+	}
+
+	part, err := openXlsxPart(files, sheetPath)
+	if err != nil {
+		return nil, err
+	}
+	defer part.Close()
+
+	// Rows are decoded one at a time, so a sheet over the row limit is
+	// rejected without reading the rest of it.
+	var rows [][]string
+	cells := 0
+	decoder := xml.NewDecoder(part)
+	for {
+		token, err := decoder.Token()
+		if err == io.EOF {
+			break
+		} else if err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", sheetPath, err)
+		}
+		start, ok := token.(xml.StartElement)
+		if !ok || start.Name.Local != "row" {
+			continue
+		}
+		if len(rows) > maxRows {
+			return nil, fmt.Errorf("sheet has more than %d rows", maxRows)
+		}
+
+		var sheetRow xlsxRow
+		if err := decoder.DecodeElement(&sheetRow, &start); err != nil {
+			return nil, fmt.Errorf("error reading %s: %w", sheetPath, err)
+		}
+		row, err := xlsxRowValues(sheetRow, shared)
+		if err != nil {
+			return nil, err
+		}
+		if cells += len(row); cells > maxXlsxCells {
+			return nil, fmt.Errorf("sheet has more than %d cells", maxXlsxCells)
+		}
+		rows = append(rows, row)
+	}
+
+	return rows, nil
+}
+
+func xlsxRowValues(sheetRow xlsxRow, shared xlsxSharedStrings) ([]string, error) {
+	var row []string
+	for i, cell := range sheetRow.Cells {
+		column := i
+		if cell.Ref != "" {
+			var err error
+			if column, err = xlsxColumn(cell.Ref); err != nil {
+				return nil, err
+			}
+		}
+		if column >= maxXlsxColumns {
+			return nil, fmt.Errorf("cell %s is past column XFD", cell.Ref)
+		}
+		for len(row) <= column {
+			row = append(row, "")
+		}
+
+		switch cell.Type {
+		case "s":
+			index, err := strconv.Atoi(cell.Value)
+			if err != nil || index < 0 || index >= len(shared.Items) {
+				return nil, fmt.Errorf("cell %s has an invalid shared string", cell.Ref)
+			}
+			row[column] = shared.Items[index].String()
+		case "inlineStr":
+			row[column] = cell.Inline.String()
+		default:
+			row[column] = cell.Value
+		}
+	}
+
+	return row, nil
+}
+
+// firstSheetPath finds the part of the workbook's first worksheet.
+func firstSheetPath(files map[string]*zip.File) (string, error) {
+	var workbook xlsxWorkbook
+	if err := decodeXlsxPart(files, "xl/workbook.xml", &workbook); err != nil {
+		return "", err
+	}
+	if len(workbook.Sheets) == 0 {
+		return "", errors.New("workbook has no sheets")
+	}
+
+	var relationships xlsxRelationships
+	if err := decodeXlsxPart(files, "xl/_rels/workbook.xml.rels", &relationships); err != nil {
+		return "", err
+	}
+	for _, relationship := range relationships.Relationships {
+		if relationship.ID != workbook.Sheets[0].RelationshipID {
+			continue
+		}
+		if strings.HasPrefix(relationship.Target, "/") {
+			return strings.TrimPrefix(relationship.Target, "/"), nil
+		}
+		return path.Join("xl", relationship.Target), nil
+	}
+
+	return "", errors.New("workbook has no sheets")
+}
+
+func decodeXlsxPart(files map[string]*zip.File, name string, v interface{}) error {
+	part, err := openXlsxPart(files, name)
+	if err != nil {
+		return err
+	}
+	defer part.Close()
+
+	if err := xml.NewDecoder(part).Decode(v); err != nil {
+		return fmt.Errorf("error reading %s: %w", name, err)
+	}
+	return nil
+}
+
+// openXlsxPart opens a part of the workbook that fails to read past
+// maxXlsxPartSize.
+func openXlsxPart(files map[string]*zip.File, name string) (*xlsxPart, error) {
+	f, ok := files[name]
+	if !ok {
+		return nil, fmt.Errorf("workbook has no %s", name)
+	}
+
+	part, err := f.Open()
+	if err != nil {
+		return nil, err
+	}
+
+	return &xlsxPart{
+		Reader: io.LimitReader(part, maxXlsxPartSize+1),
+		Closer: part,
+		name:   name,
+		left:   maxXlsxPartSize,
+	}, nil
+}
+
+type xlsxPart struct {
+	io.Reader
+	io.Closer
+	name string
+	left int64
+}
+
+func (p *xlsxPart) Read(b []byte) (int, error) {
+	n, err := p.Reader.Read(b)
+	if p.left -= int64(n); p.left < 0 {
+		return n, fmt.Errorf("%s is larger than %d MB uncompressed", p.name, maxXlsxPartSize>>20)
+	}
+	return n, err
+}
+
+// xlsxColumn turns the column letters of a cell reference, e.g. "AB" of
+// "AB12", into a zero-based index.
+func xlsxColumn(ref string) (int, error) {
+	column := 0
+	letters := 0
+	for _, r := range ref {
+		if r < 'A' || r > 'Z' {
+			break
+		}
+		column = column*26 + int(r-'A'+1)
+		letters++
+		if column > maxXlsxColumns {
+			return 0, fmt.Errorf("cell %s is past column XFD", ref)
+		}
+	}
+	if letters == 0 {
+		return 0, fmt.Errorf("invalid cell reference %q", ref)
+	}
+
+	return column - 1, nil
+}
+
+func xlsxColumnName(index int) string {
+	name := ""
+	for index++; index > 0; index = (index - 1) / 26 {
+		name = string(rune('A'+(index-1)%26)) + name
+	}
+	return name
+}
+
+var xlsxStaticParts = map[string]string{
+	"[Content_Types].xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Types xmlns="http://schemas.openxmlformats.org/package/2006/content-types"><Default Extension="rels" ContentType="application/vnd.openxmlformats-package.relationships+xml"/><Default Extension="xml" ContentType="application/xml"/><Override PartName="/xl/workbook.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.sheet.main+xml"/><Override PartName="/xl/worksheets/sheet1.xml" ContentType="application/vnd.openxmlformats-officedocument.spreadsheetml.worksheet+xml"/></Types>`,
+	"_rels/.rels": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/officeDocument" Target="xl/workbook.xml"/></Relationships>`,
+	"xl/workbook.xml": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<workbook xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main" xmlns:r="http://schemas.openxmlformats.org/officeDocument/2006/relationships"><sheets><sheet name="Sheet1" sheetId="1" r:id="rId1"/></sheets></workbook>`,
+	"xl/_rels/workbook.xml.rels": `<?xml version="1.0" encoding="UTF-8" standalone="yes"?>
+<Relationships xmlns="http://schemas.openxmlformats.org/package/2006/relationships"><Relationship Id="rId1" Type="http://schemas.openxmlformats.org/officeDocument/2006/relationships/worksheet" Target="worksheets/sheet1.xml"/></Relationships>`,
+}
+
+// writeXlsx writes rows as a single-sheet workbook. Values that are plain
+// numbers are written as number cells so they can be edited as numbers;
+// everything else, including codes with leading zeros, stays text.
+func writeXlsx(w io.Writer, rows [][]string) error {
+	archive := zip.NewWriter(w)
+
+	for _, name := range []string{"[Content_Types].xml", "_rels/.rels", "xl/workbook.xml", "xl/_rels/workbook.xml.rels"} {
+		part, err := archive.Create(name)
+		if err != nil {
+			return err
+		}
+		if _, err := io.WriteString(part, xlsxStaticParts[name]); err != nil {
+			return err
+		}
+	}
+
+	var sheet bytes.Buffer
+	sheet.WriteString(`<?xml version="1.0" encoding="UTF-8" standalone="yes"?>` + "\n")
+	sheet.WriteString(`<worksheet xmlns="http://schemas.openxmlformats.org/spreadsheetml/2006/main"><sheetData>`)
+	for i, row := range rows {
+		fmt.Fprintf(&sheet, `<row r="%d">`, i+1)
+		for j, value := range row {
+			ref := fmt.Sprintf("%s%d", xlsxColumnName(j), i+1)
+			if isXlsxNumber(value) {
+				fmt.Fprintf(&sheet, `<c r="%s"><v>%s</v></c>`, ref, value)
+				continue
+			}
+			fmt.Fprintf(&sheet, `<c r="%s" t="inlineStr"><is><t xml:space="preserve">`, ref)
+			if err := xml.EscapeText(&sheet, []byte(value)); err != nil {
+				return err
+			}
+			sheet.WriteString(`</t></is></c>`)
+		}
+		sheet.WriteString(`</row>`)
+	}
+	sheet.WriteString(`</sheetData></worksheet>`)
+
+	part, err := archive.Create("xl/worksheets/sheet1.xml")
+	if err != nil {
+		return err
+	}
+	if _, err := part.Write(sheet.Bytes()); err != nil {
+		return err
+	}
+
+	return archive.Close()
+}
+
+func isXlsxNumber(value string) bool {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || math.IsNaN(number) || math.IsInf(number, 0) {
+		return false
+	}
+	return strconv.FormatFloat(number, 'f', -1, 64) == value
+}
@@ -0,0 +1,281 @@
+package cart
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/notification"
+	"BE-Golang/usecase/users"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+type CartUseCase interface {
+	GetCartUseCase(userID string) (*model.Cart, error)
+	AddCartItemUseCase(userID string, payload dto.CartItemDto) (*model.Cart, error)
+	RemoveCartItemUseCase(userID, itemID string) (*model.Cart, error)
+	CheckoutCartUseCase(userID string, payload dto.CartCheckoutDto) (*model.Cart, error)
+}
+
+type cartUseCase struct {
+	cartRepository        repository.CartRepository
+	transactionRepository repository.TransactionRepository
+	userRepository        repository.UserRepository
+	billerUseCase         biller.BillerUseCase
+	notificationUseCase   notification.NotificationUseCase
+}
+
+func NewCartUseCase(cartRepository repository.CartRepository, transactionRepository repository.TransactionRepository, userRepository repository.UserRepository, billerUseCase biller.BillerUseCase, notificationUseCase notification.NotificationUseCase) *cartUseCase {
+	return &cartUseCase{
+		cartRepository:        cartRepository,
+		transactionRepository: transactionRepository,
+		userRepository:        userRepository,
+		billerUseCase:         billerUseCase,
+		notificationUseCase:   notificationUseCase,
+	}
+}
+
+func (uc *cartUseCase) GetCartUseCase(userID string) (*model.Cart, error) {
+	return uc.getOpenCart(userID)
+}
+
+func (uc *cartUseCase) AddCartItemUseCase(userID string, payload dto.CartItemDto) (*model.Cart, error) {
+	if payload.TransactionID == "" {
+		return nil, errors.New("transaction_id is required")
+	}
+
+	product, err := uc.billerUseCase.GetProductByTransactionIdUseCase(payload.TransactionID)
+	if err != nil || product.Prepaid {
+		return nil, errors.New("only bill transactions can be added to the cart")
+	}
+
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(payload.TransactionID)
+	if err != nil || transaction.UserID != userID {
+		return nil, fmt.Errorf("transaction with ID %s not found", payload.TransactionID)
+	}
+	if transaction.Status != model.STATUS_UNPAID {
+		return nil, errors.New("only unpaid transactions can be added to the cart")
+	}
+
+	cart, err := uc.getOpenCart(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, item := range cart.Items {
+		if item.TransactionID == transaction.ID {
+			return nil, errors.New("transaction is already in the cart")
+		}
+	}
+
+	item := model.CartItem{
+		CartID:        cart.ID,
+		TransactionID: transaction.ID,
+		Category:      product.Category,
+		Description:   transaction.Description,
+		Price:         transaction.Price,
+		DiscountPrice: transaction.DiscountPrice,
+		Status:        model.STATUS_UNPAID,
+	}
+	if _, err := uc.cartRepository.CreateCartItemRepository(&item); err != nil {
+		return nil, err
+	}
+	cart.Items = append(cart.Items, item)
+
+	return uc.saveQuote(cart)
+}
+
+func (uc *cartUseCase) RemoveCartItemUseCase(userID, itemID string) (*model.Cart, error) {
+	cart, err := uc.getOpenCart(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := uc.cartRepository.DeleteCartItemRepository(cart.ID, itemID); err != nil {
+		return nil, err
+	}
+
+	items := make([]model.CartItem, 0, len(cart.Items))
+	for _, item := range cart.Items {
+		if item.ID != itemID {
+			items = append(items, item)
+		}
+	}
+	cart.Items = items
+
+	return uc.saveQuote(cart)
+}
+
+func (uc *cartUseCase) CheckoutCartUseCase(userID string, payload dto.CartCheckoutDto) (*model.Cart, error) {
+	cart, err := uc.cartRepository.GetOpenCartByUserIdRepository(userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart == nil || len(cart.Items) == 0 {
+		return nil, errors.New("cart is empty")
+	}
+
+	user, err := uc.userRepository.GetUserByIDRepository(userID)
+	if err != nil {
+		return nil, err
+	}
+	if !users.CompareHashPin(user.Pin, payload.Pin) {
+		return nil, errors.New("invalid PIN")
+	}
+
+	// Refresh every item so the quote reflects the bills as they are now.
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		transaction, err := uc.transactionRepository.GetTransactionByIdRepository(item.TransactionID)
+		if err != nil || transaction.UserID != userID || transaction.Status != model.STATUS_UNPAID {
+			return nil, fmt.Errorf("transaction %s is no longer payable, remove it from the cart", item.TransactionID)
+		}
+		item.Price = transaction.Price
+		item.DiscountPrice = transaction.DiscountPrice
+	}
+	applyQuote(cart)
+
+	if user.Amount < cart.TotalPrice {
+		return nil, users.ErrBalanceNotEnough
+	}
+
+	// Only one checkout of a cart gets past this point.
+	claimed, err := uc.cartRepository.UpdateCartStatusRepository(cart.ID, model.CART_STATUS_OPEN, model.CART_STATUS_CHECKING_OUT)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		return nil, errors.New("cart is already being checked out")
+	}
+
+	if err := uc.userRepository.DebitUserAmountRepository(userID, cart.TotalPrice); err != nil {
+		if _, reopenErr := uc.cartRepository.UpdateCartStatusRepository(cart.ID, model.CART_STATUS_CHECKING_OUT, model.CART_STATUS_OPEN); reopenErr != nil {
+			log.Printf("cart %s: failed to reopen: %v", cart.ID, reopenErr)
+		}
+		return nil, err
+	}
+
+	now := time.Now()
+	paid := 0
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		_, err := uc.billerUseCase.SettleBillUseCase(user, item.TransactionID, item.AdminFee, item.TotalPrice)
+		if err != nil {
+			item.Status = model.STATUS_FAIL
+			item.Error = err.Error()
+			cart.RefundAmount += item.TotalPrice
+			continue
+		}
+
+		item.Status = model.STATUS_SUCCESSFUL
+		paid++
+	}
+
+	if cart.RefundAmount > 0 {
+		if err := uc.userRepository.CreditUserAmountRepository(userID, cart.RefundAmount); err != nil {
+			log.Printf("cart %s: failed to refund %.2f: %v", cart.ID, cart.RefundAmount, err)
+		}
+	}
+
+	switch {
+	case paid == len(cart.Items):
+		cart.Status = model.CART_STATUS_COMPLETED
+	case paid == 0:
+		cart.Status = model.CART_STATUS_FAILED
+	default:
+		cart.Status = model.CART_STATUS_PARTIALLY_COMPLETED
+	}
+	cart.PaidAt = &now
+
+	for i := range cart.Items {
+		if _, err := uc.cartRepository.UpdateCartItemRepository(&cart.Items[i]); err != nil {
+			log.Printf("cart %s: failed to save item %s: %v", cart.ID, cart.Items[i].ID, err)
+		}
+	}
+	if _, err := uc.cartRepository.UpdateCartRepository(cart); err != nil {
+		return nil, err
+	}
+
+	uc.notify(cart, paid)
+
+	return cart, nil
+}
+
+func (uc *cartUseCase) getOpenCart(userID string) (*model.Cart, error) {
+	cart, err := uc.cartRepository.GetOpenCartByUserIdRepository(userID)
+	if err != nil {
+		return nil, err
+	}
+	if cart != nil {
+		applyQuote(cart)
+		return cart, nil
+	}
+
+	return uc.cartRepository.CreateCartRepository(&model.Cart{
+		UserID: userID,
+		Status: model.CART_STATUS_OPEN,
+		Items:  []model.CartItem{},
+	})
+}
+
+func (uc *cartUseCase) saveQuote(cart *model.Cart) (*model.Cart, error) {
+	applyQuote(cart)
+
+	for i := range cart.Items {
+		if _, err := uc.cartRepository.UpdateCartItemRepository(&cart.Items[i]); err != nil {
+			return nil, err
+		}
+	}
+
+	return uc.cartRepository.UpdateCartRepository(cart)
+}
+
+func (uc *cartUseCase) notify(cart *model.Cart, paid int) {
+	message := fmt.Sprintf("%d dari %d tagihan berhasil dibayar. Total Rp%.0f.", paid, len(cart.Items), cart.TotalPrice-cart.RefundAmount)
+	if cart.RefundAmount > 0 {
+		message += fmt.Sprintf(" Rp%.0f telah dikembalikan ke saldo Anda.", cart.RefundAmount)
+	}
+
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_CART,
+		Title:    "Pembayaran Keranjang",
+		Message:  message,
+	}
+	if err := uc.notificationUseCase.SendNotificationUseCase(cart.UserID, notification); err != nil {
+		log.Printf("cart %s: failed to notify user: %v", cart.ID, err)
+	}
+}
+
+// applyQuote totals the cart. A single admin fee is charged for the whole
+// cart and split across the items, with the remainder on the first item.
+func applyQuote(cart *model.Cart) {
+	cart.Price = 0
+	cart.DiscountPrice = 0
+	cart.AdminFee = 0
+	cart.TotalPrice = 0
+
+	if len(cart.Items) == 0 {
+		return
+	}
+
+	count := float64(len(cart.Items))
+	share := float64(int(model.ADMIN_FEE / count))
+	remainder := model.ADMIN_FEE - share*count
+
+	for i := range cart.Items {
+		item := &cart.Items[i]
+		item.AdminFee = share
+		if i == 0 {
+			item.AdminFee += remainder
+		}
+		item.TotalPrice = item.Price - item.DiscountPrice + item.AdminFee
+
+		cart.Price += item.Price
+		cart.DiscountPrice += item.DiscountPrice
+		cart.AdminFee += item.AdminFee
+		cart.TotalPrice += item.TotalPrice
+	}
+}
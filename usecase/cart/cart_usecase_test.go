@@ -0,0 +1,201 @@
+package cart
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/mocks"
+	"BE-Golang/usecase/users"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type CartUseCaseTest struct {
+	suite.Suite
+	cartUseCase         CartUseCase
+	cartRepo            *repoMocks.CartRepository
+	transactionRepo     *repoMocks.TransactionRepository
+	userRepo            *repoMocks.UserRepository
+	billerUseCase       *mocks.BillerUseCase
+	notificationUseCase *mocks.NotificationUseCase
+}
+
+func TestCartUseCase(t *testing.T) {
+	suite.Run(t, new(CartUseCaseTest))
+}
+
+func (m *CartUseCaseTest) SetupTest() {
+	m.cartRepo = &repoMocks.CartRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.userRepo = &repoMocks.UserRepository{}
+	m.billerUseCase = &mocks.BillerUseCase{}
+	m.billerUseCase.On("GetProductByTransactionIdUseCase", "PDAM-1").Return(&biller.Product{Code: model.PRODUCT_PDAM, Category: model.PRODUCT_PDAM}, nil)
+	m.billerUseCase.On("GetProductByTransactionIdUseCase", "PREPAID-1").Return(&biller.Product{Code: "electricity_prepaid", Category: model.PRODUCT_ELECTRICITY, Prepaid: true}, nil)
+	m.billerUseCase.On("GetProductByTransactionIdUseCase", mock.Anything).Return(nil, errors.New("no bill product"))
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.cartUseCase = NewCartUseCase(m.cartRepo, m.transactionRepo, m.userRepo, m.billerUseCase, m.notificationUseCase)
+}
+
+func (m *CartUseCaseTest) openCart(items ...model.CartItem) *model.Cart {
+	cart := &model.Cart{UserID: "user", Status: model.CART_STATUS_OPEN, Items: items}
+	cart.ID = "cart-1"
+	return cart
+}
+
+func unpaidTransaction(id string, price, discount float64) *model.Transaction {
+	return &model.Transaction{
+		ID:            id,
+		UserID:        "user",
+		Status:        model.STATUS_UNPAID,
+		Price:         price,
+		DiscountPrice: discount,
+		ProductType:   "pdam",
+		ProductDetail: map[string]interface{}{"customer_id": "123456", "provider_name": "PDAM", "period": "March-2026"},
+	}
+}
+
+func (m *CartUseCaseTest) TestApplyQuoteSplitsSingleAdminFee() {
+	cart := m.openCart(
+		model.CartItem{Price: 10000},
+		model.CartItem{Price: 20000, DiscountPrice: 1000},
+		model.CartItem{Price: 30000},
+	)
+
+	applyQuote(cart)
+
+	assert.Equal(m.T(), float64(model.ADMIN_FEE), cart.AdminFee)
+	assert.Equal(m.T(), float64(834), cart.Items[0].AdminFee)
+	assert.Equal(m.T(), float64(833), cart.Items[1].AdminFee)
+	assert.Equal(m.T(), float64(60000), cart.Price)
+	assert.Equal(m.T(), float64(1000), cart.DiscountPrice)
+	assert.Equal(m.T(), float64(61500), cart.TotalPrice)
+}
+
+func (m *CartUseCaseTest) TestAddCartItemSuccess() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(unpaidTransaction("PDAM-1", 50000, 0), nil)
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(), nil)
+	m.cartRepo.On("CreateCartItemRepository", mock.Anything).Return(func(item *model.CartItem) *model.CartItem {
+		return item
+	}, nil)
+	m.cartRepo.On("UpdateCartItemRepository", mock.Anything).Return(func(item *model.CartItem) *model.CartItem {
+		return item
+	}, nil)
+	m.cartRepo.On("UpdateCartRepository", mock.Anything).Return(func(cart *model.Cart) *model.Cart {
+		return cart
+	}, nil)
+
+	resp, err := m.cartUseCase.AddCartItemUseCase("user", dto.CartItemDto{TransactionID: "PDAM-1"})
+
+	assert.NoError(m.T(), err)
+	assert.Len(m.T(), resp.Items, 1)
+	assert.Equal(m.T(), model.PRODUCT_PDAM, resp.Items[0].Category)
+	assert.Equal(m.T(), float64(52500), resp.TotalPrice)
+}
+
+func (m *CartUseCaseTest) TestAddCartItemRejectsPaidTransaction() {
+	transaction := unpaidTransaction("PDAM-1", 50000, 0)
+	transaction.Status = model.STATUS_SUCCESSFUL
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(transaction, nil)
+
+	_, err := m.cartUseCase.AddCartItemUseCase("user", dto.CartItemDto{TransactionID: "PDAM-1"})
+
+	assert.EqualError(m.T(), err, "only unpaid transactions can be added to the cart")
+}
+
+func (m *CartUseCaseTest) TestAddCartItemRejectsDuplicate() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(unpaidTransaction("PDAM-1", 50000, 0), nil)
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(model.CartItem{TransactionID: "PDAM-1"}), nil)
+
+	_, err := m.cartUseCase.AddCartItemUseCase("user", dto.CartItemDto{TransactionID: "PDAM-1"})
+
+	assert.EqualError(m.T(), err, "transaction is already in the cart")
+}
+
+func (m *CartUseCaseTest) TestAddCartItemRejectsNonBill() {
+	_, err := m.cartUseCase.AddCartItemUseCase("user", dto.CartItemDto{TransactionID: "PPD-1"})
+
+	assert.EqualError(m.T(), err, "only bill transactions can be added to the cart")
+}
+
+func (m *CartUseCaseTest) TestAddCartItemRejectsPrepaid() {
+	_, err := m.cartUseCase.AddCartItemUseCase("user", dto.CartItemDto{TransactionID: "PREPAID-1"})
+
+	assert.EqualError(m.T(), err, "only bill transactions can be added to the cart")
+}
+
+func (m *CartUseCaseTest) TestCheckoutInvalidPin() {
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(model.CartItem{TransactionID: "PDAM-1"}), nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Pin: users.HashPin("123456")}, nil)
+
+	_, err := m.cartUseCase.CheckoutCartUseCase("user", dto.CartCheckoutDto{Pin: "654321"})
+
+	assert.EqualError(m.T(), err, "invalid PIN")
+}
+
+func (m *CartUseCaseTest) TestCheckoutBalanceNotEnough() {
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(model.CartItem{TransactionID: "PDAM-1"}), nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Pin: users.HashPin("123456"), Amount: 1000}, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(unpaidTransaction("PDAM-1", 50000, 0), nil)
+
+	_, err := m.cartUseCase.CheckoutCartUseCase("user", dto.CartCheckoutDto{Pin: "123456"})
+
+	assert.Equal(m.T(), users.ErrBalanceNotEnough, err)
+}
+
+func (m *CartUseCaseTest) TestCheckoutPartialRefund() {
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(
+		model.CartItem{TransactionID: "PDAM-1", Category: model.PRODUCT_PDAM},
+		model.CartItem{TransactionID: "WIFI-1", Category: model.PRODUCT_WIFI},
+	), nil)
+	user := &model.User{Pin: users.HashPin("123456"), Amount: 100000}
+	m.userRepo.On("GetUserByIDRepository", "user").Return(user, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(unpaidTransaction("PDAM-1", 20000, 0), nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "WIFI-1").Return(unpaidTransaction("WIFI-1", 30000, 0), nil)
+	m.cartRepo.On("UpdateCartStatusRepository", "cart-1", model.CART_STATUS_OPEN, model.CART_STATUS_CHECKING_OUT).Return(true, nil)
+	m.userRepo.On("DebitUserAmountRepository", "user", float64(52500)).Return(nil)
+	m.userRepo.On("CreditUserAmountRepository", "user", float64(31250)).Return(nil)
+	m.billerUseCase.On("SettleBillUseCase", user, "PDAM-1", float64(1250), float64(21250)).Return(&model.Transaction{}, nil)
+	m.billerUseCase.On("SettleBillUseCase", user, "WIFI-1", float64(1250), float64(31250)).Return(nil, errors.New("transaction is no longer unpaid"))
+	m.cartRepo.On("UpdateCartItemRepository", mock.Anything).Return(&model.CartItem{}, nil)
+	m.cartRepo.On("UpdateCartRepository", mock.Anything).Return(func(cart *model.Cart) *model.Cart {
+		return cart
+	}, nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	resp, err := m.cartUseCase.CheckoutCartUseCase("user", dto.CartCheckoutDto{Pin: "123456"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.CART_STATUS_PARTIALLY_COMPLETED, resp.Status)
+	assert.Equal(m.T(), model.STATUS_SUCCESSFUL, resp.Items[0].Status)
+	assert.Equal(m.T(), model.STATUS_FAIL, resp.Items[1].Status)
+	assert.Equal(m.T(), float64(31250), resp.RefundAmount)
+	m.userRepo.AssertCalled(m.T(), "DebitUserAmountRepository", "user", float64(52500))
+	m.userRepo.AssertCalled(m.T(), "CreditUserAmountRepository", "user", float64(31250))
+	m.billerUseCase.AssertNumberOfCalls(m.T(), "SettleBillUseCase", 2)
+}
+
+func (m *CartUseCaseTest) TestCheckoutAlreadyCheckingOut() {
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(m.openCart(model.CartItem{TransactionID: "PDAM-1"}), nil)
+	m.userRepo.On("GetUserByIDRepository", "user").Return(&model.User{Pin: users.HashPin("123456"), Amount: 100000}, nil)
+	m.transactionRepo.On("GetTransactionByIdRepository", "PDAM-1").Return(unpaidTransaction("PDAM-1", 20000, 0), nil)
+	m.cartRepo.On("UpdateCartStatusRepository", "cart-1", model.CART_STATUS_OPEN, model.CART_STATUS_CHECKING_OUT).Return(false, nil)
+
+	_, err := m.cartUseCase.CheckoutCartUseCase("user", dto.CartCheckoutDto{Pin: "123456"})
+
+	assert.EqualError(m.T(), err, "cart is already being checked out")
+	m.userRepo.AssertNotCalled(m.T(), "DebitUserAmountRepository", mock.Anything, mock.Anything)
+	m.billerUseCase.AssertNotCalled(m.T(), "SettleBillUseCase", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *CartUseCaseTest) TestCheckoutEmptyCart() {
+	m.cartRepo.On("GetOpenCartByUserIdRepository", "user").Return(nil, nil)
+
+	_, err := m.cartUseCase.CheckoutCartUseCase("user", dto.CartCheckoutDto{Pin: "123456"})
+
+	assert.EqualError(m.T(), err, "cart is empty")
+}
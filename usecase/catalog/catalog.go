@@ -0,0 +1,16 @@
+package catalog
+
+import "BE-Golang/model"
+
+// Catalog describes a product table the catalog sync reconciles with a
+// provider price list. Products are matched to the price list by code.
+type Catalog struct {
+	// Code identifies the catalog in routes and on price reviews, e.g. "ppd".
+	Code string
+	// Items returns every product of the catalog, active or not.
+	Items func() ([]model.CatalogItem, error)
+	// Deactivate hides a product the provider no longer sells.
+	Deactivate func(id string) error
+	// SetPrice stores the cost price and sell price of a product.
+	SetPrice func(id string, costPrice, sellPrice float64) error
+}
@@ -0,0 +1,325 @@
+package catalog
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"strings"
+	"time"
+)
+
+const (
+	SOURCE_PROVIDER = "provider"
+	SOURCE_IMPORT   = "import"
+)
+
+type CatalogSyncUseCase interface {
+	SyncCatalogUseCase(code string, dryRun bool) (*model.CatalogSyncResult, error)
+	ImportPriceListUseCase(code, filename string, file io.Reader, dryRun bool) (*model.CatalogSyncResult, error)
+	RunCatalogSyncUseCase(now time.Time) error
+	GetAllPriceReviewUseCase(catalog, status string, page, limit int) ([]*model.CatalogPriceReview, error)
+	ApprovePriceReviewUseCase(id string, sellPrice float64) (*model.CatalogPriceReview, error)
+	RejectPriceReviewUseCase(id string) (*model.CatalogPriceReview, error)
+}
+
+type catalogSyncUseCase struct {
+	priceReviewRepository repository.CatalogPriceReviewRepository
+	priceListRepository   repository.PriceListProviderRepository
+	catalogs              map[string]*Catalog
+	codes                 []string
+}
+
+func NewCatalogSyncUseCase(priceReviewRepository repository.CatalogPriceReviewRepository, priceListRepository repository.PriceListProviderRepository, catalogs ...*Catalog) *catalogSyncUseCase {
+	uc := &catalogSyncUseCase{
+		priceReviewRepository: priceReviewRepository,
+		priceListRepository:   priceListRepository,
+		catalogs:              map[string]*Catalog{},
+	}
+	for _, catalog := range catalogs {
+		uc.catalogs[catalog.Code] = catalog
+		uc.codes = append(uc.codes, catalog.Code)
+	}
+
+	return uc
+}
+
+func (uc *catalogSyncUseCase) SyncCatalogUseCase(code string, dryRun bool) (*model.CatalogSyncResult, error) {
+	catalog, err := uc.catalog(code)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := uc.priceListRepository.GetPriceListRepository(catalog.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.sync(catalog, prices, SOURCE_PROVIDER, dryRun)
+}
+
+func (uc *catalogSyncUseCase) ImportPriceListUseCase(code, filename string, file io.Reader, dryRun bool) (*model.CatalogSyncResult, error) {
+	catalog, err := uc.catalog(code)
+	if err != nil {
+		return nil, err
+	}
+
+	prices, err := ParsePriceList(filename, file)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.sync(catalog, prices, SOURCE_IMPORT, dryRun)
+}
+
+// RunCatalogSyncUseCase pulls the provider price list of every catalog and
+// syncs the catalog with it.
+func (uc *catalogSyncUseCase) RunCatalogSyncUseCase(now time.Time) error {
+	failed := 0
+	for _, code := range uc.codes {
+		result, err := uc.SyncCatalogUseCase(code, false)
+		if err != nil {
+			log.Printf("catalog sync %s: %v", code, err)
+			failed++
+			continue
+		}
+		log.Printf("catalog sync %s at %s: %d unchanged, %d deactivated, %d cost priced, %d price reviews, %d new",
+			code, now.Format(time.RFC3339), result.Unchanged, len(result.Deactivated), len(result.CostPriced), len(result.PriceReviews), len(result.New))
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to sync %d of %d catalogs", failed, len(uc.codes))
+	}
+
+	return nil
+}
+
+func (uc *catalogSyncUseCase) GetAllPriceReviewUseCase(catalog, status string, page, limit int) ([]*model.CatalogPriceReview, error) {
+	return uc.priceReviewRepository.GetAllPriceReviewRepository(catalog, status, page, limit)
+}
+
+// ApprovePriceReviewUseCase applies the new cost price of a review together
+// with sellPrice. A sellPrice of 0 keeps the product's current sell price.
+func (uc *catalogSyncUseCase) ApprovePriceReviewUseCase(id string, sellPrice float64) (*model.CatalogPriceReview, error) {
+	review, err := uc.pendingReview(id)
+	if err != nil {
+		return nil, err
+	}
+
+	catalog, err := uc.catalog(review.Catalog)
+	if err != nil {
+		return nil, err
+	}
+
+	if sellPrice < 0 {
+		return nil, errors.New("sell price cannot be negative")
+	}
+	if sellPrice == 0 {
+		item, err := findItem(catalog, review.ProductID)
+		if err != nil {
+			return nil, err
+		}
+		sellPrice = item.SellPrice
+	}
+	if sellPrice < review.NewCostPrice {
+		return nil, fmt.Errorf("sell price %.0f is below the cost price %.0f", sellPrice, review.NewCostPrice)
+	}
+
+	if err := catalog.SetPrice(review.ProductID, review.NewCostPrice, sellPrice); err != nil {
+		return nil, err
+	}
+
+	review.Status = model.PRICE_REVIEW_APPROVED
+	review.ApprovedSellPrice = sellPrice
+	return uc.priceReviewRepository.UpdatePriceReviewByIdRepository(review.ID, review)
+}
+
+// RejectPriceReviewUseCase keeps the product's prices as they are. The same
+// cost price is not flagged again by later syncs.
+func (uc *catalogSyncUseCase) RejectPriceReviewUseCase(id string) (*model.CatalogPriceReview, error) {
+	review, err := uc.pendingReview(id)
+	if err != nil {
+		return nil, err
+	}
+
+	review.Status = model.PRICE_REVIEW_REJECTED
+	return uc.priceReviewRepository.UpdatePriceReviewByIdRepository(review.ID, review)
+}
+
+func (uc *catalogSyncUseCase) catalog(code string) (*Catalog, error) {
+	catalog, ok := uc.catalogs[code]
+	if !ok {
+		return nil, fmt.Errorf("catalog %s not found", code)
+	}
+
+	return catalog, nil
+}
+
+func (uc *catalogSyncUseCase) pendingReview(id string) (*model.CatalogPriceReview, error) {
+	review, err := uc.priceReviewRepository.GetPriceReviewByIdRepository(id)
+	if err != nil {
+		return nil, err
+	}
+	if review.Status != model.PRICE_REVIEW_PENDING {
+		return nil, fmt.Errorf("price review is already %s", review.Status)
+	}
+
+	return review, nil
+}
+
+// sync reconciles a catalog with a price list. Products the provider no
+// longer sells are deactivated, products without a cost price get one, and
+// cost price changes are held as price reviews so sell prices are only ever
+// changed by an admin. A dry run reports the same result without writing.
+func (uc *catalogSyncUseCase) sync(catalog *Catalog, prices []model.ProviderPrice, source string, dryRun bool) (*model.CatalogSyncResult, error) {
+	listed, err := indexPrices(prices)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := catalog.Items()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &model.CatalogSyncResult{
+		Catalog:      catalog.Code,
+		Source:       source,
+		DryRun:       dryRun,
+		Deactivated:  []string{},
+		CostPriced:   []string{},
+		PriceReviews: []*model.CatalogPriceReview{},
+		New:          []model.ProviderPrice{},
+	}
+
+	known := map[string]bool{}
+	for _, item := range items {
+		known[item.Code] = true
+
+		price, ok := listed[item.Code]
+		if !ok || !available(price) {
+			if !item.IsActive {
+				result.Unchanged++
+				continue
+			}
+			if !dryRun {
+				if err := catalog.Deactivate(item.ID); err != nil {
+					return nil, err
+				}
+			}
+			result.Deactivated = append(result.Deactivated, item.Code)
+			continue
+		}
+
+		switch {
+		case price.CostPrice == item.CostPrice:
+			result.Unchanged++
+		case item.CostPrice == 0:
+			if !dryRun {
+				if err := catalog.SetPrice(item.ID, price.CostPrice, item.SellPrice); err != nil {
+					return nil, err
+				}
+			}
+			result.CostPriced = append(result.CostPriced, item.Code)
+		default:
+			review, err := uc.reviewPriceChange(catalog, item, price.CostPrice, dryRun)
+			if err != nil {
+				return nil, err
+			}
+			if review == nil {
+				result.Unchanged++
+				continue
+			}
+			result.PriceReviews = append(result.PriceReviews, review)
+		}
+	}
+
+	for _, price := range prices {
+		if !known[strings.TrimSpace(price.Code)] && available(price) {
+			result.New = append(result.New, price)
+		}
+	}
+
+	return result, nil
+}
+
+// reviewPriceChange returns the pending review holding a product's new cost
+// price, or nil when an admin has already rejected that cost price.
+func (uc *catalogSyncUseCase) reviewPriceChange(catalog *Catalog, item model.CatalogItem, costPrice float64, dryRun bool) (*model.CatalogPriceReview, error) {
+	latest, err := uc.priceReviewRepository.GetLatestPriceReviewRepository(catalog.Code, item.Code)
+	if err != nil {
+		return nil, err
+	}
+
+	if latest != nil && latest.Status == model.PRICE_REVIEW_PENDING {
+		changed := latest.NewCostPrice != costPrice
+		latest.NewCostPrice = costPrice
+		if !changed || dryRun {
+			return latest, nil
+		}
+		return uc.priceReviewRepository.UpdatePriceReviewByIdRepository(latest.ID, latest)
+	}
+	if latest != nil && latest.Status == model.PRICE_REVIEW_REJECTED && latest.NewCostPrice == costPrice {
+		return nil, nil
+	}
+
+	review := &model.CatalogPriceReview{
+		Catalog:      catalog.Code,
+		ProductID:    item.ID,
+		Code:         item.Code,
+		Name:         item.Name,
+		OldCostPrice: item.CostPrice,
+		NewCostPrice: costPrice,
+		SellPrice:    item.SellPrice,
+		Status:       model.PRICE_REVIEW_PENDING,
+	}
+	if dryRun {
+		return review, nil
+	}
+
+	return uc.priceReviewRepository.CreatePriceReviewRepository(review)
+}
+
+func indexPrices(prices []model.ProviderPrice) (map[string]model.ProviderPrice, error) {
+	if len(prices) == 0 {
+		return nil, errors.New("price list is empty")
+	}
+
+	listed := map[string]model.ProviderPrice{}
+	for _, price := range prices {
+		code := strings.TrimSpace(price.Code)
+		if code == "" {
+			return nil, errors.New("price list has a product without a code")
+		}
+		if price.CostPrice < 0 {
+			return nil, fmt.Errorf("cost price of %s cannot be negative", code)
+		}
+		if _, ok := listed[code]; ok {
+			return nil, fmt.Errorf("price list has product %s more than once", code)
+		}
+		listed[code] = price
+	}
+
+	return listed, nil
+}
+
+func available(price model.ProviderPrice) bool {
+	return price.Available == nil || *price.Available
+}
+
+func findItem(catalog *Catalog, id string) (model.CatalogItem, error) {
+	items, err := catalog.Items()
+	if err != nil {
+		return model.CatalogItem{}, err
+	}
+
+	for _, item := range items {
+		if item.ID == id {
+			return item, nil
+		}
+	}
+
+	return model.CatalogItem{}, fmt.Errorf("product %s of catalog %s not found", id, catalog.Code)
+}
@@ -0,0 +1,223 @@
+package catalog
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+// fakeCatalog keeps catalog rows in memory and records every write.
+type fakeCatalog struct {
+	items       []model.CatalogItem
+	deactivated []string
+	prices      map[string][2]float64
+}
+
+func (f *fakeCatalog) catalog() *Catalog {
+	f.prices = map[string][2]float64{}
+	return &Catalog{
+		Code: model.CATALOG_PPD,
+		Items: func() ([]model.CatalogItem, error) {
+			return f.items, nil
+		},
+		Deactivate: func(id string) error {
+			f.deactivated = append(f.deactivated, id)
+			return nil
+		},
+		SetPrice: func(id string, costPrice, sellPrice float64) error {
+			f.prices[id] = [2]float64{costPrice, sellPrice}
+			return nil
+		},
+	}
+}
+
+func price(code string, costPrice float64) model.ProviderPrice {
+	return model.ProviderPrice{Code: code, Name: code, CostPrice: costPrice}
+}
+
+func TestSyncCatalogUseCase(t *testing.T) {
+	unavailable := false
+	fake := &fakeCatalog{items: []model.CatalogItem{
+		{ID: "1", Code: "TSEL10", CostPrice: 10200, SellPrice: 11000, IsActive: true},
+		{ID: "2", Code: "TSEL20", CostPrice: 20100, SellPrice: 21000, IsActive: true},
+		{ID: "3", Code: "TSEL25", CostPrice: 25100, SellPrice: 26000, IsActive: true},
+		{ID: "4", Code: "TSEL50", CostPrice: 0, SellPrice: 51000, IsActive: true},
+		{ID: "5", Code: "XL5", CostPrice: 5200, SellPrice: 6000, IsActive: false},
+		{ID: "6", Code: "XL10", CostPrice: 10100, SellPrice: 11000, IsActive: true},
+	}}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetLatestPriceReviewRepository", model.CATALOG_PPD, "TSEL20").Return(nil, nil)
+	mockReviewRepo.On("CreatePriceReviewRepository", mock.Anything).Return(func(review *model.CatalogPriceReview) *model.CatalogPriceReview {
+		return review
+	}, nil)
+	mockPriceListRepo := mocks.NewPriceListProviderRepository(t)
+	mockPriceListRepo.On("GetPriceListRepository", model.CATALOG_PPD).Return([]model.ProviderPrice{
+		price("TSEL10", 10200),
+		price("TSEL20", 20500),
+		{Code: "TSEL25", CostPrice: 25100, Available: &unavailable},
+		price("TSEL50", 50200),
+		price("TSEL100", 99500),
+	}, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mockPriceListRepo, fake.catalog())
+
+	result, err := service.SyncCatalogUseCase(model.CATALOG_PPD, false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 2, result.Unchanged)
+	assert.Equal(t, []string{"TSEL25", "XL10"}, result.Deactivated)
+	assert.Equal(t, []string{"3", "6"}, fake.deactivated)
+	assert.Equal(t, []string{"TSEL50"}, result.CostPriced)
+	assert.Equal(t, [2]float64{50200, 51000}, fake.prices["4"])
+	assert.Len(t, result.PriceReviews, 1)
+	assert.Equal(t, "TSEL20", result.PriceReviews[0].Code)
+	assert.Equal(t, float64(20100), result.PriceReviews[0].OldCostPrice)
+	assert.Equal(t, float64(20500), result.PriceReviews[0].NewCostPrice)
+	assert.Equal(t, model.PRICE_REVIEW_PENDING, result.PriceReviews[0].Status)
+	assert.NotContains(t, fake.prices, "2")
+	assert.Equal(t, []model.ProviderPrice{price("TSEL100", 99500)}, result.New)
+}
+
+func TestSyncCatalogUseCaseDryRun(t *testing.T) {
+	fake := &fakeCatalog{items: []model.CatalogItem{
+		{ID: "1", Code: "TSEL10", CostPrice: 10200, SellPrice: 11000, IsActive: true},
+		{ID: "2", Code: "TSEL20", CostPrice: 20100, SellPrice: 21000, IsActive: true},
+		{ID: "3", Code: "TSEL50", CostPrice: 0, SellPrice: 51000, IsActive: true},
+	}}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetLatestPriceReviewRepository", model.CATALOG_PPD, "TSEL20").Return(nil, nil)
+	mockPriceListRepo := mocks.NewPriceListProviderRepository(t)
+	mockPriceListRepo.On("GetPriceListRepository", model.CATALOG_PPD).Return([]model.ProviderPrice{
+		price("TSEL20", 20500),
+		price("TSEL50", 50200),
+	}, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mockPriceListRepo, fake.catalog())
+
+	result, err := service.SyncCatalogUseCase(model.CATALOG_PPD, true)
+
+	assert.NoError(t, err)
+	assert.True(t, result.DryRun)
+	assert.Equal(t, []string{"TSEL10"}, result.Deactivated)
+	assert.Equal(t, []string{"TSEL50"}, result.CostPriced)
+	assert.Len(t, result.PriceReviews, 1)
+	assert.Empty(t, fake.deactivated)
+	assert.Empty(t, fake.prices)
+	mockReviewRepo.AssertNotCalled(t, "CreatePriceReviewRepository", mock.Anything)
+}
+
+func TestSyncCatalogUseCasePendingReview(t *testing.T) {
+	fake := &fakeCatalog{items: []model.CatalogItem{
+		{ID: "1", Code: "TSEL10", CostPrice: 10200, SellPrice: 11000, IsActive: true},
+		{ID: "2", Code: "TSEL20", CostPrice: 20100, SellPrice: 21000, IsActive: true},
+	}}
+	pending := &model.CatalogPriceReview{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "review-1"}, Code: "TSEL10", OldCostPrice: 10200, NewCostPrice: 10400, Status: model.PRICE_REVIEW_PENDING}
+	waiting := &model.CatalogPriceReview{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "review-2"}, Code: "TSEL20", OldCostPrice: 20100, NewCostPrice: 20300, Status: model.PRICE_REVIEW_PENDING}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetLatestPriceReviewRepository", model.CATALOG_PPD, "TSEL10").Return(pending, nil)
+	mockReviewRepo.On("GetLatestPriceReviewRepository", model.CATALOG_PPD, "TSEL20").Return(waiting, nil)
+	mockReviewRepo.On("UpdatePriceReviewByIdRepository", "review-1", pending).Return(pending, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mocks.NewPriceListProviderRepository(t), fake.catalog())
+
+	result, err := service.ImportPriceListUseCase(model.CATALOG_PPD, "prices.csv", strings.NewReader("code,cost_price\nTSEL10,10600\nTSEL20,20300\n"), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, SOURCE_IMPORT, result.Source)
+	assert.Equal(t, []*model.CatalogPriceReview{pending, waiting}, result.PriceReviews)
+	assert.Equal(t, float64(10600), pending.NewCostPrice)
+	mockReviewRepo.AssertNotCalled(t, "CreatePriceReviewRepository", mock.Anything)
+}
+
+func TestSyncCatalogUseCaseRejectedReview(t *testing.T) {
+	fake := &fakeCatalog{items: []model.CatalogItem{
+		{ID: "1", Code: "TSEL10", CostPrice: 10200, SellPrice: 11000, IsActive: true},
+	}}
+	rejected := &model.CatalogPriceReview{Code: "TSEL10", OldCostPrice: 10200, NewCostPrice: 10400, Status: model.PRICE_REVIEW_REJECTED}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetLatestPriceReviewRepository", model.CATALOG_PPD, "TSEL10").Return(rejected, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mocks.NewPriceListProviderRepository(t), fake.catalog())
+
+	result, err := service.ImportPriceListUseCase(model.CATALOG_PPD, "prices.json", strings.NewReader(`[{"code":"TSEL10","cost_price":10400}]`), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Unchanged)
+	assert.Empty(t, result.PriceReviews)
+}
+
+func TestSyncCatalogUseCaseInvalidPriceList(t *testing.T) {
+	service := NewCatalogSyncUseCase(mocks.NewCatalogPriceReviewRepository(t), mocks.NewPriceListProviderRepository(t), (&fakeCatalog{}).catalog())
+
+	_, err := service.ImportPriceListUseCase(model.CATALOG_PPD, "prices.json", strings.NewReader(`[]`), false)
+	assert.EqualError(t, err, "price list is empty")
+
+	_, err = service.ImportPriceListUseCase(model.CATALOG_PPD, "prices.json", strings.NewReader(`[{"code":"TSEL10","cost_price":1},{"code":"TSEL10","cost_price":2}]`), false)
+	assert.EqualError(t, err, "price list has product TSEL10 more than once")
+
+	_, err = service.ImportPriceListUseCase(model.CATALOG_ISP_PLAN, "prices.json", strings.NewReader(`[]`), false)
+	assert.EqualError(t, err, "catalog isp_plan not found")
+}
+
+func TestRunCatalogSyncUseCase(t *testing.T) {
+	mockPriceListRepo := mocks.NewPriceListProviderRepository(t)
+	mockPriceListRepo.On("GetPriceListRepository", model.CATALOG_PPD).Return(nil, errors.New("price list provider returned 503 Service Unavailable"))
+
+	service := NewCatalogSyncUseCase(mocks.NewCatalogPriceReviewRepository(t), mockPriceListRepo, (&fakeCatalog{}).catalog())
+
+	err := service.RunCatalogSyncUseCase(time.Now())
+
+	assert.EqualError(t, err, "failed to sync 1 of 1 catalogs")
+}
+
+func TestApprovePriceReviewUseCase(t *testing.T) {
+	fake := &fakeCatalog{items: []model.CatalogItem{
+		{ID: "1", Code: "TSEL10", CostPrice: 10200, SellPrice: 11000, IsActive: true},
+	}}
+	review := &model.CatalogPriceReview{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "review-1"}, Catalog: model.CATALOG_PPD, ProductID: "1", Code: "TSEL10", OldCostPrice: 10200, NewCostPrice: 10400, SellPrice: 11000, Status: model.PRICE_REVIEW_PENDING}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetPriceReviewByIdRepository", "review-1").Return(review, nil)
+	mockReviewRepo.On("UpdatePriceReviewByIdRepository", "review-1", review).Return(review, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mocks.NewPriceListProviderRepository(t), fake.catalog())
+
+	_, err := service.ApprovePriceReviewUseCase("review-1", 10300)
+	assert.EqualError(t, err, "sell price 10300 is below the cost price 10400")
+
+	result, err := service.ApprovePriceReviewUseCase("review-1", 0)
+	assert.NoError(t, err)
+	assert.Equal(t, model.PRICE_REVIEW_APPROVED, result.Status)
+	assert.Equal(t, float64(11000), result.ApprovedSellPrice)
+	assert.Equal(t, [2]float64{10400, 11000}, fake.prices["1"])
+
+	_, err = service.ApprovePriceReviewUseCase("review-1", 12000)
+	assert.EqualError(t, err, "price review is already approved")
+}
+
+func TestRejectPriceReviewUseCase(t *testing.T) {
+	fake := &fakeCatalog{}
+	review := &model.CatalogPriceReview{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "review-1"}, Catalog: model.CATALOG_PPD, ProductID: "1", NewCostPrice: 10400, Status: model.PRICE_REVIEW_PENDING}
+
+	mockReviewRepo := mocks.NewCatalogPriceReviewRepository(t)
+	mockReviewRepo.On("GetPriceReviewByIdRepository", "review-1").Return(review, nil)
+	mockReviewRepo.On("UpdatePriceReviewByIdRepository", "review-1", review).Return(review, nil)
+
+	service := NewCatalogSyncUseCase(mockReviewRepo, mocks.NewPriceListProviderRepository(t), fake.catalog())
+
+	result, err := service.RejectPriceReviewUseCase("review-1")
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.PRICE_REVIEW_REJECTED, result.Status)
+	assert.Empty(t, fake.prices)
+}
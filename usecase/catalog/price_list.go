@@ -0,0 +1,89 @@
+package catalog
+
+import (
+	"BE-Golang/model"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// ParsePriceList reads a provider price list file. JSON files hold an array
+// of model.ProviderPrice; CSV files need a header row with at least the code
+// and cost_price columns, and may add name and available.
+func ParsePriceList(filename string, file io.Reader) ([]model.ProviderPrice, error) {
+	switch strings.ToLower(filepath.Ext(filename)) {
+	case ".json":
+		data, err := ioutil.ReadAll(file)
+		if err != nil {
+			return nil, err
+		}
+
+		var prices []model.ProviderPrice
+		if err := json.Unmarshal(data, &prices); err != nil {
+			return nil, fmt.Errorf("invalid price list: %w", err)
+		}
+		return prices, nil
+	case ".csv":
+		return parseCSV(file)
+	}
+
+	return nil, errors.New("price list must be a .csv or .json file")
+}
+
+func parseCSV(file io.Reader) ([]model.ProviderPrice, error) {
+	rows, err := csv.NewReader(file).ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("invalid price list: %w", err)
+	}
+	if len(rows) == 0 {
+		return nil, errors.New("price list is empty")
+	}
+
+	columns := map[string]int{}
+	for i, name := range rows[0] {
+		columns[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	for _, required := range []string{"code", "cost_price"} {
+		if _, ok := columns[required]; !ok {
+			return nil, fmt.Errorf("price list has no %s column", required)
+		}
+	}
+
+	field := func(row []string, name string) string {
+		i, ok := columns[name]
+		if !ok || i >= len(row) {
+			return ""
+		}
+		return strings.TrimSpace(row[i])
+	}
+
+	prices := make([]model.ProviderPrice, 0, len(rows)-1)
+	for line, row := range rows[1:] {
+		costPrice, err := strconv.ParseFloat(field(row, "cost_price"), 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid cost_price %q", line+2, field(row, "cost_price"))
+		}
+
+		price := model.ProviderPrice{
+			Code:      field(row, "code"),
+			Name:      field(row, "name"),
+			CostPrice: costPrice,
+		}
+		if value := field(row, "available"); value != "" {
+			available, err := strconv.ParseBool(value)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: invalid available %q", line+2, value)
+			}
+			price.Available = &available
+		}
+		prices = append(prices, price)
+	}
+
+	return prices, nil
+}
@@ -0,0 +1,43 @@
+package catalog
+
+import (
+	"BE-Golang/model"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParsePriceListCSV(t *testing.T) {
+	prices, err := ParsePriceList("Prices.CSV", strings.NewReader("Code,Name,Cost_Price,Available\nTSEL10,Telkomsel 10K,10200,true\nTSEL20,Telkomsel 20K,20100,false\nXL10,,10100,\n"))
+
+	unavailable := false
+	available := true
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ProviderPrice{
+		{Code: "TSEL10", Name: "Telkomsel 10K", CostPrice: 10200, Available: &available},
+		{Code: "TSEL20", Name: "Telkomsel 20K", CostPrice: 20100, Available: &unavailable},
+		{Code: "XL10", CostPrice: 10100},
+	}, prices)
+}
+
+func TestParsePriceListCSVInvalid(t *testing.T) {
+	_, err := ParsePriceList("prices.csv", strings.NewReader("code,name\nTSEL10,Telkomsel 10K\n"))
+	assert.EqualError(t, err, "price list has no cost_price column")
+
+	_, err = ParsePriceList("prices.csv", strings.NewReader("code,cost_price\nTSEL10,ten\n"))
+	assert.EqualError(t, err, `line 2: invalid cost_price "ten"`)
+
+	_, err = ParsePriceList("prices.csv", strings.NewReader("code,cost_price,available\nTSEL10,10200,maybe\n"))
+	assert.EqualError(t, err, `line 2: invalid available "maybe"`)
+}
+
+func TestParsePriceListJSON(t *testing.T) {
+	prices, err := ParsePriceList("prices.json", strings.NewReader(`[{"code":"TSEL10","name":"Telkomsel 10K","cost_price":10200}]`))
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.ProviderPrice{{Code: "TSEL10", Name: "Telkomsel 10K", CostPrice: 10200}}, prices)
+
+	_, err = ParsePriceList("prices.xlsx", strings.NewReader(""))
+	assert.EqualError(t, err, "price list must be a .csv or .json file")
+}
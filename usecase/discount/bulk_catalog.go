@@ -0,0 +1,57 @@
+package discount
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+)
+
+// NewBulkCatalog lets admins upload and download discounts, keyed by
+// discount code.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	discounts := func() ([]model.Discount, error) {
+		var discounts []model.Discount
+		err := bulkRepository.FindAllRepository(&discounts)
+		return discounts, err
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_DISCOUNT,
+		Columns: []string{"discount_code", "description", "discount_price", "image"},
+		Key:     []string{"discount_code"},
+		Export: func() ([][]string, error) {
+			discounts, err := discounts()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(discounts))
+			for i, discount := range discounts {
+				rows[i] = []string{discount.DiscountCode, discount.Description, bulk.FormatFloat(discount.DiscountPrice), discount.Image}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			discounts, err := discounts()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.Discount{}
+			for _, discount := range discounts {
+				existing[discount.DiscountCode] = discount
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				discount, update := existing[row.Required("discount_code")]
+				discount.DiscountCode = row.String("discount_code")
+				discount.Description = row.String("description")
+				discount.DiscountPrice = row.Float("discount_price")
+				if discount.DiscountPrice <= 0 {
+					row.Errorf("discount_price must be greater than 0")
+				}
+				discount.Image = row.String("image")
+				return &discount, update
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,63 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+	"strconv"
+	"strings"
+)
+
+// NewBulkCatalog lets admins upload and download PLN tariffs, keyed by class
+// and min_power.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	tariffs := func() ([]model.PlnTariff, error) {
+		var tariffs []model.PlnTariff
+		err := bulkRepository.FindAllRepository(&tariffs)
+		return tariffs, err
+	}
+	key := func(class string, minPower int) string {
+		return class + "|" + strconv.Itoa(minPower)
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_PLN_TARIFF,
+		Columns: []string{"class", "min_power", "max_power", "rate_per_kwh", "description"},
+		Key:     []string{"class", "min_power"},
+		Export: func() ([][]string, error) {
+			tariffs, err := tariffs()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(tariffs))
+			for i, tariff := range tariffs {
+				rows[i] = []string{tariff.Class, bulk.FormatInt(tariff.MinPower), bulk.FormatInt(tariff.MaxPower), bulk.FormatFloat(tariff.RatePerKwh), tariff.Description}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			tariffs, err := tariffs()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.PlnTariff{}
+			for _, tariff := range tariffs {
+				existing[key(tariff.Class, tariff.MinPower)] = tariff
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				class := strings.ToUpper(row.String("class"))
+				minPower := row.Int("min_power")
+				tariff, update := existing[key(class, minPower)]
+				tariff.Class = class
+				tariff.MinPower = minPower
+				tariff.MaxPower = row.Int("max_power")
+				tariff.RatePerKwh = row.Float("rate_per_kwh")
+				tariff.Description = row.String("description")
+				row.Error(validatePlnTariff(&tariff))
+				return &tariff, update
+			}, nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/catalog"
+)
+
+// NewCatalog exposes electricity products to the catalog sync.
+func NewCatalog(electricityRepository repository.ElectricityRepository) *catalog.Catalog {
+	return &catalog.Catalog{
+		Code: model.CATALOG_ELECTRICITY,
+		Items: func() ([]model.CatalogItem, error) {
+			products, err := electricityRepository.GetElectricityCatalogRepository()
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]model.CatalogItem, len(products))
+			for i, product := range products {
+				items[i] = model.CatalogItem{
+					ID:        product.ID,
+					Code:      product.Code,
+					Name:      product.Name,
+					CostPrice: product.CostPrice,
+					SellPrice: product.Price,
+					IsActive:  product.IsActive == nil || *product.IsActive,
+				}
+			}
+			return items, nil
+		},
+		Deactivate: func(id string) error {
+			inactive := false
+			_, err := electricityRepository.UpdateElectricityByIdRepository(id, &model.Electricity{IsActive: &inactive})
+			return err
+		},
+		SetPrice: func(id string, costPrice, sellPrice float64) error {
+			_, err := electricityRepository.UpdateElectricityByIdRepository(id, &model.Electricity{CostPrice: costPrice, Price: sellPrice})
+			return err
+		},
+	}
+}
@@ -0,0 +1,166 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"crypto/rand"
+	"fmt"
+)
+
+const PRODUCT_ELECTRICITY_PREPAID = "electricity_prepaid"
+
+// NewPostpaidProduct plugs monthly PLN bills into the biller engine. Bills are
+// priced from the customer's tariff class.
+func NewPostpaidProduct(plnTariffRepository repository.PlnTariffRepository) *biller.Product {
+	return &biller.Product{
+		Code:              model.PRODUCT_ELECTRICITY,
+		Category:          model.PRODUCT_ELECTRICITY,
+		Name:              "ELECTRICITY",
+		TransactionPrefix: "POSTPAID",
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			meter := lookupMeter(inquiry.Payload.CustomerId)
+			tariff, err := findTariff(plnTariffRepository, meter)
+			if err != nil {
+				return nil, err
+			}
+
+			return pricePostpaid(tariff, meter, inquiry), nil
+		},
+		Detail:  newDetail,
+		Receipt: receipt,
+	}
+}
+
+// NewPrepaidProduct plugs PLN token purchases into the biller engine. The
+// kWh a token buys comes from the customer's tariff class.
+func NewPrepaidProduct(plnTariffRepository repository.PlnTariffRepository) *biller.Product {
+	return &biller.Product{
+		Code:               PRODUCT_ELECTRICITY_PREPAID,
+		Category:           model.PRODUCT_ELECTRICITY,
+		Name:               "ELECTRICITY",
+		TransactionPrefix:  "PREPAID",
+		Prepaid:            true,
+		ValidateCustomerId: validateMeterNumber,
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			if err := validateDenomination(inquiry.Payload.Amount); err != nil {
+				return nil, err
+			}
+
+			meter, err := lookupPrepaidMeter(inquiry.Payload.CustomerId)
+			if err != nil {
+				return nil, err
+			}
+			tariff, err := findTariff(plnTariffRepository, meter)
+			if err != nil {
+				return nil, err
+			}
+
+			return pricePrepaid(tariff, meter, inquiry), nil
+		},
+		Detail:  newDetail,
+		Receipt: receipt,
+		Settle:  issueToken,
+	}
+}
+
+func pricePostpaid(tariff *model.PlnTariff, meter meter, inquiry *biller.Inquiry) *biller.Bill {
+	kwh := monthlyUsage(inquiry.Payload.CustomerId, inquiry.Payload.Period, meter.Power)
+	c := calculatePostpaid(tariff, meter.Power, kwh)
+
+	return &biller.Bill{
+		Price:       c.Total,
+		Description: fmt.Sprintf("Pembayaran Tagihan Listrik %s ", inquiry.Payload.Period),
+		Detail: &model.Electricity{
+			Period:          inquiry.Payload.Period,
+			Name:            inquiry.User.Name,
+			CustomerId:      inquiry.Payload.CustomerId,
+			ProviderName:    inquiry.Response.ProductID,
+			ElectricalPower: meter.Power,
+			Type:            inquiry.Response.ProductID,
+			DiscountId:      inquiry.Discount.ID,
+			Price:           c.Total,
+			TariffClass:     tariff.Class,
+			RatePerKwh:      tariff.RatePerKwh,
+			Kwh:             c.Kwh,
+			EnergyCharge:    c.EnergyCharge,
+			Ppj:             c.Ppj,
+			Ppn:             c.Ppn,
+			StampDuty:       c.StampDuty,
+		},
+	}
+}
+
+// pricePrepaid charges the token amount the user asked for. Prepaid meters are
+// not stored as saved billers, so the meter goes into MeterNumber rather than
+// the customer ID.
+func pricePrepaid(tariff *model.PlnTariff, meter meter, inquiry *biller.Inquiry) *biller.Bill {
+	c := calculatePrepaid(tariff, meter.Power, inquiry.Payload.Amount)
+
+	return &biller.Bill{
+		Price:       c.Total,
+		Description: fmt.Sprintf("Pembelian Token Listrik %.2f ", inquiry.Payload.Amount),
+		Detail: &model.Electricity{
+			MeterNumber:     inquiry.Payload.CustomerId,
+			Name:            meter.Name,
+			ProviderName:    inquiry.Response.ProductID,
+			Type:            inquiry.Response.ProductID,
+			ElectricalPower: meter.Power,
+			DiscountId:      inquiry.Discount.ID,
+			Price:           c.Total,
+			TariffClass:     tariff.Class,
+			RatePerKwh:      tariff.RatePerKwh,
+			Kwh:             c.Kwh,
+			EnergyCharge:    c.EnergyCharge,
+			Ppj:             c.Ppj,
+			Ppn:             c.Ppn,
+			StampDuty:       c.StampDuty,
+		},
+	}
+}
+
+func newDetail() interface{} {
+	return &model.Electricity{}
+}
+
+// issueToken issues the token for a prepaid purchase once it is paid.
+// issueToken issues the 20 digit token the customer enters on the meter. It
+// must not be guessable, so it is drawn from crypto/rand.
+func issueToken(transactionID string, detail interface{}) (interface{}, error) {
+	electricity := detail.(*model.Electricity)
+	token, err := newToken()
+	if err != nil {
+		return nil, err
+	}
+	electricity.Token = formatToken(token)
+	return electricity, nil
+}
+
+func newToken() (string, error) {
+	token := make([]byte, 0, 20)
+	random := make([]byte, 32)
+	for len(token) < cap(token) {
+		if _, err := rand.Read(random); err != nil {
+			return "", fmt.Errorf("failed to issue PLN token: %w", err)
+		}
+		for _, b := range random {
+			// Bytes from 250 up would make the low digits more likely.
+			if b < 250 && len(token) < cap(token) {
+				token = append(token, '0'+b%10)
+			}
+		}
+	}
+
+	return string(token), nil
+}
+
+func receipt(detail interface{}, receipt *model.PayloadMail) {
+	electricity := detail.(*model.Electricity)
+	if electricity.MeterNumber != "" {
+		receipt.CustomerId = electricity.MeterNumber
+	}
+	receipt.ElectricalPower = electricity.ElectricalPower
+	receipt.Token = electricity.Token
+	receipt.TariffClass = electricity.TariffClass
+	receipt.Kwh = fmt.Sprintf("%.2f", electricity.Kwh)
+}
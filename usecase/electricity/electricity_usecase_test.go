@@ -22,6 +22,10 @@ type ElectricityUsecaseTest struct {
 	discountRepo       *mocks.DiscountRepository
 	transactionRepo    *mocks.TransactionRepository
 	billerOyApiRepo    *mocks.BillerOyApiRepository
+	savedBillerRepo    *mocks.SavedBillerRepository
+	customerIdRuleRepo *mocks.CustomerIdRuleRepository
+	availabilityRepo   *mocks.ProductAvailabilityRepository
+	plnTariffRepo      *mocks.PlnTariffRepository
 }
 
 func TestElectricityUsecase(t *testing.T) {
@@ -34,7 +38,16 @@ func (m *ElectricityUsecaseTest) SetupTest() {
 	m.discountRepo = &mocks.DiscountRepository{}
 	m.transactionRepo = &mocks.TransactionRepository{}
 	m.billerOyApiRepo = &mocks.BillerOyApiRepository{}
-	m.electricityUsecase = electricity.NewElectricityUseCase(m.electricityRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo)
+	m.savedBillerRepo = &mocks.SavedBillerRepository{}
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	m.customerIdRuleRepo.On("GetCustomerIdRulesByProductRepository", mock.Anything).Return(nil, nil)
+	m.availabilityRepo = &mocks.ProductAvailabilityRepository{}
+	m.availabilityRepo.On("GetProductAvailabilityRepository", mock.Anything, mock.Anything).Return(nil, nil)
+	m.availabilityRepo.On("AddProductAvailabilityFailureRepository", mock.Anything, mock.Anything).Return(nil)
+	m.availabilityRepo.On("TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	m.availabilityRepo.On("ResetProductAvailabilityFailuresRepository", mock.Anything, mock.Anything).Return(nil)
+	m.plnTariffRepo = &mocks.PlnTariffRepository{}
+	m.electricityUsecase = electricity.NewElectricityUseCase(m.electricityRepo, m.plnTariffRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo, m.savedBillerRepo, m.customerIdRuleRepo, m.availabilityRepo)
 }
 
 func (m *ElectricityUsecaseTest) TestCreateElectricityUseCaseSuccess() {
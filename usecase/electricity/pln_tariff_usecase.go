@@ -0,0 +1,115 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PlnTariffUseCase interface {
+	CreatePlnTariffUseCase(payload *model.PlnTariff) (*model.PlnTariff, error)
+	GetAllPlnTariffUseCase(page, limit int) ([]*model.PlnTariff, error)
+	GetPlnTariffByIdUseCase(id string) (*model.PlnTariff, error)
+	UpdatePlnTariffByIdUseCase(id string, payload *model.PlnTariff) (*model.PlnTariff, error)
+	DeletePlnTariffByIdUseCase(id string) error
+}
+
+type plnTariffUseCase struct {
+	plnTariffRepository repository.PlnTariffRepository
+}
+
+func NewPlnTariffUseCase(plnTariffRepository repository.PlnTariffRepository) *plnTariffUseCase {
+	return &plnTariffUseCase{
+		plnTariffRepository: plnTariffRepository,
+	}
+}
+
+func (uc *plnTariffUseCase) CreatePlnTariffUseCase(payload *model.PlnTariff) (*model.PlnTariff, error) {
+	payload.Class = strings.ToUpper(strings.TrimSpace(payload.Class))
+	if err := validatePlnTariff(payload); err != nil {
+		return nil, err
+	}
+
+	tariff, err := uc.plnTariffRepository.CreatePlnTariffRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating PLN tariff in database: %w", err)
+	}
+
+	return tariff, nil
+}
+
+func (uc *plnTariffUseCase) GetAllPlnTariffUseCase(page, limit int) ([]*model.PlnTariff, error) {
+	return uc.plnTariffRepository.GetAllPlnTariffRepository(page, limit)
+}
+
+func (uc *plnTariffUseCase) GetPlnTariffByIdUseCase(id string) (*model.PlnTariff, error) {
+	tariff, err := uc.plnTariffRepository.GetPlnTariffByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("PLN tariff not found")
+	}
+
+	return tariff, nil
+}
+
+func (uc *plnTariffUseCase) UpdatePlnTariffByIdUseCase(id string, payload *model.PlnTariff) (*model.PlnTariff, error) {
+	tariff, err := uc.plnTariffRepository.GetPlnTariffByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update PLN tariff: %v", err)
+	}
+
+	if payload.Class != "" {
+		tariff.Class = strings.ToUpper(strings.TrimSpace(payload.Class))
+	}
+	if payload.MinPower != 0 {
+		tariff.MinPower = payload.MinPower
+	}
+	if payload.MaxPower != 0 {
+		tariff.MaxPower = payload.MaxPower
+	}
+	if payload.RatePerKwh != 0 {
+		tariff.RatePerKwh = payload.RatePerKwh
+	}
+	if payload.Description != "" {
+		tariff.Description = payload.Description
+	}
+	if err := validatePlnTariff(tariff); err != nil {
+		return nil, err
+	}
+	tariff.UpdatedAt = time.Now()
+
+	updated, err := uc.plnTariffRepository.UpdatePlnTariffByIdRepository(id, tariff)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update PLN tariff: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *plnTariffUseCase) DeletePlnTariffByIdUseCase(id string) error {
+	err := uc.plnTariffRepository.DeletePlnTariffByIdRepository(id)
+	if err != nil {
+		return errors.New("PLN tariff not found")
+	}
+
+	return nil
+}
+
+func validatePlnTariff(tariff *model.PlnTariff) error {
+	if tariff.Class == "" || !strings.ContainsAny(tariff.Class[:1], "RBI") {
+		return errors.New("class must start with segment R, B or I")
+	}
+	if tariff.MinPower <= 0 {
+		return errors.New("min_power must be greater than 0")
+	}
+	if tariff.MaxPower != 0 && tariff.MaxPower < tariff.MinPower {
+		return errors.New("max_power must not be less than min_power")
+	}
+	if tariff.RatePerKwh <= 0 {
+		return errors.New("rate_per_kwh must be greater than 0")
+	}
+
+	return nil
+}
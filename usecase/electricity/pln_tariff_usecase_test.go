@@ -0,0 +1,68 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PlnTariffUseCaseTest struct {
+	suite.Suite
+	plnTariffUseCase PlnTariffUseCase
+	plnTariffRepo    *mocks.PlnTariffRepository
+}
+
+func TestPlnTariffUseCase(t *testing.T) {
+	suite.Run(t, new(PlnTariffUseCaseTest))
+}
+
+func (m *PlnTariffUseCaseTest) SetupTest() {
+	m.plnTariffRepo = &mocks.PlnTariffRepository{}
+	m.plnTariffUseCase = NewPlnTariffUseCase(m.plnTariffRepo)
+}
+
+func (m *PlnTariffUseCaseTest) TestCreatePlnTariffSuccess() {
+	m.plnTariffRepo.On("CreatePlnTariffRepository", mock.Anything).Return(func(tariff *model.PlnTariff) *model.PlnTariff {
+		return tariff
+	}, nil)
+
+	resp, err := m.plnTariffUseCase.CreatePlnTariffUseCase(&model.PlnTariff{
+		Class:      " r1 ",
+		MinPower:   1300,
+		MaxPower:   2200,
+		RatePerKwh: 1444.70,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "R1", resp.Class)
+}
+
+func (m *PlnTariffUseCaseTest) TestCreatePlnTariffInvalidClass() {
+	_, err := m.plnTariffUseCase.CreatePlnTariffUseCase(&model.PlnTariff{Class: "X1", MinPower: 450, RatePerKwh: 415})
+
+	assert.EqualError(m.T(), err, "class must start with segment R, B or I")
+}
+
+func (m *PlnTariffUseCaseTest) TestCreatePlnTariffInvalidBand() {
+	_, err := m.plnTariffUseCase.CreatePlnTariffUseCase(&model.PlnTariff{Class: "R2", MinPower: 5500, MaxPower: 3500, RatePerKwh: 1699.53})
+
+	assert.EqualError(m.T(), err, "max_power must not be less than min_power")
+}
+
+func (m *PlnTariffUseCaseTest) TestUpdatePlnTariffValidatesMergedBand() {
+	m.plnTariffRepo.On("GetPlnTariffByIdRepository", "id").Return(&model.PlnTariff{
+		Class:      "R1",
+		MinPower:   1300,
+		MaxPower:   2200,
+		RatePerKwh: 1444.70,
+	}, nil)
+
+	_, err := m.plnTariffUseCase.UpdatePlnTariffByIdUseCase("id", &model.PlnTariff{MinPower: 3500})
+
+	assert.EqualError(m.T(), err, "max_power must not be less than min_power")
+	m.plnTariffRepo.AssertNotCalled(m.T(), "UpdatePlnTariffByIdRepository", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,184 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+)
+
+const (
+	// ppjRate is the regional street lighting tax charged on the energy charge.
+	ppjRate = 0.03
+	// ppnRate applies to connections above ppnMinPower.
+	ppnRate     = 0.11
+	ppnMinPower = 6600
+
+	stampDuty          = 10000.0
+	stampDutyThreshold = 5000000.0
+
+	// minimumHours is the usage a postpaid bill charges at least, per kVA.
+	minimumHours = 40
+)
+
+// tokenDenominations are the token amounts PLN sells.
+var tokenDenominations = []float64{20000, 50000, 100000, 200000, 500000, 1000000, 5000000, 10000000, 50000000}
+
+// meterNumberPattern accepts both the 11 digit meter number and the 12 digit
+// customer number (ID pelanggan) printed on the meter.
+var meterNumberPattern = regexp.MustCompile(`^[0-9]{11,12}$`)
+
+var meterHolderNames = []string{"Budi Santoso", "Siti Rahayu", "Agus Setiawan", "Dewi Lestari", "Rizky Pratama", "Nur Aini"}
+
+var segmentPowers = map[string][]int{
+	"R": {450, 900, 1300, 2200, 3500, 4400, 5500, 7700, 11000},
+	"B": {1300, 2200, 5500, 6600, 16500, 23000, 41500, 240000},
+	"I": {5500, 14000, 53000, 240000},
+}
+
+// meter is the connection behind a customer number.
+type meter struct {
+	Segment string
+	Power   int
+	Name    string
+}
+
+// charge is an electricity bill or token purchase broken down into its lines.
+type charge struct {
+	Kwh          float64
+	EnergyCharge float64
+	Ppj          float64
+	Ppn          float64
+	StampDuty    float64
+	Total        float64
+}
+
+// lookupMeter stands in for PLN's customer lookup until the gateway returns
+// the connection. Meters are derived from the customer number, so a customer
+// always has the same segment and power.
+func lookupMeter(customerID string) meter {
+	sum := biller.Hash(customerID)
+
+	segment := "R"
+	switch {
+	case sum%20 == 0:
+		segment = "I"
+	case sum%20 < 4:
+		segment = "B"
+	}
+	powers := segmentPowers[segment]
+
+	return meter{
+		Segment: segment,
+		Power:   powers[(sum/20)%uint32(len(powers))],
+		Name:    meterHolderNames[sum%uint32(len(meterHolderNames))],
+	}
+}
+
+func validateMeterNumber(meterNumber string) error {
+	if !meterNumberPattern.MatchString(meterNumber) {
+		return errors.New("meter number must be 11 or 12 digits")
+	}
+
+	return nil
+}
+
+// lookupPrepaidMeter checks the meter number and finds the meter behind it.
+// Like the Oy sandbox, meter numbers ending in 9 are not registered.
+func lookupPrepaidMeter(meterNumber string) (meter, error) {
+	if err := validateMeterNumber(meterNumber); err != nil {
+		return meter{}, err
+	}
+	if strings.HasSuffix(meterNumber, "9") {
+		return meter{}, fmt.Errorf("meter %s is not registered", meterNumber)
+	}
+
+	return lookupMeter(meterNumber), nil
+}
+
+func validateDenomination(amount float64) error {
+	for _, nominal := range tokenDenominations {
+		if amount == nominal {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("token amount %.0f is not available", amount)
+}
+
+// formatToken groups a 20 digit token in blocks of four, the way it is typed
+// into the meter.
+func formatToken(token string) string {
+	groups := make([]string, 0, 5)
+	for len(token) > 4 {
+		groups = append(groups, token[:4])
+		token = token[4:]
+	}
+
+	return strings.Join(append(groups, token), "-")
+}
+
+// monthlyUsage is the metered kWh of a postpaid customer for a period.
+func monthlyUsage(customerID, period string, power int) float64 {
+	hours := 60 + biller.Hash(customerID+period)%181
+	return math.Round(float64(power) / 1000 * float64(hours))
+}
+
+func findTariff(plnTariffRepository repository.PlnTariffRepository, meter meter) (*model.PlnTariff, error) {
+	tariff, err := plnTariffRepository.GetPlnTariffByPowerRepository(meter.Segment, meter.Power)
+	if err != nil {
+		return nil, err
+	}
+	if tariff == nil {
+		return nil, fmt.Errorf("no PLN tariff for %s %d VA", meter.Segment, meter.Power)
+	}
+
+	return tariff, nil
+}
+
+// calculatePostpaid bills the metered usage, but never less than
+// minimumHours of usage at full power.
+func calculatePostpaid(tariff *model.PlnTariff, power int, kwh float64) charge {
+	billed := math.Max(kwh, float64(minimumHours*power)/1000)
+	c := charge{Kwh: kwh, EnergyCharge: math.Round(billed * tariff.RatePerKwh)}
+	c.Ppj = math.Round(c.EnergyCharge * ppjRate)
+	if power > ppnMinPower {
+		c.Ppn = math.Round(c.EnergyCharge * ppnRate)
+	}
+
+	c.Total = c.EnergyCharge + c.Ppj + c.Ppn
+	if c.Total > stampDutyThreshold {
+		c.StampDuty = stampDuty
+		c.Total += stampDuty
+	}
+
+	return c
+}
+
+// calculatePrepaid splits a token purchase into its taxes and the energy the
+// remainder buys.
+func calculatePrepaid(tariff *model.PlnTariff, power int, amount float64) charge {
+	c := charge{Total: amount}
+	if amount > stampDutyThreshold {
+		c.StampDuty = stampDuty
+	}
+
+	rate := ppjRate
+	if power > ppnMinPower {
+		rate += ppnRate
+	}
+	energy := (amount - c.StampDuty) / (1 + rate)
+
+	c.Ppj = math.Round(energy * ppjRate)
+	if power > ppnMinPower {
+		c.Ppn = math.Round(energy * ppnRate)
+	}
+	c.EnergyCharge = amount - c.StampDuty - c.Ppj - c.Ppn
+	c.Kwh = math.Floor(c.EnergyCharge/tariff.RatePerKwh*100) / 100
+
+	return c
+}
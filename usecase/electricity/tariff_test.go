@@ -0,0 +1,134 @@
+package electricity
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller/billertest"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLookupMeterIsStable(t *testing.T) {
+	meter := lookupMeter("512345678901")
+
+	assert.Equal(t, meter, lookupMeter("512345678901"))
+	assert.Contains(t, segmentPowers[meter.Segment], meter.Power)
+}
+
+func TestCalculatePostpaidMinimumCharge(t *testing.T) {
+	c := calculatePostpaid(&model.PlnTariff{RatePerKwh: 1444.70}, 1300, 30)
+
+	assert.Equal(t, 30.0, c.Kwh)
+	assert.Equal(t, 75124.0, c.EnergyCharge)
+	assert.Equal(t, 2254.0, c.Ppj)
+	assert.Equal(t, 0.0, c.Ppn)
+	assert.Equal(t, 77378.0, c.Total)
+}
+
+func TestCalculatePostpaidPpn(t *testing.T) {
+	c := calculatePostpaid(&model.PlnTariff{RatePerKwh: 1699.53}, 7700, 500)
+
+	assert.Equal(t, 849765.0, c.EnergyCharge)
+	assert.Equal(t, 25493.0, c.Ppj)
+	assert.Equal(t, 93474.0, c.Ppn)
+	assert.Equal(t, 0.0, c.StampDuty)
+	assert.Equal(t, 968732.0, c.Total)
+}
+
+func TestCalculatePostpaidStampDuty(t *testing.T) {
+	c := calculatePostpaid(&model.PlnTariff{RatePerKwh: 1114.74}, 240000, 5000)
+
+	assert.Equal(t, stampDuty, c.StampDuty)
+	assert.Equal(t, c.EnergyCharge+c.Ppj+c.Ppn+stampDuty, c.Total)
+}
+
+func TestCalculatePrepaid(t *testing.T) {
+	c := calculatePrepaid(&model.PlnTariff{RatePerKwh: 1444.70}, 1300, 100000)
+
+	assert.Equal(t, 2913.0, c.Ppj)
+	assert.Equal(t, 97087.0, c.EnergyCharge)
+	assert.Equal(t, 67.2, c.Kwh)
+	assert.Equal(t, 100000.0, c.Total)
+}
+
+func TestPostpaidProductPrice(t *testing.T) {
+	meter := lookupMeter("512345678901")
+	tariff := &model.PlnTariff{Class: "R1", RatePerKwh: 1444.70}
+	repo := &mocks.PlnTariffRepository{}
+	repo.On("GetPlnTariffByPowerRepository", meter.Segment, meter.Power).Return(tariff, nil)
+
+	bill, err := NewPostpaidProduct(repo).Price(billertest.Inquiry(model.PRODUCT_ELECTRICITY, "512345678901", "March-2026"))
+
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.Electricity)
+	assert.Equal(t, "R1", detail.TariffClass)
+	assert.Equal(t, meter.Power, detail.ElectricalPower)
+	assert.Equal(t, calculatePostpaid(tariff, meter.Power, detail.Kwh).Total, bill.Price)
+}
+
+func TestPostpaidProductMissingTariff(t *testing.T) {
+	meter := lookupMeter("512345678901")
+	repo := &mocks.PlnTariffRepository{}
+	repo.On("GetPlnTariffByPowerRepository", meter.Segment, meter.Power).Return(nil, nil)
+
+	_, err := NewPostpaidProduct(repo).Price(billertest.Inquiry(model.PRODUCT_ELECTRICITY, "512345678901", "March-2026"))
+
+	assert.EqualError(t, err, fmt.Sprintf("no PLN tariff for %s %d VA", meter.Segment, meter.Power))
+}
+
+func TestPrepaidProductRejectsUnknownDenomination(t *testing.T) {
+	inquiry := billertest.Inquiry(PRODUCT_ELECTRICITY_PREPAID, "51234567890", "March-2026")
+	inquiry.Payload.Amount = 25000
+	_, err := NewPrepaidProduct(&mocks.PlnTariffRepository{}).Price(inquiry)
+
+	assert.EqualError(t, err, "token amount 25000 is not available")
+}
+
+func TestPrepaidProductPrice(t *testing.T) {
+	meter := lookupMeter("51234567890")
+	tariff := &model.PlnTariff{Class: "R1", RatePerKwh: 1444.70}
+	repo := &mocks.PlnTariffRepository{}
+	repo.On("GetPlnTariffByPowerRepository", meter.Segment, meter.Power).Return(tariff, nil)
+	product := NewPrepaidProduct(repo)
+
+	inquiry := billertest.Inquiry(PRODUCT_ELECTRICITY_PREPAID, "51234567890", "March-2026")
+	inquiry.Payload.Amount = 100000
+	bill, err := product.Price(inquiry)
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.Electricity)
+	assert.Equal(t, "51234567890", detail.MeterNumber)
+	assert.Equal(t, meter.Name, detail.Name)
+	assert.Empty(t, detail.CustomerId)
+	assert.Equal(t, 100000.0, bill.Price)
+
+	inquiry.Payload.CustomerId = "51234567899"
+	_, err = product.Price(inquiry)
+	assert.EqualError(t, err, "meter 51234567899 is not registered")
+}
+
+func TestValidateMeterNumber(t *testing.T) {
+	assert.NoError(t, validateMeterNumber("51234567890"))
+	assert.NoError(t, validateMeterNumber("512345678901"))
+	assert.EqualError(t, validateMeterNumber("5123"), "meter number must be 11 or 12 digits")
+	assert.Error(t, validateMeterNumber("5123456789a"))
+}
+
+func TestIssueTokenFormatsToken(t *testing.T) {
+	detail, err := issueToken("PLNPRE-1", &model.Electricity{Kwh: 67.2, TariffClass: "R1"})
+	assert.NoError(t, err)
+	settled := detail.(*model.Electricity)
+
+	assert.Regexp(t, `^[0-9]{4}(-[0-9]{4}){4}$`, settled.Token)
+
+	var mail model.PayloadMail
+	receipt(settled, &mail)
+	assert.Equal(t, settled.Token, mail.Token)
+	assert.Equal(t, "67.20", mail.Kwh)
+	assert.Equal(t, "R1", mail.TariffClass)
+}
+
+func TestFormatToken(t *testing.T) {
+	assert.Equal(t, "1234-5678-9012-3456-7890", formatToken("12345678901234567890"))
+}
@@ -0,0 +1,294 @@
+package emoney
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/fulfilment"
+	"BE-Golang/usecase/notification"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// topUpTimeout is how long a top-up may stay pending at the provider before
+// it is failed and refunded.
+const topUpTimeout = 30 * time.Minute
+
+// transactionPrefix starts the ID of every e-money top-up.
+const transactionPrefix = "EMONEY"
+
+var (
+	walletPattern = regexp.MustCompile(`^08[0-9]{8,11}$`)
+	cardPattern   = regexp.MustCompile(`^[0-9]{16}$`)
+)
+
+type EMoneyUseCase interface {
+	CreateEMoneyUseCase(data model.EMoney) (model.EMoneyResponse, error)
+	GetAllEMoneyUseCase(data dto.EMoneyDto, isUser *bool) ([]model.EMoneyResponse, error)
+	GetEMoneyByIdUseCase(id string) (model.EMoneyResponse, error)
+	UpdateEMoneyByIdUseCase(id string, data model.EMoney) (model.EMoneyResponse, error)
+	DeleteEMoneyByIdUseCase(id string) error
+	AccountInquiryUseCase(payload dto.EMoneyInquiryDto) (*model.EMoneyAccount, error)
+	CreateTransactionEMoneyUseCase(userID string, payload dto.TransactionEMoneyDto) (*model.Transaction, error)
+	GetTransactionEMoneyUseCase(userID, transactionID string) (*model.Transaction, error)
+	RunPendingTopUpsUseCase(now time.Time) error
+}
+
+type eMoneyUseCase struct {
+	eMoneyRepository      repository.EMoneyRepository
+	providerRepository    repository.EMoneyProviderRepository
+	transactionRepository repository.TransactionRepository
+	discountRepository    repository.DiscountRepository
+	topUps                *fulfilment.Purchase
+}
+
+func NewEMoneyUseCase(eMoneyRepository repository.EMoneyRepository, providerRepository repository.EMoneyProviderRepository, transactionRepository repository.TransactionRepository, discountRepository repository.DiscountRepository, notificationUseCase notification.NotificationUseCase) *eMoneyUseCase {
+	return &eMoneyUseCase{
+		eMoneyRepository:      eMoneyRepository,
+		providerRepository:    providerRepository,
+		transactionRepository: transactionRepository,
+		discountRepository:    discountRepository,
+		topUps: &fulfilment.Purchase{
+			Name:          "e-money top-up",
+			Prefix:        transactionPrefix,
+			Timeout:       topUpTimeout,
+			Transactions:  transactionRepository,
+			Notifications: notificationUseCase,
+			NewDetail:     func() fulfilment.Detail { return &model.TransactionEMoney{} },
+			Send: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+				return topUpResult(providerRepository.TopUpRepository(transactionID, detail.(*model.TransactionEMoney)))
+			},
+			Poll: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+				return topUpResult(providerRepository.TopUpStatusRepository(transactionID, detail.(*model.TransactionEMoney)))
+			},
+			Notification: topUpNotification,
+		},
+	}
+}
+
+func (uc *eMoneyUseCase) CreateEMoneyUseCase(data model.EMoney) (model.EMoneyResponse, error) {
+	if err := validateEMoney(data); err != nil {
+		return model.EMoneyResponse{}, err
+	}
+
+	emoney, err := uc.eMoneyRepository.CreateEMoney(data)
+	if err != nil {
+		return model.EMoneyResponse{}, err
+	}
+
+	return toResponse(emoney), nil
+}
+
+func (uc *eMoneyUseCase) GetAllEMoneyUseCase(data dto.EMoneyDto, isUser *bool) ([]model.EMoneyResponse, error) {
+	emoney, err := uc.eMoneyRepository.GetAllEMoney(data, isUser)
+	if err != nil {
+		return []model.EMoneyResponse{}, err
+	}
+
+	response := make([]model.EMoneyResponse, 0, len(emoney))
+	for _, v := range emoney {
+		response = append(response, toResponse(v))
+	}
+
+	return response, nil
+}
+
+func (uc *eMoneyUseCase) GetEMoneyByIdUseCase(id string) (model.EMoneyResponse, error) {
+	emoney, err := uc.eMoneyRepository.GetEMoneyById(id)
+	if err != nil {
+		return model.EMoneyResponse{}, err
+	}
+
+	return toResponse(emoney), nil
+}
+
+func (uc *eMoneyUseCase) UpdateEMoneyByIdUseCase(id string, data model.EMoney) (model.EMoneyResponse, error) {
+	if data.Type != "" && data.Type != model.EMONEY_TYPE_WALLET && data.Type != model.EMONEY_TYPE_ETOLL {
+		return model.EMoneyResponse{}, fmt.Errorf("type must be %s or %s", model.EMONEY_TYPE_WALLET, model.EMONEY_TYPE_ETOLL)
+	}
+	if data.Nominal < 0 || data.Price < 0 {
+		return model.EMoneyResponse{}, errors.New("nominal and price must not be negative")
+	}
+
+	if err := uc.eMoneyRepository.UpdateEMoneyById(id, data); err != nil {
+		return model.EMoneyResponse{}, err
+	}
+
+	return uc.GetEMoneyByIdUseCase(id)
+}
+
+func (uc *eMoneyUseCase) DeleteEMoneyByIdUseCase(id string) error {
+	return uc.eMoneyRepository.DeleteEMoneyById(id)
+}
+
+func (uc *eMoneyUseCase) AccountInquiryUseCase(payload dto.EMoneyInquiryDto) (*model.EMoneyAccount, error) {
+	emoney, err := uc.getActiveEMoney(payload.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	return uc.accountInquiry(emoney, payload.AccountNumber)
+}
+
+func (uc *eMoneyUseCase) CreateTransactionEMoneyUseCase(userID string, payload dto.TransactionEMoneyDto) (*model.Transaction, error) {
+	emoney, err := uc.getActiveEMoney(payload.ProductID)
+	if err != nil {
+		return nil, err
+	}
+
+	account, err := uc.accountInquiry(emoney, payload.AccountNumber)
+	if err != nil {
+		return nil, err
+	}
+
+	discount, err := uc.discountRepository.GetDiscountByIdRepository(payload.DiscountID)
+	if err != nil {
+		return nil, errors.New("discount Not Found")
+	}
+
+	transaction := &model.Transaction{
+		ID:          fmt.Sprintf("%s-%s", transactionPrefix, uuid.New().String()),
+		UserID:      userID,
+		Status:      model.STATUS_PROCESSING,
+		ProductType: model.PRODUCT_EMONEY,
+		Description: fmt.Sprintf("Top Up %s %s", emoney.Provider, emoney.Name),
+		ProductDetail: model.TransactionEMoney{
+			Provider:      emoney.Provider,
+			AccountNumber: account.AccountNumber,
+			AccountName:   account.AccountName,
+			Name:          emoney.Name,
+			Code:          emoney.Code,
+			Nominal:       emoney.Nominal,
+			DiscountID:    discount.ID,
+		},
+		DiscountPrice: discount.DiscountPrice,
+		AdminFee:      model.ADMIN_FEE,
+		Price:         emoney.Price,
+		TotalPrice:    emoney.Price + model.ADMIN_FEE - discount.DiscountPrice,
+	}
+
+	if err := uc.topUps.Create(transaction); err != nil {
+		return nil, err
+	}
+
+	return transaction, nil
+}
+
+func (uc *eMoneyUseCase) GetTransactionEMoneyUseCase(userID, transactionID string) (*model.Transaction, error) {
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != userID || !strings.HasPrefix(transaction.ID, transactionPrefix+"-") {
+		return nil, fmt.Errorf("e-money transaction with ID %s not found", transactionID)
+	}
+
+	return transaction, nil
+}
+
+// RunPendingTopUpsUseCase sends processing top-ups to their provider once and
+// then follows their status. Top-ups the provider rejects, or reports pending
+// past topUpTimeout, are failed and the user's balance is refunded.
+func (uc *eMoneyUseCase) RunPendingTopUpsUseCase(now time.Time) error {
+	return uc.topUps.RunPending(now)
+}
+
+func topUpResult(result *model.EMoneyTopUpResult, err error) (*fulfilment.Result, error) {
+	if err != nil {
+		return nil, err
+	}
+
+	return &fulfilment.Result{
+		Status:            result.Status,
+		ProviderReference: result.ProviderReference,
+		Message:           result.Message,
+		Deliver: func(detail fulfilment.Detail) error {
+			detail.(*model.TransactionEMoney).ReferenceNumber = result.ReferenceNumber
+			return nil
+		},
+	}, nil
+}
+
+func topUpNotification(transaction *model.Transaction, status string, detail fulfilment.Detail) *model.Notification {
+	topUp := detail.(*model.TransactionEMoney)
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_EMONEY,
+		Title:    "Top Up Berhasil",
+		Message:  fmt.Sprintf("Top up %s Rp%.0f ke %s berhasil. No. Ref %s.", topUp.Provider, topUp.Nominal, topUp.AccountNumber, topUp.ReferenceNumber),
+	}
+	if status == model.STATUS_FAIL {
+		notification.Title = "Top Up Gagal"
+		notification.Message = fmt.Sprintf("Top up %s Rp%.0f ke %s gagal. Rp%.0f telah dikembalikan ke saldo Anda.", topUp.Provider, topUp.Nominal, topUp.AccountNumber, transaction.TotalPrice)
+	}
+
+	return notification
+}
+
+func (uc *eMoneyUseCase) getActiveEMoney(id string) (model.EMoney, error) {
+	emoney, err := uc.eMoneyRepository.GetEMoneyById(id)
+	if err != nil {
+		return model.EMoney{}, errors.New("e-money product not found")
+	}
+	if emoney.IsActive != nil && !*emoney.IsActive {
+		return model.EMoney{}, errors.New("e-money product is not available")
+	}
+
+	return emoney, nil
+}
+
+func (uc *eMoneyUseCase) accountInquiry(emoney model.EMoney, accountNumber string) (*model.EMoneyAccount, error) {
+	if err := validateAccountNumber(emoney.Type, accountNumber); err != nil {
+		return nil, err
+	}
+
+	return uc.providerRepository.AccountInquiryRepository(emoney.Provider, accountNumber)
+}
+
+// validateAccountNumber checks wallet phone numbers and 16 digit e-Toll card
+// numbers before the provider is asked for the account holder.
+func validateAccountNumber(emoneyType, accountNumber string) error {
+	switch emoneyType {
+	case model.EMONEY_TYPE_WALLET:
+		if !walletPattern.MatchString(accountNumber) {
+			return errors.New("invalid phone number")
+		}
+	case model.EMONEY_TYPE_ETOLL:
+		if !cardPattern.MatchString(accountNumber) {
+			return errors.New("card number must be 16 digits")
+		}
+	default:
+		return fmt.Errorf("unsupported e-money type %s", emoneyType)
+	}
+
+	return nil
+}
+
+func validateEMoney(data model.EMoney) error {
+	if data.Type != model.EMONEY_TYPE_WALLET && data.Type != model.EMONEY_TYPE_ETOLL {
+		return fmt.Errorf("type must be %s or %s", model.EMONEY_TYPE_WALLET, model.EMONEY_TYPE_ETOLL)
+	}
+	if data.Code == "" || data.Provider == "" {
+		return errors.New("code and provider are required")
+	}
+	if data.Nominal <= 0 || data.Price <= 0 {
+		return errors.New("nominal and price must be greater than 0")
+	}
+
+	return nil
+}
+
+func toResponse(emoney model.EMoney) model.EMoneyResponse {
+	return model.EMoneyResponse{
+		ID:          emoney.ID,
+		Name:        emoney.Name,
+		Type:        emoney.Type,
+		Code:        emoney.Code,
+		Provider:    emoney.Provider,
+		Nominal:     emoney.Nominal,
+		Price:       emoney.Price,
+		IsActive:    emoney.IsActive,
+		Description: emoney.Description,
+	}
+}
@@ -0,0 +1,254 @@
+package emoney
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/fulfilment"
+	"BE-Golang/usecase/mocks"
+	"BE-Golang/usecase/users"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type EMoneyUseCaseTest struct {
+	suite.Suite
+	eMoneyUseCase       EMoneyUseCase
+	eMoneyRepo          *repoMocks.EMoneyRepository
+	providerRepo        *repoMocks.EMoneyProviderRepository
+	transactionRepo     *repoMocks.TransactionRepository
+	discountRepo        *repoMocks.DiscountRepository
+	notificationUseCase *mocks.NotificationUseCase
+}
+
+func TestEMoneyUseCase(t *testing.T) {
+	suite.Run(t, new(EMoneyUseCaseTest))
+}
+
+func (m *EMoneyUseCaseTest) SetupTest() {
+	m.eMoneyRepo = &repoMocks.EMoneyRepository{}
+	m.providerRepo = &repoMocks.EMoneyProviderRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.discountRepo = &repoMocks.DiscountRepository{}
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.eMoneyUseCase = NewEMoneyUseCase(m.eMoneyRepo, m.providerRepo, m.transactionRepo, m.discountRepo, m.notificationUseCase)
+}
+
+func gopay() model.EMoney {
+	return model.EMoney{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "gopay-50"},
+		Name:           "GoPay 50.000",
+		Type:           model.EMONEY_TYPE_WALLET,
+		Code:           "GOPAY50",
+		Provider:       "GoPay",
+		Nominal:        50000,
+		Price:          51000,
+	}
+}
+
+func processingTopUp(createdAt time.Time) *model.Transaction {
+	return &model.Transaction{
+		ID:          "EMONEY-1",
+		UserID:      "user",
+		Status:      model.STATUS_PROCESSING,
+		ProductType: model.PRODUCT_EMONEY,
+		TotalPrice:  53500,
+		ProductDetail: map[string]interface{}{
+			"provider":       "GoPay",
+			"account_number": "081234567890",
+			"nominal":        50000,
+		},
+		CreatedAt: createdAt,
+	}
+}
+
+// submittedTopUp is a processing top-up that has already been sent to the
+// provider.
+func submittedTopUp(createdAt time.Time) *model.Transaction {
+	transaction := processingTopUp(createdAt)
+	transaction.ProductDetail.(map[string]interface{})["submitted_at"] = createdAt
+	return transaction
+}
+
+func (m *EMoneyUseCaseTest) TestCreateEMoneyInvalidType() {
+	data := gopay()
+	data.Type = "pulsa"
+
+	_, err := m.eMoneyUseCase.CreateEMoneyUseCase(data)
+
+	assert.EqualError(m.T(), err, "type must be wallet or etoll")
+}
+
+func (m *EMoneyUseCaseTest) TestAccountInquirySuccess() {
+	m.eMoneyRepo.On("GetEMoneyById", "gopay-50").Return(gopay(), nil)
+	m.providerRepo.On("AccountInquiryRepository", "GoPay", "081234567890").Return(&model.EMoneyAccount{AccountName: "Budi Santoso"}, nil)
+
+	resp, err := m.eMoneyUseCase.AccountInquiryUseCase(dto.EMoneyInquiryDto{ProductID: "gopay-50", AccountNumber: "081234567890"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "Budi Santoso", resp.AccountName)
+}
+
+func (m *EMoneyUseCaseTest) TestAccountInquiryInvalidCardNumber() {
+	etoll := gopay()
+	etoll.Type = model.EMONEY_TYPE_ETOLL
+	m.eMoneyRepo.On("GetEMoneyById", "etoll").Return(etoll, nil)
+
+	_, err := m.eMoneyUseCase.AccountInquiryUseCase(dto.EMoneyInquiryDto{ProductID: "etoll", AccountNumber: "081234567890"})
+
+	assert.EqualError(m.T(), err, "card number must be 16 digits")
+	m.providerRepo.AssertNotCalled(m.T(), "AccountInquiryRepository", mock.Anything, mock.Anything)
+}
+
+func (m *EMoneyUseCaseTest) TestAccountInquiryInactiveProduct() {
+	inactive := gopay()
+	isActive := false
+	inactive.IsActive = &isActive
+	m.eMoneyRepo.On("GetEMoneyById", "gopay-50").Return(inactive, nil)
+
+	_, err := m.eMoneyUseCase.AccountInquiryUseCase(dto.EMoneyInquiryDto{ProductID: "gopay-50", AccountNumber: "081234567890"})
+
+	assert.EqualError(m.T(), err, "e-money product is not available")
+}
+
+func (m *EMoneyUseCaseTest) TestCreateTransactionBalanceNotEnough() {
+	m.eMoneyRepo.On("GetEMoneyById", "gopay-50").Return(gopay(), nil)
+	m.providerRepo.On("AccountInquiryRepository", "GoPay", "081234567890").Return(&model.EMoneyAccount{AccountNumber: "081234567890"}, nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(nil, users.ErrBalanceNotEnough)
+
+	_, err := m.eMoneyUseCase.CreateTransactionEMoneyUseCase("user", dto.TransactionEMoneyDto{ProductID: "gopay-50", AccountNumber: "081234567890"})
+
+	assert.Equal(m.T(), users.ErrBalanceNotEnough, err)
+}
+
+func (m *EMoneyUseCaseTest) TestCreateTransactionSuccess() {
+	m.eMoneyRepo.On("GetEMoneyById", "gopay-50").Return(gopay(), nil)
+	m.providerRepo.On("AccountInquiryRepository", "GoPay", "081234567890").Return(&model.EMoneyAccount{AccountNumber: "081234567890", AccountName: "Budi Santoso"}, nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.UserID == "user" && t.TotalPrice == 53500
+	})).Return(&model.Transaction{}, nil)
+
+	resp, err := m.eMoneyUseCase.CreateTransactionEMoneyUseCase("user", dto.TransactionEMoneyDto{ProductID: "gopay-50", AccountNumber: "081234567890"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_PROCESSING, resp.Status)
+	assert.Equal(m.T(), 53500.0, resp.TotalPrice)
+	assert.Equal(m.T(), "Budi Santoso", resp.ProductDetail.(model.TransactionEMoney).AccountName)
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsSubmitsOnce() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{processingTopUp(now)}, nil)
+	var submitted bool
+	m.transactionRepo.On("SubmitProcessingTransactionRepository", "EMONEY-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_PROCESSING && t.ProductDetail.(*model.TransactionEMoney).SubmittedAt != nil
+	})).Run(func(args mock.Arguments) { submitted = true }).Return(true, nil).Once()
+	m.providerRepo.On("TopUpRepository", "EMONEY-1", mock.Anything).Run(func(args mock.Arguments) {
+		assert.True(m.T(), submitted, "submission must be recorded before the top-up is sent")
+	}).Return(&model.EMoneyTopUpResult{Status: model.STATUS_SUCCESSFUL, ProviderReference: "PRV1", ReferenceNumber: "REF1"}, nil)
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", "EMONEY-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		detail := t.ProductDetail.(*model.TransactionEMoney)
+		return t.Status == model.STATUS_SUCCESSFUL && detail.ReferenceNumber == "REF1" && detail.ProviderReference == "PRV1"
+	})).Return(nil).Once()
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Top Up Berhasil"
+	})).Return(nil)
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.notificationUseCase.AssertExpectations(m.T())
+	m.transactionRepo.AssertExpectations(m.T())
+	m.transactionRepo.AssertNotCalled(m.T(), "RefundProcessingTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsPollsSubmitted() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{submittedTopUp(now.Add(-time.Minute))}, nil)
+	m.providerRepo.On("TopUpStatusRepository", "EMONEY-1", mock.Anything).Return(&model.EMoneyTopUpResult{Status: model.STATUS_PROCESSING, Message: "pending"}, nil)
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", "EMONEY-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		detail := t.ProductDetail.(*model.TransactionEMoney)
+		return t.Status == model.STATUS_PROCESSING && detail.Error == "pending" && detail.Attempts == 1
+	})).Return(nil)
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.providerRepo.AssertNotCalled(m.T(), "TopUpRepository", mock.Anything, mock.Anything)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsRejectedRefunds() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{submittedTopUp(now)}, nil)
+	m.providerRepo.On("TopUpStatusRepository", "EMONEY-1", mock.Anything).Return(&model.EMoneyTopUpResult{Status: model.STATUS_FAIL, Message: "rejected"}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.ID == "EMONEY-1" && t.UserID == "user"
+	}), mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_FAIL && t.ProductDetail.(*model.TransactionEMoney).Error == "rejected"
+	})).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.transactionRepo.AssertExpectations(m.T())
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsNoRefundWhenAlreadyFinished() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{submittedTopUp(now)}, nil)
+	m.providerRepo.On("TopUpStatusRepository", "EMONEY-1", mock.Anything).Return(&model.EMoneyTopUpResult{Status: model.STATUS_FAIL}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.Anything).Return(errors.New("transaction is no longer processing"))
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.Error(m.T(), err)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsProviderErrorStaysPending() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{submittedTopUp(now.Add(-time.Hour))}, nil)
+	m.providerRepo.On("TopUpStatusRepository", "EMONEY-1", mock.Anything).Return(nil, errors.New("timeout"))
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", "EMONEY-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_PROCESSING
+	})).Return(nil)
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.transactionRepo.AssertNotCalled(m.T(), "RefundProcessingTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *EMoneyUseCaseTest) TestRunPendingTopUpsTimesOut() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "EMONEY", fulfilment.BatchSize).Return([]*model.Transaction{submittedTopUp(now.Add(-time.Hour))}, nil)
+	m.providerRepo.On("TopUpStatusRepository", "EMONEY-1", mock.Anything).Return(&model.EMoneyTopUpResult{Status: model.STATUS_PROCESSING}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_FAIL && t.ProductDetail.(*model.TransactionEMoney).Error == "purchase timed out at the provider"
+	})).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Top Up Gagal"
+	})).Return(nil)
+
+	err := m.eMoneyUseCase.RunPendingTopUpsUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.notificationUseCase.AssertExpectations(m.T())
+}
+
+func (m *EMoneyUseCaseTest) TestGetTransactionEMoneyOtherUser() {
+	m.transactionRepo.On("GetTransactionByIdRepository", "EMONEY-1").Return(processingTopUp(time.Now()), nil)
+
+	_, err := m.eMoneyUseCase.GetTransactionEMoneyUseCase("other", "EMONEY-1")
+
+	assert.EqualError(m.T(), err, "e-money transaction with ID EMONEY-1 not found")
+}
@@ -0,0 +1,265 @@
+package fulfilment
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/availability"
+	"BE-Golang/usecase/notification"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"time"
+)
+
+// BatchSize is how many pending purchases one run follows.
+const BatchSize = 100
+
+// Detail is the product detail of a purchase. Details embed model.Fulfilment.
+type Detail interface {
+	Tracking() *model.Fulfilment
+}
+
+// Result is a provider's answer about a purchase. Status is one of
+// STATUS_PROCESSING, STATUS_SUCCESSFUL or STATUS_FAIL.
+type Result struct {
+	Status            string
+	ProviderReference string
+	Message           string
+	// Deliver copies what a successful purchase delivered into its detail,
+	// e.g. the serial number. An error keeps the purchase pending, so it is
+	// delivered again on the next run instead of being lost.
+	Deliver func(detail Detail) error
+}
+
+// Purchase sends purchases the user has paid for to their provider and
+// follows them until they succeed or fail. Failed purchases are refunded.
+type Purchase struct {
+	// Name names the purchase in logs and errors, e.g. "e-money top-up".
+	Name string
+	// Prefix starts the ID of every purchase.
+	Prefix string
+	// Timeout is how long a purchase may stay pending before it is failed
+	// and refunded.
+	Timeout time.Duration
+	// ReconcileAfter gives a purchase sent while the user waits time to
+	// settle before a pending run touches it.
+	ReconcileAfter time.Duration
+
+	Transactions  repository.TransactionRepository
+	Notifications notification.NotificationUseCase
+
+	// NewDetail returns the empty product detail purchases are decoded into.
+	NewDetail func() Detail
+	// Send sends a purchase to its provider; Poll asks how it ended.
+	Send func(transactionID string, detail Detail) (*Result, error)
+	Poll func(transactionID string, detail Detail) (*Result, error)
+	// Notification tells the user how a purchase ended.
+	Notification func(transaction *model.Transaction, status string, detail Detail) *model.Notification
+	// Receipt, when set, is sent after a successful purchase.
+	Receipt func(transaction *model.Transaction, detail Detail)
+
+	// Availability, when set, records the failures and successes of the
+	// provider Provider names under AvailabilityProduct.
+	Availability        repository.ProductAvailabilityRepository
+	AvailabilityProduct string
+	Provider            func(detail Detail) string
+}
+
+// Create charges the user and stores the purchase in one database
+// transaction, so only purchases that were paid for are ever sent.
+func (p *Purchase) Create(transaction *model.Transaction) error {
+	if _, err := p.Transactions.CreatePaidTransactionRepository(transaction); err != nil {
+		if errors.Is(err, repository.ErrBalanceNotEnough) {
+			return err
+		}
+		return fmt.Errorf("error creating %s in database: %w", p.Name, err)
+	}
+
+	return nil
+}
+
+// Decode reads the product detail of a purchase.
+func (p *Purchase) Decode(transaction *model.Transaction) (Detail, error) {
+	detail := p.NewDetail()
+	if err := DecodeDetail(transaction, detail); err != nil {
+		return nil, err
+	}
+
+	return detail, nil
+}
+
+// Submit sends a stored purchase to its provider and saves how it ended. A
+// purchase another run has already sent is left to that run.
+func (p *Purchase) Submit(transaction *model.Transaction, detail Detail, now time.Time) (string, error) {
+	// Record the submission first, so the purchase is never sent twice even
+	// if the provider's answer is lost.
+	detail.Tracking().SubmittedAt = &now
+	update := &model.Transaction{
+		Status:        model.STATUS_PROCESSING,
+		ProductDetail: detail,
+		UpdatedAt:     time.Now(),
+	}
+	submitted, err := p.Transactions.SubmitProcessingTransactionRepository(transaction.ID, update)
+	if err != nil {
+		return "", fmt.Errorf("error updating %s in database: %w", p.Name, err)
+	}
+	if !submitted {
+		return model.STATUS_PROCESSING, nil
+	}
+
+	result, err := p.Send(transaction.ID, detail)
+
+	return p.settle(transaction, detail, p.answer(transaction, detail, result, err, now))
+}
+
+// RunPending follows processing purchases. Purchases that were never sent
+// are sent, others are polled. Purchases the provider rejects, or reports
+// pending past Timeout, are failed and refunded.
+func (p *Purchase) RunPending(now time.Time) error {
+	transactions, err := p.Transactions.GetProcessingTransactionsByPrefixRepository(p.Prefix, BatchSize)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, transaction := range transactions {
+		if err := p.follow(transaction, now); err != nil {
+			log.Printf("%s %s: %v", p.Name, transaction.ID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to process %d of %d %ss", failed, len(transactions), p.Name)
+	}
+
+	return nil
+}
+
+func (p *Purchase) follow(transaction *model.Transaction, now time.Time) error {
+	detail, err := p.Decode(transaction)
+	if err != nil {
+		return err
+	}
+
+	tracking := detail.Tracking()
+	if tracking.SubmittedAt == nil {
+		// A purchase stored but not sent in time, e.g. because the server
+		// stopped right after storing it, is refunded rather than sent late.
+		if now.Sub(transaction.CreatedAt) > p.Timeout {
+			_, err := p.settle(transaction, detail, &Result{Status: model.STATUS_FAIL, Message: "purchase was never sent to the provider"})
+			return err
+		}
+		if now.Sub(transaction.CreatedAt) < p.ReconcileAfter {
+			return nil
+		}
+
+		_, err := p.Submit(transaction, detail, now)
+		return err
+	}
+	if now.Sub(*tracking.SubmittedAt) < p.ReconcileAfter {
+		return nil
+	}
+
+	tracking.Attempts++
+	result, err := p.Poll(transaction.ID, detail)
+	_, err = p.settle(transaction, detail, p.answer(transaction, detail, result, err, now))
+
+	return err
+}
+
+// answer turns the outcome of a provider call into a result and records it
+// against the provider's availability.
+func (p *Purchase) answer(transaction *model.Transaction, detail Detail, result *Result, err error, now time.Time) *Result {
+	if err != nil {
+		// The purchase may have gone through, so it stays pending until the
+		// provider tells how it ended.
+		if p.Availability != nil {
+			availability.RecordFailure(p.Availability, p.AvailabilityProduct, p.Provider(detail), now)
+		}
+		return &Result{Status: model.STATUS_PROCESSING, Message: err.Error()}
+	}
+
+	if p.Availability != nil {
+		availability.RecordSuccess(p.Availability, p.AvailabilityProduct, p.Provider(detail))
+	}
+	if result.Status == model.STATUS_PROCESSING && now.Sub(transaction.CreatedAt) > p.Timeout {
+		return &Result{Status: model.STATUS_FAIL, ProviderReference: result.ProviderReference, Message: "purchase timed out at the provider"}
+	}
+
+	return result
+}
+
+// settle saves how a purchase ended, refunds it when it failed and tells the
+// user once it has finished.
+func (p *Purchase) settle(transaction *model.Transaction, detail Detail, result *Result) (string, error) {
+	tracking := detail.Tracking()
+	if result.ProviderReference != "" {
+		tracking.ProviderReference = result.ProviderReference
+	}
+	tracking.Error = result.Message
+
+	status := result.Status
+	switch status {
+	case model.STATUS_SUCCESSFUL:
+		tracking.Error = ""
+		if result.Deliver != nil {
+			if err := result.Deliver(detail); err != nil {
+				status = model.STATUS_PROCESSING
+				tracking.Error = err.Error()
+			}
+		}
+	case model.STATUS_FAIL:
+		if tracking.Error == "" {
+			tracking.Error = "purchase failed"
+		}
+	default:
+		status = model.STATUS_PROCESSING
+	}
+
+	update := &model.Transaction{
+		Status:        status,
+		ProductDetail: detail,
+		UpdatedAt:     time.Now(),
+	}
+	if status == model.STATUS_FAIL {
+		// Failing the purchase and refunding it happen together, so a
+		// purchase finished by another run is never refunded twice.
+		if err := p.Transactions.RefundProcessingTransactionRepository(transaction, update); err != nil {
+			return "", fmt.Errorf("failed to refund %.2f: %w", transaction.TotalPrice, err)
+		}
+	} else if err := p.Transactions.UpdateProcessingTransactionRepository(transaction.ID, update); err != nil {
+		return "", fmt.Errorf("error updating %s in database: %w", p.Name, err)
+	}
+
+	if status != model.STATUS_PROCESSING {
+		p.notify(transaction, status, detail)
+	}
+
+	return status, nil
+}
+
+func (p *Purchase) notify(transaction *model.Transaction, status string, detail Detail) {
+	if err := p.Notifications.SendNotificationUseCase(transaction.UserID, p.Notification(transaction, status, detail)); err != nil {
+		log.Printf("%s %s: failed to notify user: %v", p.Name, transaction.ID, err)
+	}
+
+	if status == model.STATUS_SUCCESSFUL && p.Receipt != nil {
+		p.Receipt(transaction, detail)
+	}
+}
+
+// DecodeDetail reads the stored product detail of a transaction into detail.
+func DecodeDetail(transaction *model.Transaction, detail interface{}) error {
+	jsonData, err := json.Marshal(transaction.ProductDetail)
+	if err != nil {
+		return fmt.Errorf("error serializing transaction detail to JSON: %w", err)
+	}
+
+	if err := json.Unmarshal(jsonData, detail); err != nil {
+		return fmt.Errorf("error Unmarshal: %w", err)
+	}
+
+	return nil
+}
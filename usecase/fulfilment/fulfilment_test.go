@@ -0,0 +1,145 @@
+package fulfilment
+
+import (
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+type testDetail struct {
+	Provider string `json:"provider"`
+	Serial   string `json:"serial"`
+	model.Fulfilment
+}
+
+func pending(createdAt time.Time, submittedAt *time.Time) *model.Transaction {
+	return &model.Transaction{
+		ID:            "TEST-1",
+		UserID:        "user",
+		Status:        model.STATUS_PROCESSING,
+		TotalPrice:    10000,
+		ProductDetail: testDetail{Provider: "Telkomsel", Fulfilment: model.Fulfilment{SubmittedAt: submittedAt}},
+		CreatedAt:     createdAt,
+	}
+}
+
+func newPurchase(t *testing.T, result *Result, err error) (*Purchase, *repoMocks.TransactionRepository, *mocks.NotificationUseCase) {
+	transactions := repoMocks.NewTransactionRepository(t)
+	notifications := mocks.NewNotificationUseCase(t)
+	answer := func(transactionID string, detail Detail) (*Result, error) {
+		return result, err
+	}
+
+	return &Purchase{
+		Name:          "test purchase",
+		Prefix:        "TEST",
+		Timeout:       30 * time.Minute,
+		Transactions:  transactions,
+		Notifications: notifications,
+		NewDetail:     func() Detail { return &testDetail{} },
+		Send:          answer,
+		Poll:          answer,
+		Notification: func(transaction *model.Transaction, status string, detail Detail) *model.Notification {
+			return &model.Notification{Title: status}
+		},
+	}, transactions, notifications
+}
+
+func TestSubmitSentByAnotherRun(t *testing.T) {
+	purchase, transactions, _ := newPurchase(t, nil, nil)
+	purchase.Send = func(transactionID string, detail Detail) (*Result, error) {
+		t.Errorf("purchase %s was sent twice", transactionID)
+		return nil, nil
+	}
+	transactions.On("SubmitProcessingTransactionRepository", "TEST-1", mock.Anything).Return(false, nil)
+
+	status, err := purchase.Submit(pending(time.Now(), nil), &testDetail{}, time.Now())
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.STATUS_PROCESSING, status)
+}
+
+func TestRunPendingSendsAndDelivers(t *testing.T) {
+	now := time.Now()
+	purchase, transactions, notifications := newPurchase(t, &Result{Status: model.STATUS_SUCCESSFUL, ProviderReference: "PRV1", Deliver: func(detail Detail) error {
+		detail.(*testDetail).Serial = "SN1"
+		return nil
+	}}, nil)
+	transactions.On("GetProcessingTransactionsByPrefixRepository", "TEST", BatchSize).Return([]*model.Transaction{pending(now, nil)}, nil)
+	transactions.On("SubmitProcessingTransactionRepository", "TEST-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.ProductDetail.(*testDetail).SubmittedAt != nil
+	})).Return(true, nil)
+	transactions.On("UpdateProcessingTransactionRepository", "TEST-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		detail := update.ProductDetail.(*testDetail)
+		return update.Status == model.STATUS_SUCCESSFUL && detail.Serial == "SN1" && detail.ProviderReference == "PRV1"
+	})).Return(nil)
+	notifications.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	assert.NoError(t, purchase.RunPending(now))
+}
+
+func TestRunPendingDeliveryErrorStaysPending(t *testing.T) {
+	now := time.Now()
+	purchase, transactions, _ := newPurchase(t, &Result{Status: model.STATUS_SUCCESSFUL, Deliver: func(detail Detail) error {
+		return errors.New("failed to seal")
+	}}, nil)
+	transactions.On("GetProcessingTransactionsByPrefixRepository", "TEST", BatchSize).Return([]*model.Transaction{pending(now, &now)}, nil)
+	transactions.On("UpdateProcessingTransactionRepository", "TEST-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.Status == model.STATUS_PROCESSING && update.ProductDetail.(*testDetail).Error == "failed to seal"
+	})).Return(nil)
+
+	assert.NoError(t, purchase.RunPending(now))
+}
+
+func TestRunPendingRefundsNeverSent(t *testing.T) {
+	now := time.Now()
+	purchase, transactions, notifications := newPurchase(t, nil, nil)
+	purchase.Send = func(transactionID string, detail Detail) (*Result, error) {
+		t.Errorf("purchase %s was sent after its timeout", transactionID)
+		return nil, nil
+	}
+	transactions.On("GetProcessingTransactionsByPrefixRepository", "TEST", BatchSize).Return([]*model.Transaction{pending(now.Add(-time.Hour), nil)}, nil)
+	transactions.On("RefundProcessingTransactionRepository", mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.UserID == "user" && transaction.TotalPrice == 10000
+	}), mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.Status == model.STATUS_FAIL && update.ProductDetail.(*testDetail).Error == "purchase was never sent to the provider"
+	})).Return(nil)
+	notifications.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == model.STATUS_FAIL
+	})).Return(nil)
+
+	assert.NoError(t, purchase.RunPending(now))
+}
+
+func TestRunPendingWaitsBeforeReconciling(t *testing.T) {
+	now := time.Now()
+	purchase, transactions, _ := newPurchase(t, nil, nil)
+	purchase.ReconcileAfter = time.Minute
+	transactions.On("GetProcessingTransactionsByPrefixRepository", "TEST", BatchSize).Return([]*model.Transaction{pending(now, nil), pending(now, &now)}, nil)
+
+	assert.NoError(t, purchase.RunPending(now))
+}
+
+func TestRunPendingRecordsProviderAvailability(t *testing.T) {
+	now := time.Now()
+	purchase, transactions, _ := newPurchase(t, nil, errors.New("timeout"))
+	availability := repoMocks.NewProductAvailabilityRepository(t)
+	purchase.Availability = availability
+	purchase.AvailabilityProduct = model.AVAILABILITY_PPD
+	purchase.Provider = func(detail Detail) string { return detail.(*testDetail).Provider }
+	transactions.On("GetProcessingTransactionsByPrefixRepository", "TEST", BatchSize).Return([]*model.Transaction{pending(now, &now)}, nil)
+	availability.On("AddProductAvailabilityFailureRepository", model.AVAILABILITY_PPD, "telkomsel").Return(nil)
+	availability.On("TripProductAvailabilityRepository", model.AVAILABILITY_PPD, "telkomsel", mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	transactions.On("UpdateProcessingTransactionRepository", "TEST-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		detail := update.ProductDetail.(*testDetail)
+		return update.Status == model.STATUS_PROCESSING && detail.Error == "timeout" && detail.Attempts == 1
+	})).Return(nil)
+
+	assert.NoError(t, purchase.RunPending(now))
+}
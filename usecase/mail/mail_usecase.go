@@ -54,3 +54,30 @@ func SendingMail(payload model.PayloadMail) {
 
 	log.Println("Mail sent!")
 }
+
+func SendingNotificationMail(payload model.PayloadMail) error {
+	tmpl, err := template.ParseFiles("usecase/mail/notification.html")
+	if err != nil {
+		return err
+	}
+
+	var emailBody bytes.Buffer
+	err = tmpl.Execute(&emailBody, payload)
+	if err != nil {
+		return err
+	}
+
+	mailer := gomail.NewMessage()
+	mailer.SetHeader("From", config.AppConfig.SenderEmail)
+	mailer.SetHeader("To", payload.RecipentEmail)
+	mailer.SetHeader("Subject", payload.Subject)
+	mailer.SetBody("text/html", emailBody.String())
+
+	dialer := gomail.NewDialer(
+		config.AppConfig.SmtpHost,
+		config.AppConfig.SmtpPort,
+		config.AppConfig.SenderEmail,
+		config.AppConfig.EmailPassword,
+	)
+	return dialer.DialAndSend(mailer)
+}
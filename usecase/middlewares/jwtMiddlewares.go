@@ -3,6 +3,8 @@ package middlewares
 import (
 	"BE-Golang/config"
 	"BE-Golang/model"
+	"BE-Golang/repository"
+	"log"
 	"net/http"
 
 	"strings"
@@ -13,17 +15,23 @@ import (
 )
 
 type Middlewares interface {
-	CreateToken(user model.User) (string, error)
+	CreateToken(user model.User, sessionID, tokenID string, expiresAt time.Time) (string, error)
 	ExtractTokenUserId(userType string, c echo.Context) string
+	ExtractTokenSessionId(c echo.Context) string
 	AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc
+	RevokedTokenMiddleware(sessionRepository repository.SessionRepository) echo.MiddlewareFunc
 }
 
-func CreateToken(user model.User) (string, error) {
+// CreateToken signs an access token of a session. tokenID becomes the jti
+// claim, which is what revocation denylists.
+func CreateToken(user model.User, sessionID, tokenID string, expiresAt time.Time) (string, error) {
 	claims := jwt.MapClaims{}
 	claims["authorized"] = true
 	claims["userId"] = user.ID
 	claims["user_type"] = user.UserType
-	claims["exp"] = time.Now().Add(time.Hour * 24 * 30).Unix()
+	claims["sid"] = sessionID
+	claims["jti"] = tokenID
+	claims["exp"] = expiresAt.Unix()
 
 	token := jwt.NewWithClaims(jwt.SigningMethodHS256, claims)
 	return token.SignedString([]byte(config.AppConfig.SecretJWT))
@@ -55,6 +63,63 @@ func ExtractTokenUserId(userType string, c echo.Context) string {
 	}
 }
 
+// ExtractTokenSessionId returns the session an access token belongs to.
+func ExtractTokenSessionId(c echo.Context) string {
+	claims := extractClaims(c)
+	if claims == nil {
+		return ""
+	}
+
+	sessionID, _ := claims["sid"].(string)
+	return sessionID
+}
+
+// RevokedTokenMiddleware rejects access tokens revoked by a logout or a
+// password or PIN change, and tokens issued before they could be revoked.
+// It runs after the JWT middleware has checked the signature and expiry.
+func RevokedTokenMiddleware(sessionRepository repository.SessionRepository) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			claims := extractClaims(c)
+			if claims == nil {
+				return echo.NewHTTPError(http.StatusUnauthorized, "invalid or expired token")
+			}
+
+			tokenID, _ := claims["jti"].(string)
+			if tokenID == "" {
+				return echo.NewHTTPError(http.StatusUnauthorized, "token has been revoked, please log in again")
+			}
+
+			revoked, err := sessionRepository.IsTokenRevokedRepository(tokenID)
+			if err != nil {
+				log.Printf("token revocation check: %v", err)
+				return echo.NewHTTPError(http.StatusInternalServerError, "failed to check token")
+			}
+			if revoked {
+				return echo.NewHTTPError(http.StatusUnauthorized, "token has been revoked, please log in again")
+			}
+
+			return next(c)
+		}
+	}
+}
+
+func extractClaims(c echo.Context) jwt.MapClaims {
+	tokenString := strings.TrimPrefix(c.Request().Header.Get("Authorization"), "Bearer ")
+	if tokenString == "" {
+		return nil
+	}
+
+	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
+		return []byte(config.AppConfig.SecretJWT), nil
+	})
+	if err != nil || !token.Valid {
+		return nil
+	}
+
+	return token.Claims.(jwt.MapClaims)
+}
+
 func AuthMiddleware(next echo.HandlerFunc) echo.HandlerFunc {
 	return func(c echo.Context) error {
 		authHeader := c.Request().Header.Get("Authorization")
@@ -0,0 +1,274 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	biller "BE-Golang/usecase/biller"
+
+	mock "github.com/stretchr/testify/mock"
+
+	model "BE-Golang/model"
+
+	time "time"
+)
+
+// BillerUseCase is an autogenerated mock type for the BillerUseCase type
+type BillerUseCase struct {
+	mock.Mock
+}
+
+// BillInquiryUseCase provides a mock function with given fields: code, userId, payload
+func (_m *BillerUseCase) BillInquiryUseCase(code string, userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(code, userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(code, userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(code, userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *model.OyBillerApi) error); ok {
+		r1 = rf(code, userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillStatusUseCase provides a mock function with given fields: code, payload
+func (_m *BillerUseCase) BillStatusUseCase(code string, payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	ret := _m.Called(code, payload)
+
+	var r0 *model.OyBillerApiResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.OyBillerApiResponse, error)); ok {
+		return rf(code, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.OyBillerApiResponse); ok {
+		r0 = rf(code, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OyBillerApiResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(code, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CancelInquiryUseCase provides a mock function with given fields: userId, transactionID
+func (_m *BillerUseCase) CancelInquiryUseCase(userId string, transactionID string) (*model.Transaction, error) {
+	ret := _m.Called(userId, transactionID)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string) (*model.Transaction, error)); ok {
+		return rf(userId, transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(string, string) *model.Transaction); ok {
+		r0 = rf(userId, transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(userId, transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductByTransactionIdUseCase provides a mock function with given fields: transactionID
+func (_m *BillerUseCase) GetProductByTransactionIdUseCase(transactionID string) (*biller.Product, error) {
+	ret := _m.Called(transactionID)
+
+	var r0 *biller.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*biller.Product, error)); ok {
+		return rf(transactionID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *biller.Product); ok {
+		r0 = rf(transactionID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*biller.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(transactionID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductStatusesUseCase provides a mock function with given fields:
+func (_m *BillerUseCase) GetProductStatusesUseCase() []biller.ProductStatus {
+	ret := _m.Called()
+
+	var r0 []biller.ProductStatus
+	if rf, ok := ret.Get(0).(func() []biller.ProductStatus); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]biller.ProductStatus)
+		}
+	}
+
+	return r0
+}
+
+// GetProductUseCase provides a mock function with given fields: code
+func (_m *BillerUseCase) GetProductUseCase(code string) (*biller.Product, error) {
+	ret := _m.Called(code)
+
+	var r0 *biller.Product
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*biller.Product, error)); ok {
+		return rf(code)
+	}
+	if rf, ok := ret.Get(0).(func(string) *biller.Product); ok {
+		r0 = rf(code)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*biller.Product)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(code)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetProductsUseCase provides a mock function with given fields:
+func (_m *BillerUseCase) GetProductsUseCase() []*biller.Product {
+	ret := _m.Called()
+
+	var r0 []*biller.Product
+	if rf, ok := ret.Get(0).(func() []*biller.Product); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*biller.Product)
+		}
+	}
+
+	return r0
+}
+
+// PayBillUseCase provides a mock function with given fields: code, userId, payload
+func (_m *BillerUseCase) PayBillUseCase(code string, userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(code, userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(code, userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(code, userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, string, *model.OyBillerApi) error); ok {
+		r1 = rf(code, userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResendReceiptUseCase provides a mock function with given fields: code, userId, transactionID
+func (_m *BillerUseCase) ResendReceiptUseCase(code string, userId string, transactionID string) error {
+	ret := _m.Called(code, userId, transactionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, string) error); ok {
+		r0 = rf(code, userId, transactionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RunExpireInquiriesUseCase provides a mock function with given fields: now
+func (_m *BillerUseCase) RunExpireInquiriesUseCase(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SettleBillUseCase provides a mock function with given fields: user, transactionID, adminFee, totalPrice
+func (_m *BillerUseCase) SettleBillUseCase(user *model.User, transactionID string, adminFee float64, totalPrice float64) (*model.Transaction, error) {
+	ret := _m.Called(user, transactionID, adminFee, totalPrice)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.User, string, float64, float64) (*model.Transaction, error)); ok {
+		return rf(user, transactionID, adminFee, totalPrice)
+	}
+	if rf, ok := ret.Get(0).(func(*model.User, string, float64, float64) *model.Transaction); ok {
+		r0 = rf(user, transactionID, adminFee, totalPrice)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.User, string, float64, float64) error); ok {
+		r1 = rf(user, transactionID, adminFee, totalPrice)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewBillerUseCase creates a new instance of BillerUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewBillerUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *BillerUseCase {
+	mock := &BillerUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,332 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// ElectricityUseCase is an autogenerated mock type for the ElectricityUseCase type
+type ElectricityUseCase struct {
+	mock.Mock
+}
+
+// BillElectricityStatusUseCase provides a mock function with given fields: payload
+func (_m *ElectricityUseCase) BillElectricityStatusUseCase(payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.OyBillerApiResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) (*model.OyBillerApiResponse, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) *model.OyBillerApiResponse); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OyBillerApiResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OyBillerApi) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateElectricityUseCase provides a mock function with given fields: payload
+func (_m *ElectricityUseCase) CreateElectricityUseCase(payload *model.Electricity) (*model.Electricity, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.Electricity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Electricity) (*model.Electricity, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Electricity) *model.Electricity); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Electricity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Electricity) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteElectricityByIDUseCase provides a mock function with given fields: userId
+func (_m *ElectricityUseCase) DeleteElectricityByIDUseCase(userId string) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllElectricityUseCase provides a mock function with given fields: page, limit
+func (_m *ElectricityUseCase) GetAllElectricityUseCase(page int, limit int) ([]*model.Electricity, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.Electricity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.Electricity, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.Electricity); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Electricity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetElectricityByIdUseCase provides a mock function with given fields: electricityId
+func (_m *ElectricityUseCase) GetElectricityByIdUseCase(electricityId string) (*model.Electricity, error) {
+	ret := _m.Called(electricityId)
+
+	var r0 *model.Electricity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Electricity, error)); ok {
+		return rf(electricityId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Electricity); ok {
+		r0 = rf(electricityId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Electricity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(electricityId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetTokenDenominationsUseCase provides a mock function with given fields:
+func (_m *ElectricityUseCase) GetTokenDenominationsUseCase() []model.TokenDenomination {
+	ret := _m.Called()
+
+	var r0 []model.TokenDenomination
+	if rf, ok := ret.Get(0).(func() []model.TokenDenomination); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.TokenDenomination)
+		}
+	}
+
+	return r0
+}
+
+// PostBillInquiryElectricityUseCase provides a mock function with given fields: userId, payload
+func (_m *ElectricityUseCase) PostBillInquiryElectricityUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PostPayBillElectricityUseCase provides a mock function with given fields: userId, payload
+func (_m *ElectricityUseCase) PostPayBillElectricityUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PreBillInquiryElectricityUseCase provides a mock function with given fields: userId, payload
+func (_m *ElectricityUseCase) PreBillInquiryElectricityUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrePayBillElectricityUseCase provides a mock function with given fields: userId, payload
+func (_m *ElectricityUseCase) PrePayBillElectricityUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PrepaidMeterInquiryUseCase provides a mock function with given fields: meterNumber
+func (_m *ElectricityUseCase) PrepaidMeterInquiryUseCase(meterNumber string) (*model.PlnMeter, error) {
+	ret := _m.Called(meterNumber)
+
+	var r0 *model.PlnMeter
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.PlnMeter, error)); ok {
+		return rf(meterNumber)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.PlnMeter); ok {
+		r0 = rf(meterNumber)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.PlnMeter)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(meterNumber)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ResendTokenElectricityUseCase provides a mock function with given fields: userId, transactionID
+func (_m *ElectricityUseCase) ResendTokenElectricityUseCase(userId string, transactionID string) error {
+	ret := _m.Called(userId, transactionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userId, transactionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateElectricityByIdUseCase provides a mock function with given fields: electricityId, payload
+func (_m *ElectricityUseCase) UpdateElectricityByIdUseCase(electricityId string, payload *model.Electricity) (*model.Electricity, error) {
+	ret := _m.Called(electricityId, payload)
+
+	var r0 *model.Electricity
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Electricity) (*model.Electricity, error)); ok {
+		return rf(electricityId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Electricity) *model.Electricity); ok {
+		r0 = rf(electricityId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Electricity)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Electricity) error); ok {
+		r1 = rf(electricityId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewElectricityUseCase creates a new instance of ElectricityUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewElectricityUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *ElectricityUseCase {
+	mock := &ElectricityUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
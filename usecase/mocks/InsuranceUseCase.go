@@ -0,0 +1,224 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// InsuranceUseCase is an autogenerated mock type for the InsuranceUseCase type
+type InsuranceUseCase struct {
+	mock.Mock
+}
+
+// BillInquiryInsuranceUseCase provides a mock function with given fields: userId, payload
+func (_m *InsuranceUseCase) BillInquiryInsuranceUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillInsuranceStatusUseCase provides a mock function with given fields: payload
+func (_m *InsuranceUseCase) BillInsuranceStatusUseCase(payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.OyBillerApiResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) (*model.OyBillerApiResponse, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) *model.OyBillerApiResponse); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OyBillerApiResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OyBillerApi) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateInsuranceUseCase provides a mock function with given fields: payload
+func (_m *InsuranceUseCase) CreateInsuranceUseCase(payload *model.Insurance) (*model.Insurance, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.Insurance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Insurance) (*model.Insurance, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Insurance) *model.Insurance); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Insurance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Insurance) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteInsuranceByIDUseCase provides a mock function with given fields: userId
+func (_m *InsuranceUseCase) DeleteInsuranceByIDUseCase(userId string) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllInsuranceUseCase provides a mock function with given fields: page, limit
+func (_m *InsuranceUseCase) GetAllInsuranceUseCase(page int, limit int) ([]*model.Insurance, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.Insurance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.Insurance, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.Insurance); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Insurance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInsuranceByIdUseCase provides a mock function with given fields: insuranceId
+func (_m *InsuranceUseCase) GetInsuranceByIdUseCase(insuranceId string) (*model.Insurance, error) {
+	ret := _m.Called(insuranceId)
+
+	var r0 *model.Insurance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Insurance, error)); ok {
+		return rf(insuranceId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Insurance); ok {
+		r0 = rf(insuranceId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Insurance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(insuranceId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayBillInsuranceUseCase provides a mock function with given fields: userId, payload
+func (_m *InsuranceUseCase) PayBillInsuranceUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateInsuranceByIdUseCase provides a mock function with given fields: insuranceId, payload
+func (_m *InsuranceUseCase) UpdateInsuranceByIdUseCase(insuranceId string, payload *model.Insurance) (*model.Insurance, error) {
+	ret := _m.Called(insuranceId, payload)
+
+	var r0 *model.Insurance
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Insurance) (*model.Insurance, error)); ok {
+		return rf(insuranceId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Insurance) *model.Insurance); ok {
+		r0 = rf(insuranceId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Insurance)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Insurance) error); ok {
+		r1 = rf(insuranceId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewInsuranceUseCase creates a new instance of InsuranceUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewInsuranceUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *InsuranceUseCase {
+	mock := &InsuranceUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,135 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "BE-Golang/dto"
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// NotificationUseCase is an autogenerated mock type for the NotificationUseCase type
+type NotificationUseCase struct {
+	mock.Mock
+}
+
+// GetNotificationPreferenceUseCase provides a mock function with given fields: userID
+func (_m *NotificationUseCase) GetNotificationPreferenceUseCase(userID string) (*model.NotificationPreference, error) {
+	ret := _m.Called(userID)
+
+	var r0 *model.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.NotificationPreference, error)); ok {
+		return rf(userID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.NotificationPreference); ok {
+		r0 = rf(userID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNotificationsByUserIdUseCase provides a mock function with given fields: userID, page, limit
+func (_m *NotificationUseCase) GetNotificationsByUserIdUseCase(userID string, page int, limit int) ([]*model.Notification, error) {
+	ret := _m.Called(userID, page, limit)
+
+	var r0 []*model.Notification
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.Notification, error)); ok {
+		return rf(userID, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.Notification); ok {
+		r0 = rf(userID, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Notification)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(userID, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ReadNotificationUseCase provides a mock function with given fields: userID, notificationID
+func (_m *NotificationUseCase) ReadNotificationUseCase(userID string, notificationID string) error {
+	ret := _m.Called(userID, notificationID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, notificationID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SendNotificationUseCase provides a mock function with given fields: userID, payload
+func (_m *NotificationUseCase) SendNotificationUseCase(userID string, payload *model.Notification) error {
+	ret := _m.Called(userID, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, *model.Notification) error); ok {
+		r0 = rf(userID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateNotificationPreferenceUseCase provides a mock function with given fields: userID, payload
+func (_m *NotificationUseCase) UpdateNotificationPreferenceUseCase(userID string, payload dto.NotificationPreferenceDto) (*model.NotificationPreference, error) {
+	ret := _m.Called(userID, payload)
+
+	var r0 *model.NotificationPreference
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.NotificationPreferenceDto) (*model.NotificationPreference, error)); ok {
+		return rf(userID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.NotificationPreferenceDto) *model.NotificationPreference); ok {
+		r0 = rf(userID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.NotificationPreference)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.NotificationPreferenceDto) error); ok {
+		r1 = rf(userID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewNotificationUseCase creates a new instance of NotificationUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewNotificationUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *NotificationUseCase {
+	mock := &NotificationUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
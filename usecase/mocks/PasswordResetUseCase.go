@@ -0,0 +1,85 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "BE-Golang/dto"
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// PasswordResetUseCase is an autogenerated mock type for the PasswordResetUseCase type
+type PasswordResetUseCase struct {
+	mock.Mock
+}
+
+// RequestPasswordResetUseCase provides a mock function with given fields: email
+func (_m *PasswordResetUseCase) RequestPasswordResetUseCase(email string) error {
+	ret := _m.Called(email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ResetPasswordUseCase provides a mock function with given fields: payload
+func (_m *PasswordResetUseCase) ResetPasswordUseCase(payload dto.ResetPasswordDto) (*model.AuthResponse, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(dto.ResetPasswordDto) (*model.AuthResponse, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(dto.ResetPasswordDto) *model.AuthResponse); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(dto.ResetPasswordDto) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunPasswordResetCleanupUseCase provides a mock function with given fields: now
+func (_m *PasswordResetUseCase) RunPasswordResetCleanupUseCase(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewPasswordResetUseCase creates a new instance of PasswordResetUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPasswordResetUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PasswordResetUseCase {
+	mock := &PasswordResetUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
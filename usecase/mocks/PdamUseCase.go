@@ -0,0 +1,224 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// PdamUseCase is an autogenerated mock type for the PdamUseCase type
+type PdamUseCase struct {
+	mock.Mock
+}
+
+// BillInquiryPdamUseCase provides a mock function with given fields: userId, payload
+func (_m *PdamUseCase) BillInquiryPdamUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillPdamStatusUseCase provides a mock function with given fields: payload
+func (_m *PdamUseCase) BillPdamStatusUseCase(payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.OyBillerApiResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) (*model.OyBillerApiResponse, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) *model.OyBillerApiResponse); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OyBillerApiResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OyBillerApi) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreatePdamUseCase provides a mock function with given fields: payload
+func (_m *PdamUseCase) CreatePdamUseCase(payload *model.Pdam) (*model.Pdam, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.Pdam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Pdam) (*model.Pdam, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Pdam) *model.Pdam); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pdam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Pdam) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeletePdamByIDUseCase provides a mock function with given fields: userId
+func (_m *PdamUseCase) DeletePdamByIDUseCase(userId string) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllPdamUseCase provides a mock function with given fields: page, limit
+func (_m *PdamUseCase) GetAllPdamUseCase(page int, limit int) ([]*model.Pdam, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.Pdam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.Pdam, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.Pdam); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Pdam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetPdamByIdUseCase provides a mock function with given fields: pdamId
+func (_m *PdamUseCase) GetPdamByIdUseCase(pdamId string) (*model.Pdam, error) {
+	ret := _m.Called(pdamId)
+
+	var r0 *model.Pdam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Pdam, error)); ok {
+		return rf(pdamId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Pdam); ok {
+		r0 = rf(pdamId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pdam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(pdamId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayBillPdamUseCase provides a mock function with given fields: userId, payload
+func (_m *PdamUseCase) PayBillPdamUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdatePdamByIdUseCase provides a mock function with given fields: pdamId, payload
+func (_m *PdamUseCase) UpdatePdamByIdUseCase(pdamId string, payload *model.Pdam) (*model.Pdam, error) {
+	ret := _m.Called(pdamId, payload)
+
+	var r0 *model.Pdam
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Pdam) (*model.Pdam, error)); ok {
+		return rf(pdamId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Pdam) *model.Pdam); ok {
+		r0 = rf(pdamId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Pdam)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Pdam) error); ok {
+		r1 = rf(pdamId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewPdamUseCase creates a new instance of PdamUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewPdamUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *PdamUseCase {
+	mock := &PdamUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,124 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+
+	time "time"
+)
+
+// SessionUseCase is an autogenerated mock type for the SessionUseCase type
+type SessionUseCase struct {
+	mock.Mock
+}
+
+// CreateSessionUseCase provides a mock function with given fields: user
+func (_m *SessionUseCase) CreateSessionUseCase(user *model.User) (*model.AuthResponse, error) {
+	ret := _m.Called(user)
+
+	var r0 *model.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.User) (*model.AuthResponse, error)); ok {
+		return rf(user)
+	}
+	if rf, ok := ret.Get(0).(func(*model.User) *model.AuthResponse); ok {
+		r0 = rf(user)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.User) error); ok {
+		r1 = rf(user)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// LogoutAllUseCase provides a mock function with given fields: userID
+func (_m *SessionUseCase) LogoutAllUseCase(userID string) error {
+	ret := _m.Called(userID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// LogoutUseCase provides a mock function with given fields: userID, sessionID
+func (_m *SessionUseCase) LogoutUseCase(userID string, sessionID string) error {
+	ret := _m.Called(userID, sessionID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(userID, sessionID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RefreshSessionUseCase provides a mock function with given fields: refreshToken
+func (_m *SessionUseCase) RefreshSessionUseCase(refreshToken string) (*model.AuthResponse, error) {
+	ret := _m.Called(refreshToken)
+
+	var r0 *model.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.AuthResponse, error)); ok {
+		return rf(refreshToken)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.AuthResponse); ok {
+		r0 = rf(refreshToken)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(refreshToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RunSessionCleanupUseCase provides a mock function with given fields: now
+func (_m *SessionUseCase) RunSessionCleanupUseCase(now time.Time) error {
+	ret := _m.Called(now)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(time.Time) error); ok {
+		r0 = rf(now)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// NewSessionUseCase creates a new instance of SessionUseCase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewSessionUseCase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *SessionUseCase {
+	mock := &SessionUseCase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,279 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	dto "BE-Golang/dto"
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// UserUsecase is an autogenerated mock type for the UserUsecase type
+type UserUsecase struct {
+	mock.Mock
+}
+
+// ChangePINUseCase provides a mock function with given fields: userID, payload
+func (_m *UserUsecase) ChangePINUseCase(userID string, payload dto.PIN) (*model.AuthResponse, error) {
+	ret := _m.Called(userID, payload)
+
+	var r0 *model.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.PIN) (*model.AuthResponse, error)); ok {
+		return rf(userID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.PIN) *model.AuthResponse); ok {
+		r0 = rf(userID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.PIN) error); ok {
+		r1 = rf(userID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ChangePasswordUseCase provides a mock function with given fields: userID, payload
+func (_m *UserUsecase) ChangePasswordUseCase(userID string, payload dto.Password) (*model.AuthResponse, error) {
+	ret := _m.Called(userID, payload)
+
+	var r0 *model.AuthResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.Password) (*model.AuthResponse, error)); ok {
+		return rf(userID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.Password) *model.AuthResponse); ok {
+		r0 = rf(userID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.AuthResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.Password) error); ok {
+		r1 = rf(userID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CheckPINUseCase provides a mock function with given fields: userID, payload
+func (_m *UserUsecase) CheckPINUseCase(userID string, payload dto.PIN) error {
+	ret := _m.Called(userID, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dto.PIN) error); ok {
+		r0 = rf(userID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CreatePINUseCase provides a mock function with given fields: userID, payload
+func (_m *UserUsecase) CreatePINUseCase(userID string, payload dto.PIN) error {
+	ret := _m.Called(userID, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, dto.PIN) error); ok {
+		r0 = rf(userID, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// DeleteUserByIDUseCase provides a mock function with given fields: userId
+func (_m *UserUsecase) DeleteUserByIDUseCase(userId string) error {
+	ret := _m.Called(userId)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(userId)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllUsersUseCase provides a mock function with given fields: page, limit, name
+func (_m *UserUsecase) GetAllUsersUseCase(page int, limit int, name string) ([]*model.UserResponse, error) {
+	ret := _m.Called(page, limit, name)
+
+	var r0 []*model.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int, string) ([]*model.UserResponse, error)); ok {
+		return rf(page, limit, name)
+	}
+	if rf, ok := ret.Get(0).(func(int, int, string) []*model.UserResponse); ok {
+		r0 = rf(page, limit, name)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int, string) error); ok {
+		r1 = rf(page, limit, name)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByIDUseCase provides a mock function with given fields: userId
+func (_m *UserUsecase) GetUserByIDUseCase(userId string) (*model.UserResponse, error) {
+	ret := _m.Called(userId)
+
+	var r0 *model.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.UserResponse, error)); ok {
+		return rf(userId)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.UserResponse); ok {
+		r0 = rf(userId)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(userId)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUserByQueryUseCase provides a mock function with given fields: query, page, limit
+func (_m *UserUsecase) GetUserByQueryUseCase(query string, page int, limit int) ([]*model.User, error) {
+	ret := _m.Called(query, page, limit)
+
+	var r0 []*model.User
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, int, int) ([]*model.User, error)); ok {
+		return rf(query, page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(string, int, int) []*model.User); ok {
+		r0 = rf(query, page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.User)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, int, int) error); ok {
+		r1 = rf(query, page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TransferAmountUseCase provides a mock function with given fields: userID, payload
+func (_m *UserUsecase) TransferAmountUseCase(userID string, payload dto.TransactionTransferDto) (*model.Transaction, error) {
+	ret := _m.Called(userID, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, dto.TransactionTransferDto) (*model.Transaction, error)); ok {
+		return rf(userID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, dto.TransactionTransferDto) *model.Transaction); ok {
+		r0 = rf(userID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, dto.TransactionTransferDto) error); ok {
+		r1 = rf(userID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserByIDUseCase provides a mock function with given fields: userId, payload
+func (_m *UserUsecase) UpdateUserByIDUseCase(userId string, payload *model.User) (*model.UserResponse, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.User) (*model.UserResponse, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.User) *model.UserResponse); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.User) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateUserImageByIDUseCase provides a mock function with given fields: userId, payload
+func (_m *UserUsecase) UpdateUserImageByIDUseCase(userId string, payload *model.User) (*model.UserResponse, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.UserResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.User) (*model.UserResponse, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.User) *model.UserResponse); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.UserResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.User) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewUserUsecase creates a new instance of UserUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewUserUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *UserUsecase {
+	mock := &UserUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,250 @@
+// Code generated by mockery v2.30.1. DO NOT EDIT.
+
+package mocks
+
+import (
+	model "BE-Golang/model"
+
+	mock "github.com/stretchr/testify/mock"
+)
+
+// WifiUsecase is an autogenerated mock type for the WifiUsecase type
+type WifiUsecase struct {
+	mock.Mock
+}
+
+// BillInquiryWifiUseCase provides a mock function with given fields: userId, payload
+func (_m *WifiUsecase) BillInquiryWifiUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// BillWifiStatusUseCase provides a mock function with given fields: payload
+func (_m *WifiUsecase) BillWifiStatusUseCase(payload *model.OyBillerApi) (*model.OyBillerApiResponse, error) {
+	ret := _m.Called(payload)
+
+	var r0 *model.OyBillerApiResponse
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) (*model.OyBillerApiResponse, error)); ok {
+		return rf(payload)
+	}
+	if rf, ok := ret.Get(0).(func(*model.OyBillerApi) *model.OyBillerApiResponse); ok {
+		r0 = rf(payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OyBillerApiResponse)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.OyBillerApi) error); ok {
+		r1 = rf(payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// CreateWifiUseCase provides a mock function with given fields: _a0
+func (_m *WifiUsecase) CreateWifiUseCase(_a0 *model.Wifi) (*model.Wifi, error) {
+	ret := _m.Called(_a0)
+
+	var r0 *model.Wifi
+	var r1 error
+	if rf, ok := ret.Get(0).(func(*model.Wifi) (*model.Wifi, error)); ok {
+		return rf(_a0)
+	}
+	if rf, ok := ret.Get(0).(func(*model.Wifi) *model.Wifi); ok {
+		r0 = rf(_a0)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wifi)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(*model.Wifi) error); ok {
+		r1 = rf(_a0)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// DeleteWifiByIDUseCase provides a mock function with given fields: wifiID
+func (_m *WifiUsecase) DeleteWifiByIDUseCase(wifiID string) error {
+	ret := _m.Called(wifiID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(wifiID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// GetAllWifiUseCase provides a mock function with given fields: page, limit
+func (_m *WifiUsecase) GetAllWifiUseCase(page int, limit int) ([]*model.Wifi, error) {
+	ret := _m.Called(page, limit)
+
+	var r0 []*model.Wifi
+	var r1 error
+	if rf, ok := ret.Get(0).(func(int, int) ([]*model.Wifi, error)); ok {
+		return rf(page, limit)
+	}
+	if rf, ok := ret.Get(0).(func(int, int) []*model.Wifi); ok {
+		r0 = rf(page, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Wifi)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(int, int) error); ok {
+		r1 = rf(page, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWifiByCodeUseCase provides a mock function with given fields: wifiCode
+func (_m *WifiUsecase) GetWifiByCodeUseCase(wifiCode string) (*model.Wifi, error) {
+	ret := _m.Called(wifiCode)
+
+	var r0 *model.Wifi
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Wifi, error)); ok {
+		return rf(wifiCode)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Wifi); ok {
+		r0 = rf(wifiCode)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wifi)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(wifiCode)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetWifiByIDUseCase provides a mock function with given fields: wifiID
+func (_m *WifiUsecase) GetWifiByIDUseCase(wifiID string) (*model.Wifi, error) {
+	ret := _m.Called(wifiID)
+
+	var r0 *model.Wifi
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string) (*model.Wifi, error)); ok {
+		return rf(wifiID)
+	}
+	if rf, ok := ret.Get(0).(func(string) *model.Wifi); ok {
+		r0 = rf(wifiID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wifi)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(wifiID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// PayBillWifiUseCase provides a mock function with given fields: userId, payload
+func (_m *WifiUsecase) PayBillWifiUseCase(userId string, payload *model.OyBillerApi) (*model.Transaction, error) {
+	ret := _m.Called(userId, payload)
+
+	var r0 *model.Transaction
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) (*model.Transaction, error)); ok {
+		return rf(userId, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.OyBillerApi) *model.Transaction); ok {
+		r0 = rf(userId, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Transaction)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.OyBillerApi) error); ok {
+		r1 = rf(userId, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// UpdateWifiByIDUseCase provides a mock function with given fields: wifiID, payload
+func (_m *WifiUsecase) UpdateWifiByIDUseCase(wifiID string, payload *model.Wifi) (*model.Wifi, error) {
+	ret := _m.Called(wifiID, payload)
+
+	var r0 *model.Wifi
+	var r1 error
+	if rf, ok := ret.Get(0).(func(string, *model.Wifi) (*model.Wifi, error)); ok {
+		return rf(wifiID, payload)
+	}
+	if rf, ok := ret.Get(0).(func(string, *model.Wifi) *model.Wifi); ok {
+		r0 = rf(wifiID, payload)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.Wifi)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(string, *model.Wifi) error); ok {
+		r1 = rf(wifiID, payload)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// NewWifiUsecase creates a new instance of WifiUsecase. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
+// The first argument is typically a *testing.T value.
+func NewWifiUsecase(t interface {
+	mock.TestingT
+	Cleanup(func())
+}) *WifiUsecase {
+	mock := &WifiUsecase{}
+	mock.Mock.Test(t)
+
+	t.Cleanup(func() { mock.AssertExpectations(t) })
+
+	return mock
+}
@@ -0,0 +1,122 @@
+package multifinance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type FinanceCompanyUseCase interface {
+	CreateFinanceCompanyUseCase(payload *model.FinanceCompany) (*model.FinanceCompany, error)
+	GetAllFinanceCompanyUseCase(page, limit int) ([]*model.FinanceCompany, error)
+	GetFinanceCompanyByIdUseCase(id string) (*model.FinanceCompany, error)
+	UpdateFinanceCompanyByIdUseCase(id string, payload *model.FinanceCompany) (*model.FinanceCompany, error)
+	DeleteFinanceCompanyByIdUseCase(id string) error
+}
+
+type financeCompanyUseCase struct {
+	financeCompanyRepository repository.FinanceCompanyRepository
+}
+
+func NewFinanceCompanyUseCase(financeCompanyRepository repository.FinanceCompanyRepository) *financeCompanyUseCase {
+	return &financeCompanyUseCase{
+		financeCompanyRepository: financeCompanyRepository,
+	}
+}
+
+func (uc *financeCompanyUseCase) CreateFinanceCompanyUseCase(payload *model.FinanceCompany) (*model.FinanceCompany, error) {
+	payload.Code = strings.ToLower(payload.Code)
+	if payload.Code == "" || payload.Name == "" {
+		return nil, errors.New("code and name are required")
+	}
+	if err := validateFinanceCompany(payload); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.financeCompanyRepository.GetFinanceCompanyByCodeRepository(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("finance company %s already exists", payload.Code)
+	}
+
+	company, err := uc.financeCompanyRepository.CreateFinanceCompanyRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating finance company in database: %w", err)
+	}
+
+	return company, nil
+}
+
+func (uc *financeCompanyUseCase) GetAllFinanceCompanyUseCase(page, limit int) ([]*model.FinanceCompany, error) {
+	return uc.financeCompanyRepository.GetAllFinanceCompanyRepository(page, limit)
+}
+
+func (uc *financeCompanyUseCase) GetFinanceCompanyByIdUseCase(id string) (*model.FinanceCompany, error) {
+	company, err := uc.financeCompanyRepository.GetFinanceCompanyByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("finance company not found")
+	}
+
+	return company, nil
+}
+
+func (uc *financeCompanyUseCase) UpdateFinanceCompanyByIdUseCase(id string, payload *model.FinanceCompany) (*model.FinanceCompany, error) {
+	company, err := uc.financeCompanyRepository.GetFinanceCompanyByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update finance company: %v", err)
+	}
+
+	if payload.Name != "" {
+		company.Name = payload.Name
+	}
+	if payload.ContractLength != 0 {
+		company.ContractLength = payload.ContractLength
+	}
+	if payload.DueDay != 0 {
+		company.DueDay = payload.DueDay
+	}
+	if payload.LateFeeRate != 0 {
+		company.LateFeeRate = payload.LateFeeRate
+	}
+	if err := validateFinanceCompany(company); err != nil {
+		return nil, err
+	}
+	company.UpdatedAt = time.Now()
+
+	updated, err := uc.financeCompanyRepository.UpdateFinanceCompanyByIdRepository(id, company)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update finance company: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *financeCompanyUseCase) DeleteFinanceCompanyByIdUseCase(id string) error {
+	err := uc.financeCompanyRepository.DeleteFinanceCompanyByIdRepository(id)
+	if err != nil {
+		return errors.New("finance company not found")
+	}
+
+	return nil
+}
+
+// validateFinanceCompany keeps the due day within every month and the contract
+// length within what validateContractNumber accepts.
+func validateFinanceCompany(company *model.FinanceCompany) error {
+	if company.DueDay < 1 || company.DueDay > 28 {
+		return errors.New("due_day must be between 1 and 28")
+	}
+	if company.ContractLength != 0 && (company.ContractLength < 8 || company.ContractLength > 20) {
+		return errors.New("contract_length must be between 8 and 20")
+	}
+	if company.LateFeeRate < 0 || company.LateFeeRate >= 1 {
+		return errors.New("late_fee_rate must be between 0 and 1")
+	}
+
+	return nil
+}
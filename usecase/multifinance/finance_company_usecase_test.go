@@ -0,0 +1,67 @@
+package multifinance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type FinanceCompanyUseCaseTest struct {
+	suite.Suite
+	financeCompanyUseCase FinanceCompanyUseCase
+	financeCompanyRepo    *mocks.FinanceCompanyRepository
+}
+
+func TestFinanceCompanyUseCase(t *testing.T) {
+	suite.Run(t, new(FinanceCompanyUseCaseTest))
+}
+
+func (m *FinanceCompanyUseCaseTest) SetupTest() {
+	m.financeCompanyRepo = &mocks.FinanceCompanyRepository{}
+	m.financeCompanyUseCase = NewFinanceCompanyUseCase(m.financeCompanyRepo)
+}
+
+func (m *FinanceCompanyUseCaseTest) TestCreateFinanceCompanySuccess() {
+	m.financeCompanyRepo.On("GetFinanceCompanyByCodeRepository", "fif").Return(nil, nil)
+	m.financeCompanyRepo.On("CreateFinanceCompanyRepository", mock.Anything).Return(func(company *model.FinanceCompany) *model.FinanceCompany {
+		return company
+	}, nil)
+
+	resp, err := m.financeCompanyUseCase.CreateFinanceCompanyUseCase(&model.FinanceCompany{
+		Code:           "FIF",
+		Name:           "FIF Group",
+		ContractLength: 12,
+		DueDay:         15,
+		LateFeeRate:    0.005,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "fif", resp.Code)
+}
+
+func (m *FinanceCompanyUseCaseTest) TestCreateFinanceCompanyDuplicate() {
+	m.financeCompanyRepo.On("GetFinanceCompanyByCodeRepository", "adira").Return(&model.FinanceCompany{}, nil)
+
+	_, err := m.financeCompanyUseCase.CreateFinanceCompanyUseCase(&model.FinanceCompany{Code: "adira", Name: "Adira Finance", DueDay: 10})
+
+	assert.EqualError(m.T(), err, "finance company adira already exists")
+}
+
+func (m *FinanceCompanyUseCaseTest) TestCreateFinanceCompanyInvalidDueDay() {
+	_, err := m.financeCompanyUseCase.CreateFinanceCompanyUseCase(&model.FinanceCompany{Code: "wom", Name: "WOM Finance", DueDay: 31})
+
+	assert.EqualError(m.T(), err, "due_day must be between 1 and 28")
+}
+
+func (m *FinanceCompanyUseCaseTest) TestUpdateFinanceCompanyInvalidContractLength() {
+	m.financeCompanyRepo.On("GetFinanceCompanyByIdRepository", "1").Return(&model.FinanceCompany{Code: "wom", DueDay: 10}, nil)
+
+	_, err := m.financeCompanyUseCase.UpdateFinanceCompanyByIdUseCase("1", &model.FinanceCompany{ContractLength: 30})
+
+	assert.EqualError(m.T(), err, "contract_length must be between 8 and 20")
+	m.financeCompanyRepo.AssertNotCalled(m.T(), "UpdateFinanceCompanyByIdRepository", mock.Anything, mock.Anything)
+}
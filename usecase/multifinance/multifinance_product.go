@@ -0,0 +1,207 @@
+package multifinance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"time"
+)
+
+var (
+	contractPattern = regexp.MustCompile(`^[0-9]{8,20}$`)
+	tenors          = []int{12, 18, 24, 36}
+)
+
+const transactionPrefix = "FINANCE"
+
+// contract is the financing agreement behind a contract number. LastPaid is
+// the last installment the finance company has recorded as paid.
+type contract struct {
+	Start             time.Time
+	Tenor             int
+	InstallmentAmount float64
+	LastPaid          int
+}
+
+// NewMultifinanceProduct plugs monthly installments into the biller engine.
+// The inquiry's product ID selects the finance company.
+func NewMultifinanceProduct(financeCompanyRepository repository.FinanceCompanyRepository, transactionRepository repository.TransactionRepository) *biller.Product {
+	return &biller.Product{
+		Code:               model.PRODUCT_MULTIFINANCE,
+		Category:           model.PRODUCT_MULTIFINANCE,
+		Name:               "MULTIFINANCE",
+		TransactionPrefix:  transactionPrefix,
+		ValidateCustomerId: validateContractNumber,
+		ValidateForProvider: func(provider, customerID string) ([]model.FieldError, error) {
+			company, err := findCompany(financeCompanyRepository, provider)
+			if err != nil {
+				return nil, err
+			}
+			if company.ContractLength != 0 && len(customerID) != company.ContractLength {
+				return []model.FieldError{
+					{Field: "customer_id", Rule: "length", Message: fmt.Sprintf("%s contract numbers are %d digits", company.Name, company.ContractLength)},
+				}, nil
+			}
+
+			return nil, nil
+		},
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			company, err := findCompany(financeCompanyRepository, inquiry.ProductType)
+			if err != nil {
+				return nil, err
+			}
+
+			contract := lookupContract(company.Code, inquiry.Payload.CustomerId, inquiry.Now)
+			// An installment paid through the app may not have reached the
+			// finance company's records yet.
+			if paid, err := lastPaidInstallment(transactionRepository, company.Code, inquiry.Payload.CustomerId); err != nil {
+				return nil, err
+			} else if paid > contract.LastPaid {
+				contract.LastPaid = paid
+			}
+
+			return priceInstallment(company, contract, inquiry)
+		},
+		Detail: func() interface{} {
+			return &model.MultifinanceBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			bill := detail.(*model.MultifinanceBill)
+			receipt.ProviderName = bill.ProviderName
+			receipt.ReferenceNumber = bill.ReferenceNumber
+		},
+		Settle: func(transactionID string, detail interface{}) (interface{}, error) {
+			bill := detail.(*model.MultifinanceBill)
+			// Another inquiry for the same installment may have been paid
+			// since this one was made.
+			paid, err := lastPaidInstallment(transactionRepository, bill.Type, bill.CustomerID)
+			if err != nil {
+				return nil, err
+			}
+			if bill.InstallmentNumber <= paid {
+				return nil, fmt.Errorf("installment %d of contract %s has already been paid", bill.InstallmentNumber, bill.CustomerID)
+			}
+			bill.ReferenceNumber = biller.GenerateVANumber(16)
+			return bill, nil
+		},
+	}
+}
+
+func validateContractNumber(customerID string) error {
+	if !contractPattern.MatchString(customerID) {
+		return errors.New("contract number must be 8 to 20 digits")
+	}
+
+	return nil
+}
+
+func findCompany(financeCompanyRepository repository.FinanceCompanyRepository, code string) (*model.FinanceCompany, error) {
+	company, err := financeCompanyRepository.GetFinanceCompanyByCodeRepository(code)
+	if err != nil {
+		return nil, err
+	}
+	if company == nil {
+		return nil, fmt.Errorf("finance company %s not found", code)
+	}
+
+	return company, nil
+}
+
+// lookupContract stands in for the finance company's contract lookup until
+// the gateway returns installment data. Contracts are derived from the
+// contract number, so the same contract always has the same schedule; up to
+// two installments due before this month are left unpaid.
+func lookupContract(companyCode, contractNumber string, now time.Time) contract {
+	sum := biller.Hash(companyCode + contractNumber)
+
+	c := contract{
+		Start:             time.Date(2025, time.January, 1, 0, 0, 0, 0, time.UTC).AddDate(0, int(sum%24), 0),
+		Tenor:             tenors[sum%uint32(len(tenors))],
+		InstallmentAmount: float64(sum%30+5) * 50000,
+	}
+	c.LastPaid = monthsBetween(c.Start, now) - int(sum%3)
+	if c.LastPaid < 0 {
+		c.LastPaid = 0
+	} else if c.LastPaid > c.Tenor {
+		c.LastPaid = c.Tenor
+	}
+
+	return c
+}
+
+// lastPaidInstallment is the number of the contract's latest installment
+// paid through the app, or 0 when none was.
+func lastPaidInstallment(transactionRepository repository.TransactionRepository, companyCode, contractNumber string) (int, error) {
+	transaction, err := transactionRepository.GetLastPaidBillRepository(transactionPrefix, companyCode, contractNumber)
+	if err != nil || transaction == nil {
+		return 0, err
+	}
+
+	jsonData, err := json.Marshal(transaction.ProductDetail)
+	if err != nil {
+		return 0, fmt.Errorf("error serializing transaction detail to JSON: %w", err)
+	}
+
+	var bill model.MultifinanceBill
+	if err := json.Unmarshal(jsonData, &bill); err != nil {
+		return 0, fmt.Errorf("error Unmarshal: %w", err)
+	}
+
+	return bill.InstallmentNumber, nil
+}
+
+// monthsBetween counts the months from start's month to now's month.
+func monthsBetween(start, now time.Time) int {
+	return (now.Year()-start.Year())*12 + int(now.Month()) - int(start.Month())
+}
+
+// priceInstallment bills the installment after the last paid one, with a late
+// fee for every day past its due date. Installments are paid in order, so a
+// customer behind on payments clears the oldest one first.
+func priceInstallment(company *model.FinanceCompany, contract contract, inquiry *biller.Inquiry) (*biller.Bill, error) {
+	now := inquiry.Now
+	number := contract.LastPaid + 1
+	if number > contract.Tenor {
+		return nil, fmt.Errorf("contract %s has been paid off", inquiry.Payload.CustomerId)
+	}
+
+	dueMonth := contract.Start.AddDate(0, number-1, 0)
+	if monthsBetween(dueMonth, now) < 0 {
+		return nil, errors.New("no installment is due yet")
+	}
+
+	dueDate := time.Date(dueMonth.Year(), dueMonth.Month(), company.DueDay, 0, 0, 0, 0, now.Location())
+	daysLate := 0
+	if now.After(dueDate) {
+		daysLate = int(now.Sub(dueDate).Hours() / 24)
+	}
+	lateFee := math.Round(contract.InstallmentAmount * company.LateFeeRate * float64(daysLate))
+	price := contract.InstallmentAmount + lateFee
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Angsuran %s ke-%d ", company.Name, number),
+		Detail: &model.MultifinanceBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: company.Name,
+			Type:         company.Code,
+			Name:         inquiry.User.Name,
+			// The period is the installment's own month, so a customer behind
+			// on payments can clear several installments in one month.
+			Period:            biller.CurrentPeriod(dueDate),
+			InstallmentNumber: number,
+			Tenor:             contract.Tenor,
+			DueDate:           dueDate.Format("2006-01-02"),
+			DaysLate:          daysLate,
+			InstallmentAmount: contract.InstallmentAmount,
+			LateFee:           lateFee,
+			DiscountId:        inquiry.Discount.ID,
+			Price:             price,
+		},
+	}, nil
+}
@@ -0,0 +1,121 @@
+package multifinance
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/biller/billertest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var fif = &model.FinanceCompany{Code: "fif", Name: "FIF Group", ContractLength: 12, DueDay: 15, LateFeeRate: 0.001}
+
+func installmentInquiry(contractNumber string, now time.Time) *biller.Inquiry {
+	inquiry := billertest.Inquiry("fif", contractNumber, biller.CurrentPeriod(now))
+	inquiry.Now = now
+	return inquiry
+}
+
+func TestValidateContractNumber(t *testing.T) {
+	assert.NoError(t, validateContractNumber("123456789012"))
+	assert.EqualError(t, validateContractNumber("1234-5678"), "contract number must be 8 to 20 digits")
+}
+
+func TestPriceInstallment(t *testing.T) {
+	c := contract{Start: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Tenor: 12, InstallmentAmount: 500000, LastPaid: 2}
+
+	bill, err := priceInstallment(fif, c, installmentInquiry("123456789012", time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.MultifinanceBill)
+	assert.Equal(t, 3, detail.InstallmentNumber)
+	assert.Equal(t, "2026-03-15", detail.DueDate)
+	assert.Equal(t, "March-2026", detail.Period)
+	assert.Equal(t, 0.0, detail.LateFee)
+	assert.Equal(t, 500000.0, bill.Price)
+
+	bill, err = priceInstallment(fif, c, installmentInquiry("123456789012", time.Date(2026, time.March, 25, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+	detail = bill.Detail.(*model.MultifinanceBill)
+	assert.Equal(t, 10, detail.DaysLate)
+	assert.Equal(t, 5000.0, detail.LateFee)
+	assert.Equal(t, 505000.0, bill.Price)
+
+	c.LastPaid = 12
+	_, err = priceInstallment(fif, c, installmentInquiry("123456789012", time.Date(2027, time.January, 10, 0, 0, 0, 0, time.UTC)))
+	assert.EqualError(t, err, "contract 123456789012 has been paid off")
+}
+
+func TestPriceInstallmentInArrears(t *testing.T) {
+	c := contract{Start: time.Date(2026, time.January, 1, 0, 0, 0, 0, time.UTC), Tenor: 12, InstallmentAmount: 500000, LastPaid: 1}
+
+	bill, err := priceInstallment(fif, c, installmentInquiry("123456789012", time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.MultifinanceBill)
+	assert.Equal(t, 2, detail.InstallmentNumber)
+	assert.Equal(t, "2026-02-15", detail.DueDate)
+	assert.Equal(t, "February-2026", detail.Period)
+	assert.Equal(t, 23, detail.DaysLate)
+
+	c.LastPaid = 3
+	_, err = priceInstallment(fif, c, installmentInquiry("123456789012", time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)))
+	assert.EqualError(t, err, "no installment is due yet")
+}
+
+func TestMultifinanceProductPrice(t *testing.T) {
+	repo := &mocks.FinanceCompanyRepository{}
+	repo.On("GetFinanceCompanyByCodeRepository", "fif").Return(fif, nil)
+	transactionRepo := &mocks.TransactionRepository{}
+	product := NewMultifinanceProduct(repo, transactionRepo)
+
+	fieldErrors, err := product.ValidateForProvider("fif", "12345678")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "length", Message: "FIF Group contract numbers are 12 digits"},
+	}, fieldErrors)
+
+	repo.On("GetFinanceCompanyByCodeRepository", "acc").Return(nil, nil)
+	_, err = product.ValidateForProvider("acc", "123456789012")
+	assert.EqualError(t, err, "finance company acc not found")
+}
+
+func TestMultifinanceProductPriceAfterAppPayment(t *testing.T) {
+	now := time.Date(2026, time.March, 10, 0, 0, 0, 0, time.UTC)
+	repo := &mocks.FinanceCompanyRepository{}
+	repo.On("GetFinanceCompanyByCodeRepository", "fif").Return(fif, nil)
+	transactionRepo := &mocks.TransactionRepository{}
+	transactionRepo.On("GetLastPaidBillRepository", "FINANCE", "fif", "123456789000").Return(nil, nil).Once()
+	product := NewMultifinanceProduct(repo, transactionRepo)
+
+	// The finance company has this contract's first installment as paid.
+	bill, err := product.Price(installmentInquiry("123456789000", now))
+	assert.NoError(t, err)
+	assert.Equal(t, 2, bill.Detail.(*model.MultifinanceBill).InstallmentNumber)
+
+	transactionRepo.On("GetLastPaidBillRepository", "FINANCE", "fif", "123456789000").Return(&model.Transaction{
+		ProductDetail: map[string]interface{}{"installment_number": 2},
+	}, nil)
+
+	bill, err = product.Price(installmentInquiry("123456789000", now))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.MultifinanceBill)
+	assert.Equal(t, 3, detail.InstallmentNumber)
+	assert.Equal(t, "2026-02-15", detail.DueDate)
+}
+
+func TestMultifinanceProductSettleRejectsPaidInstallment(t *testing.T) {
+	transactionRepo := &mocks.TransactionRepository{}
+	transactionRepo.On("GetLastPaidBillRepository", "FINANCE", "fif", "123456789000").Return(&model.Transaction{
+		ProductDetail: map[string]interface{}{"installment_number": 2},
+	}, nil)
+	product := NewMultifinanceProduct(&mocks.FinanceCompanyRepository{}, transactionRepo)
+
+	_, err := product.Settle("FINANCE-1", &model.MultifinanceBill{CustomerID: "123456789000", Type: "fif", InstallmentNumber: 2})
+	assert.EqualError(t, err, "installment 2 of contract 123456789000 has already been paid")
+
+	settled, err := product.Settle("FINANCE-2", &model.MultifinanceBill{CustomerID: "123456789000", Type: "fif", InstallmentNumber: 3})
+	assert.NoError(t, err)
+	assert.Len(t, settled.(*model.MultifinanceBill).ReferenceNumber, 16)
+}
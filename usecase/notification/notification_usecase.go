@@ -0,0 +1,184 @@
+package notification
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/mail"
+	"errors"
+	"fmt"
+	"time"
+)
+
+const defaultReminderDaysBefore = 3
+
+type NotificationUseCase interface {
+	SendNotificationUseCase(userID string, payload *model.Notification) error
+	GetNotificationsByUserIdUseCase(userID string, page, limit int) ([]*model.Notification, error)
+	ReadNotificationUseCase(userID, notificationID string) error
+	GetNotificationPreferenceUseCase(userID string) (*model.NotificationPreference, error)
+	UpdateNotificationPreferenceUseCase(userID string, payload dto.NotificationPreferenceDto) (*model.NotificationPreference, error)
+}
+
+type notificationUseCase struct {
+	notificationRepository repository.NotificationRepository
+	userRepository         repository.UserRepository
+	sendMail               func(payload model.PayloadMail) error
+	now                    func() time.Time
+}
+
+func NewNotificationUseCase(notificationRepository repository.NotificationRepository, userRepository repository.UserRepository) *notificationUseCase {
+	return &notificationUseCase{
+		notificationRepository: notificationRepository,
+		userRepository:         userRepository,
+		sendMail:               mail.SendingNotificationMail,
+		now:                    time.Now,
+	}
+}
+
+func (uc *notificationUseCase) SendNotificationUseCase(userID string, payload *model.Notification) error {
+	user, err := uc.userRepository.GetUserByIDRepository(userID)
+	if err != nil {
+		return errors.New("user not found")
+	}
+
+	preference, err := uc.GetNotificationPreferenceUseCase(user.ID)
+	if err != nil {
+		return err
+	}
+
+	if preference.InApp {
+		payload.UserID = user.ID
+		_, err = uc.notificationRepository.CreateNotificationRepository(payload)
+		if err != nil {
+			return fmt.Errorf("failed to save notification: %v", err)
+		}
+	}
+
+	// Quiet hours only silence email; the in-app notification is still stored.
+	if !preference.Email || user.Email == "" || IsQuietHours(preference, uc.now()) {
+		return nil
+	}
+
+	mailsend := model.PayloadMail{
+		CustomerName:  user.Name,
+		RecipentEmail: user.Email,
+		Subject:       payload.Title,
+		Description:   payload.Message,
+	}
+	if err := uc.sendMail(mailsend); err != nil {
+		return fmt.Errorf("failed to send notification mail: %v", err)
+	}
+
+	return nil
+}
+
+func (uc *notificationUseCase) GetNotificationsByUserIdUseCase(userID string, page, limit int) ([]*model.Notification, error) {
+	notifications, err := uc.notificationRepository.GetNotificationsByUserIdRepository(userID, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return notifications, nil
+}
+
+func (uc *notificationUseCase) ReadNotificationUseCase(userID, notificationID string) error {
+	err := uc.notificationRepository.ReadNotificationByIdRepository(userID, notificationID)
+	if err != nil {
+		return errors.New("notification not found")
+	}
+
+	return nil
+}
+
+func (uc *notificationUseCase) GetNotificationPreferenceUseCase(userID string) (*model.NotificationPreference, error) {
+	preference, err := uc.notificationRepository.GetNotificationPreferenceByUserIdRepository(userID)
+	if err != nil {
+		return nil, err
+	}
+	if preference != nil {
+		return preference, nil
+	}
+
+	reminderDaysBefore := config.AppConfig.ReminderDaysBefore
+	if reminderDaysBefore <= 0 {
+		reminderDaysBefore = defaultReminderDaysBefore
+	}
+
+	return &model.NotificationPreference{
+		UserID:             userID,
+		InApp:              true,
+		Email:              true,
+		ReminderDaysBefore: reminderDaysBefore,
+	}, nil
+}
+
+func (uc *notificationUseCase) UpdateNotificationPreferenceUseCase(userID string, payload dto.NotificationPreferenceDto) (*model.NotificationPreference, error) {
+	if (payload.QuietHoursStart == "") != (payload.QuietHoursEnd == "") {
+		return nil, errors.New("quiet_hours_start and quiet_hours_end must be set together")
+	}
+	if payload.QuietHoursStart != "" {
+		if _, err := time.Parse("15:04", payload.QuietHoursStart); err != nil {
+			return nil, errors.New("quiet_hours_start must use the HH:MM format")
+		}
+		if _, err := time.Parse("15:04", payload.QuietHoursEnd); err != nil {
+			return nil, errors.New("quiet_hours_end must use the HH:MM format")
+		}
+	}
+	if payload.ReminderDaysBefore != nil && (*payload.ReminderDaysBefore < 0 || *payload.ReminderDaysBefore > 14) {
+		return nil, errors.New("reminder_days_before must be between 0 and 14")
+	}
+
+	preference, err := uc.GetNotificationPreferenceUseCase(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	if payload.InApp != nil {
+		preference.InApp = *payload.InApp
+	}
+	if payload.Email != nil {
+		preference.Email = *payload.Email
+	}
+	if payload.ReminderDaysBefore != nil {
+		preference.ReminderDaysBefore = *payload.ReminderDaysBefore
+	}
+	preference.QuietHoursStart = payload.QuietHoursStart
+	preference.QuietHoursEnd = payload.QuietHoursEnd
+	preference.UpdatedAt = uc.now()
+
+	resp, err := uc.notificationRepository.SaveNotificationPreferenceRepository(preference)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update notification preference: %v", err)
+	}
+
+	return resp, nil
+}
+
+// IsQuietHours reports whether now falls in the user's quiet hours. A window
+// such as 22:00-06:00 wraps past midnight.
+func IsQuietHours(preference *model.NotificationPreference, now time.Time) bool {
+	if preference.QuietHoursStart == "" || preference.QuietHoursEnd == "" {
+		return false
+	}
+
+	start, err := time.Parse("15:04", preference.QuietHoursStart)
+	if err != nil {
+		return false
+	}
+	end, err := time.Parse("15:04", preference.QuietHoursEnd)
+	if err != nil {
+		return false
+	}
+
+	current := now.Hour()*60 + now.Minute()
+	from := start.Hour()*60 + start.Minute()
+	to := end.Hour()*60 + end.Minute()
+
+	if from <= to {
+		return current >= from && current < to
+	}
+
+	return current >= from || current < to
+}
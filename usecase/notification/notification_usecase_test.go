@@ -0,0 +1,141 @@
+package notification
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type NotificationUseCaseTest struct {
+	suite.Suite
+	notificationUseCase *notificationUseCase
+	notificationRepo    *mocks.NotificationRepository
+	userRepo            *mocks.UserRepository
+	sentMails           []model.PayloadMail
+}
+
+func TestNotificationUseCase(t *testing.T) {
+	suite.Run(t, new(NotificationUseCaseTest))
+}
+
+func (m *NotificationUseCaseTest) SetupTest() {
+	m.notificationRepo = &mocks.NotificationRepository{}
+	m.userRepo = &mocks.UserRepository{}
+	m.sentMails = nil
+	m.notificationUseCase = NewNotificationUseCase(m.notificationRepo, m.userRepo)
+	m.notificationUseCase.sendMail = func(payload model.PayloadMail) error {
+		m.sentMails = append(m.sentMails, payload)
+		return nil
+	}
+}
+
+func (m *NotificationUseCaseTest) TestSendNotificationSuccess() {
+	user := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, Name: "arby", Email: "arby@mail.com"}
+	notification := &model.Notification{Title: "Title", Message: "Message"}
+
+	m.userRepo.On("GetUserByIDRepository", "user").Return(user, nil)
+	m.notificationRepo.On("GetNotificationPreferenceByUserIdRepository", "user").Return(nil, nil)
+	m.notificationRepo.On("CreateNotificationRepository", notification).Return(notification, nil)
+
+	err := m.notificationUseCase.SendNotificationUseCase("user", notification)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "user", notification.UserID)
+	assert.Len(m.T(), m.sentMails, 1)
+	assert.Equal(m.T(), "arby@mail.com", m.sentMails[0].RecipentEmail)
+	assert.Equal(m.T(), "Title", m.sentMails[0].Subject)
+}
+
+func (m *NotificationUseCaseTest) TestSendNotificationUserNotFound() {
+	m.userRepo.On("GetUserByIDRepository", "user").Return(nil, errors.New("repository error"))
+
+	err := m.notificationUseCase.SendNotificationUseCase("user", &model.Notification{})
+
+	assert.EqualError(m.T(), err, "user not found")
+}
+
+func (m *NotificationUseCaseTest) TestReadNotificationError() {
+	m.notificationRepo.On("ReadNotificationByIdRepository", "user", "id").Return(errors.New("repository error"))
+
+	err := m.notificationUseCase.ReadNotificationUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "notification not found")
+}
+
+func (m *NotificationUseCaseTest) TestSendNotificationQuietHoursSkipsEmail() {
+	user := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, Email: "arby@mail.com"}
+	preference := &model.NotificationPreference{InApp: true, Email: true, QuietHoursStart: "22:00", QuietHoursEnd: "06:00"}
+	notification := &model.Notification{Title: "Title"}
+
+	m.notificationUseCase.now = func() time.Time {
+		return time.Date(2026, time.March, 5, 23, 30, 0, 0, time.Local)
+	}
+	m.userRepo.On("GetUserByIDRepository", "user").Return(user, nil)
+	m.notificationRepo.On("GetNotificationPreferenceByUserIdRepository", "user").Return(preference, nil)
+	m.notificationRepo.On("CreateNotificationRepository", notification).Return(notification, nil)
+
+	err := m.notificationUseCase.SendNotificationUseCase("user", notification)
+
+	assert.NoError(m.T(), err)
+	assert.Empty(m.T(), m.sentMails)
+}
+
+func (m *NotificationUseCaseTest) TestSendNotificationEmailOnly() {
+	user := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, Email: "arby@mail.com"}
+	preference := &model.NotificationPreference{InApp: false, Email: true}
+
+	m.userRepo.On("GetUserByIDRepository", "user").Return(user, nil)
+	m.notificationRepo.On("GetNotificationPreferenceByUserIdRepository", "user").Return(preference, nil)
+
+	err := m.notificationUseCase.SendNotificationUseCase("user", &model.Notification{Title: "Title"})
+
+	assert.NoError(m.T(), err)
+	assert.Len(m.T(), m.sentMails, 1)
+	m.notificationRepo.AssertNotCalled(m.T(), "CreateNotificationRepository", mock.Anything)
+}
+
+func (m *NotificationUseCaseTest) TestUpdateNotificationPreferenceSuccess() {
+	email := false
+	days := 5
+
+	m.notificationRepo.On("GetNotificationPreferenceByUserIdRepository", "user").Return(nil, nil)
+	m.notificationRepo.On("SaveNotificationPreferenceRepository", mock.Anything).Return(func(preference *model.NotificationPreference) *model.NotificationPreference {
+		return preference
+	}, nil)
+
+	resp, err := m.notificationUseCase.UpdateNotificationPreferenceUseCase("user", dto.NotificationPreferenceDto{
+		Email:              &email,
+		QuietHoursStart:    "21:00",
+		QuietHoursEnd:      "07:00",
+		ReminderDaysBefore: &days,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.True(m.T(), resp.InApp)
+	assert.False(m.T(), resp.Email)
+	assert.Equal(m.T(), 5, resp.ReminderDaysBefore)
+}
+
+func (m *NotificationUseCaseTest) TestUpdateNotificationPreferenceInvalidQuietHours() {
+	_, err := m.notificationUseCase.UpdateNotificationPreferenceUseCase("user", dto.NotificationPreferenceDto{
+		QuietHoursStart: "10pm",
+		QuietHoursEnd:   "07:00",
+	})
+
+	assert.EqualError(m.T(), err, "quiet_hours_start must use the HH:MM format")
+}
+
+func (m *NotificationUseCaseTest) TestIsQuietHours() {
+	preference := &model.NotificationPreference{QuietHoursStart: "22:00", QuietHoursEnd: "06:00"}
+
+	assert.True(m.T(), IsQuietHours(preference, time.Date(2026, time.March, 5, 2, 0, 0, 0, time.Local)))
+	assert.False(m.T(), IsQuietHours(preference, time.Date(2026, time.March, 5, 12, 0, 0, 0, time.Local)))
+	assert.False(m.T(), IsQuietHours(&model.NotificationPreference{}, time.Now()))
+}
@@ -0,0 +1,58 @@
+package pdam
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+	"strings"
+)
+
+// NewBulkCatalog lets admins upload and download PDAM regions, keyed by code.
+// Block tariffs are not part of the sheet; they stay managed per region.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	regions := func() ([]model.PdamRegion, error) {
+		var regions []model.PdamRegion
+		err := bulkRepository.FindAllRepository(&regions)
+		return regions, err
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_PDAM_REGION,
+		Columns: []string{"code", "name", "biller_code", "customer_id_pattern", "fixed_fee"},
+		Key:     []string{"code"},
+		Export: func() ([][]string, error) {
+			regions, err := regions()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(regions))
+			for i, region := range regions {
+				rows[i] = []string{region.Code, region.Name, region.BillerCode, region.CustomerIdPattern, bulk.FormatFloat(region.FixedFee)}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			regions, err := regions()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.PdamRegion{}
+			for _, region := range regions {
+				existing[region.Code] = region
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				code := strings.ToLower(row.Required("code"))
+				region, update := existing[code]
+				region.Code = code
+				region.Name = row.Required("name")
+				region.BillerCode = row.Required("biller_code")
+				region.CustomerIdPattern = row.String("customer_id_pattern")
+				region.FixedFee = row.Float("fixed_fee")
+				row.Error(validateRegion(&region))
+				return &region, update
+			}, nil
+		},
+	}
+}
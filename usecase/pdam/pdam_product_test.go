@@ -0,0 +1,65 @@
+package pdam
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller/billertest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testRegion = &model.PdamRegion{
+	Code:              "pdam_jakarta",
+	Name:              "PAM Jaya",
+	BillerCode:        "PAMJAYA",
+	CustomerIdPattern: `^[0-9]{8}$`,
+	FixedFee:          7450,
+	Tariffs: []model.PdamTariff{
+		{CustomerClass: "R2", UpTo: 0, RatePerM3: 5500},
+		{CustomerClass: "R2", UpTo: 20, RatePerM3: 4700},
+		{CustomerClass: "R2", UpTo: 10, RatePerM3: 3550},
+	},
+}
+
+func TestCalculatePDAMBill(t *testing.T) {
+	tariffs := classTariffs(testRegion, "R2")
+
+	assert.Equal(t, []int{10, 20, 0}, []int{tariffs[0].UpTo, tariffs[1].UpTo, tariffs[2].UpTo})
+	assert.Equal(t, 28400.0, calculatePDAMBill(tariffs, 8))
+	assert.Equal(t, 110000.0, calculatePDAMBill(tariffs, 25))
+	assert.Equal(t, 15000.0, calculatePDAMBill([]model.PdamTariff{{UpTo: 10, RatePerM3: 1000}}, 15))
+}
+
+func TestProductPrice(t *testing.T) {
+	repo := &mocks.PdamRegionRepository{}
+	repo.On("GetPdamRegionByCodeRepository", "pdam_jakarta").Return(testRegion, nil)
+	product := NewProduct(repo)
+
+	bill, err := product.Price(billertest.Inquiry("pdam_jakarta", "12345678", "March-2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.Pdam)
+	assert.Equal(t, "PAMJAYA", detail.PartnerId)
+	assert.Equal(t, "R2", detail.CustomerClass)
+	assert.Equal(t, monthlyUsage("12345678", "March-2026"), detail.Usage)
+	assert.Equal(t, detail.UsageCharge+7450, bill.Price)
+
+	fieldErrors, err := product.ValidateForProvider("pdam_jakarta", "12345678")
+	assert.NoError(t, err)
+	assert.Empty(t, fieldErrors)
+
+	fieldErrors, err = product.ValidateForProvider("pdam_jakarta", "1234567")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "pattern", Message: "customer ID is not a valid PAM Jaya customer ID"},
+	}, fieldErrors)
+}
+
+func TestProductRegionNotFound(t *testing.T) {
+	repo := &mocks.PdamRegionRepository{}
+	repo.On("GetPdamRegionByCodeRepository", "pdam_jakarta").Return(nil, nil)
+
+	_, err := NewProduct(repo).Price(billertest.Inquiry("pdam_jakarta", "12345678", "March-2026"))
+
+	assert.EqualError(t, err, "PDAM region pdam_jakarta not found")
+}
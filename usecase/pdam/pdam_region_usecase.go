@@ -0,0 +1,158 @@
+package pdam
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type PdamRegionUseCase interface {
+	CreatePdamRegionUseCase(payload *model.PdamRegion) (*model.PdamRegion, error)
+	GetAllPdamRegionUseCase(name string, page, limit int) ([]*model.PdamRegion, error)
+	GetPdamRegionByIdUseCase(id string) (*model.PdamRegion, error)
+	UpdatePdamRegionByIdUseCase(id string, payload *model.PdamRegion) (*model.PdamRegion, error)
+	DeletePdamRegionByIdUseCase(id string) error
+}
+
+type pdamRegionUseCase struct {
+	pdamRegionRepository repository.PdamRegionRepository
+}
+
+func NewPdamRegionUseCase(pdamRegionRepository repository.PdamRegionRepository) *pdamRegionUseCase {
+	return &pdamRegionUseCase{
+		pdamRegionRepository: pdamRegionRepository,
+	}
+}
+
+func (uc *pdamRegionUseCase) CreatePdamRegionUseCase(payload *model.PdamRegion) (*model.PdamRegion, error) {
+	payload.Code = strings.ToLower(strings.TrimSpace(payload.Code))
+	if payload.Code == "" || payload.Name == "" || payload.BillerCode == "" {
+		return nil, errors.New("code, name and biller_code are required")
+	}
+	if err := validateRegion(payload); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.pdamRegionRepository.GetPdamRegionByCodeRepository(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("PDAM region %s already exists", payload.Code)
+	}
+
+	region, err := uc.pdamRegionRepository.CreatePdamRegionRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating PDAM region in database: %w", err)
+	}
+
+	return region, nil
+}
+
+func (uc *pdamRegionUseCase) GetAllPdamRegionUseCase(name string, page, limit int) ([]*model.PdamRegion, error) {
+	return uc.pdamRegionRepository.GetAllPdamRegionRepository(name, page, limit)
+}
+
+func (uc *pdamRegionUseCase) GetPdamRegionByIdUseCase(id string) (*model.PdamRegion, error) {
+	region, err := uc.pdamRegionRepository.GetPdamRegionByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("PDAM region not found")
+	}
+
+	return region, nil
+}
+
+func (uc *pdamRegionUseCase) UpdatePdamRegionByIdUseCase(id string, payload *model.PdamRegion) (*model.PdamRegion, error) {
+	region, err := uc.pdamRegionRepository.GetPdamRegionByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update PDAM region: %v", err)
+	}
+
+	if payload.Name != "" {
+		region.Name = payload.Name
+	}
+	if payload.BillerCode != "" {
+		region.BillerCode = payload.BillerCode
+	}
+	if payload.CustomerIdPattern != "" {
+		region.CustomerIdPattern = payload.CustomerIdPattern
+	}
+	if payload.FixedFee != 0 {
+		region.FixedFee = payload.FixedFee
+	}
+	// Tariffs are replaced as a whole; leaving them out keeps the stored ones.
+	region.Tariffs = payload.Tariffs
+	if err := validateRegion(region); err != nil {
+		return nil, err
+	}
+	region.UpdatedAt = time.Now()
+
+	updated, err := uc.pdamRegionRepository.UpdatePdamRegionByIdRepository(id, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update PDAM region: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *pdamRegionUseCase) DeletePdamRegionByIdUseCase(id string) error {
+	err := uc.pdamRegionRepository.DeletePdamRegionByIdRepository(id)
+	if err != nil {
+		return errors.New("PDAM region not found")
+	}
+
+	return nil
+}
+
+// validateRegion checks the customer ID pattern and, when the region carries
+// tariffs, that every customer class has increasing blocks ending in an
+// open-ended one.
+func validateRegion(region *model.PdamRegion) error {
+	if region.CustomerIdPattern == "" {
+		return errors.New("customer_id_pattern is required")
+	}
+	if _, err := regexp.Compile(region.CustomerIdPattern); err != nil {
+		return fmt.Errorf("invalid customer_id_pattern: %v", err)
+	}
+	if region.FixedFee < 0 {
+		return errors.New("fixed_fee must not be negative")
+	}
+	if region.Tariffs == nil {
+		return nil
+	}
+	if len(region.Tariffs) == 0 {
+		return errors.New("at least one tariff is required")
+	}
+
+	classes := map[string]bool{}
+	for i := range region.Tariffs {
+		tariff := &region.Tariffs[i]
+		tariff.CustomerClass = strings.ToUpper(strings.TrimSpace(tariff.CustomerClass))
+		if tariff.CustomerClass == "" {
+			return errors.New("customer_class is required")
+		}
+		if tariff.UpTo < 0 || tariff.RatePerM3 <= 0 {
+			return fmt.Errorf("invalid tariff block for class %s", tariff.CustomerClass)
+		}
+		classes[tariff.CustomerClass] = true
+	}
+
+	for class := range classes {
+		blocks := classTariffs(region, class)
+		for i, block := range blocks {
+			last := i == len(blocks)-1
+			if last != (block.UpTo == 0) {
+				return fmt.Errorf("class %s must have exactly one open-ended block", class)
+			}
+			if i > 0 && !last && block.UpTo == blocks[i-1].UpTo {
+				return fmt.Errorf("class %s has two blocks up to %d m3", class, block.UpTo)
+			}
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,101 @@
+package pdam
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type PdamRegionUseCaseTest struct {
+	suite.Suite
+	pdamRegionUseCase PdamRegionUseCase
+	pdamRegionRepo    *mocks.PdamRegionRepository
+}
+
+func TestPdamRegionUseCase(t *testing.T) {
+	suite.Run(t, new(PdamRegionUseCaseTest))
+}
+
+func (m *PdamRegionUseCaseTest) SetupTest() {
+	m.pdamRegionRepo = &mocks.PdamRegionRepository{}
+	m.pdamRegionUseCase = NewPdamRegionUseCase(m.pdamRegionRepo)
+}
+
+func (m *PdamRegionUseCaseTest) TestCreatePdamRegionSuccess() {
+	m.pdamRegionRepo.On("GetPdamRegionByCodeRepository", "pdam_bandung").Return(nil, nil)
+	m.pdamRegionRepo.On("CreatePdamRegionRepository", mock.Anything).Return(func(region *model.PdamRegion) *model.PdamRegion {
+		return region
+	}, nil)
+
+	resp, err := m.pdamRegionUseCase.CreatePdamRegionUseCase(&model.PdamRegion{
+		Code:              "PDAM_Bandung",
+		Name:              "PDAM Tirtawening",
+		BillerCode:        "PDAMBDG",
+		CustomerIdPattern: `^[0-9]{10}$`,
+		Tariffs: []model.PdamTariff{
+			{CustomerClass: "r2", UpTo: 10, RatePerM3: 3600},
+			{CustomerClass: "r2", UpTo: 0, RatePerM3: 5600},
+		},
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "pdam_bandung", resp.Code)
+	assert.Equal(m.T(), "R2", resp.Tariffs[0].CustomerClass)
+}
+
+func (m *PdamRegionUseCaseTest) TestCreatePdamRegionInvalidPattern() {
+	_, err := m.pdamRegionUseCase.CreatePdamRegionUseCase(&model.PdamRegion{
+		Code:              "pdam_bandung",
+		Name:              "PDAM Tirtawening",
+		BillerCode:        "PDAMBDG",
+		CustomerIdPattern: `^[0-9{10}$`,
+	})
+
+	assert.ErrorContains(m.T(), err, "invalid customer_id_pattern")
+}
+
+func (m *PdamRegionUseCaseTest) TestCreatePdamRegionWithoutOpenBlock() {
+	_, err := m.pdamRegionUseCase.CreatePdamRegionUseCase(&model.PdamRegion{
+		Code:              "pdam_bandung",
+		Name:              "PDAM Tirtawening",
+		BillerCode:        "PDAMBDG",
+		CustomerIdPattern: `^[0-9]{10}$`,
+		Tariffs: []model.PdamTariff{
+			{CustomerClass: "R2", UpTo: 10, RatePerM3: 3600},
+			{CustomerClass: "R2", UpTo: 20, RatePerM3: 5600},
+		},
+	})
+
+	assert.EqualError(m.T(), err, "class R2 must have exactly one open-ended block")
+}
+
+func (m *PdamRegionUseCaseTest) TestCreatePdamRegionDuplicate() {
+	m.pdamRegionRepo.On("GetPdamRegionByCodeRepository", "pdam_bandung").Return(&model.PdamRegion{}, nil)
+
+	_, err := m.pdamRegionUseCase.CreatePdamRegionUseCase(&model.PdamRegion{
+		Code:              "pdam_bandung",
+		Name:              "PDAM Tirtawening",
+		BillerCode:        "PDAMBDG",
+		CustomerIdPattern: `^[0-9]{10}$`,
+	})
+
+	assert.EqualError(m.T(), err, "PDAM region pdam_bandung already exists")
+}
+
+func (m *PdamRegionUseCaseTest) TestUpdatePdamRegionKeepsTariffs() {
+	m.pdamRegionRepo.On("GetPdamRegionByIdRepository", "id").Return(&model.PdamRegion{
+		CustomerIdPattern: `^[0-9]{10}$`,
+		Tariffs:           []model.PdamTariff{{CustomerClass: "R2", RatePerM3: 3600}},
+	}, nil)
+	m.pdamRegionRepo.On("UpdatePdamRegionByIdRepository", "id", mock.MatchedBy(func(region *model.PdamRegion) bool {
+		return region.Tariffs == nil && region.FixedFee == 5000
+	})).Return(&model.PdamRegion{}, nil)
+
+	_, err := m.pdamRegionUseCase.UpdatePdamRegionByIdUseCase("id", &model.PdamRegion{FixedFee: 5000})
+
+	assert.NoError(m.T(), err)
+}
@@ -0,0 +1,151 @@
+package postpaid
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	ppnRate = 0.11
+
+	extraMinuteFee = 1000.0
+	extraDataFeeMB = 10.0
+)
+
+var phonePattern = regexp.MustCompile(`^08[0-9]{8,11}$`)
+
+type mobilePlan struct {
+	Name string
+	Fee  float64
+}
+
+// mobileOperator lists the phone prefix table providers its numbers are
+// registered under.
+type mobileOperator struct {
+	Name      string
+	Providers []string
+	Plans     []mobilePlan
+}
+
+// mobileOperators are keyed by the product ID users pick on inquiry.
+var mobileOperators = map[string]mobileOperator{
+	"halo": {
+		Name:      "Telkomsel Halo",
+		Providers: []string{"Halo", "Telkomsel"},
+		Plans:     []mobilePlan{{"Halo Kick 50", 50000}, {"Halo 100", 100000}, {"Halo 150", 150000}, {"Halo 250", 250000}},
+	},
+	"matrix": {
+		Name:      "Indosat Matrix",
+		Providers: []string{"Indosat"},
+		Plans:     []mobilePlan{{"Prime Postpaid 75", 75000}, {"Prime Postpaid 125", 125000}, {"Prime Postpaid 200", 200000}},
+	},
+}
+
+// NewMobileProduct plugs postpaid mobile bills (Halo, Matrix) into the biller
+// engine. The inquiry's product ID selects the operator; the number's
+// operator comes from the same prefix table as pulsa and data.
+func NewMobileProduct(phonePrefixRepository repository.PhonePrefixRepository) *biller.Product {
+	return &biller.Product{
+		Code:               model.PRODUCT_POSTPAID_MOBILE,
+		Category:           model.PRODUCT_POSTPAID_MOBILE,
+		Name:               "PASCABAYAR",
+		TransactionPrefix:  "PASCA",
+		ValidateCustomerId: validatePhoneNumber,
+		ValidateForProvider: func(provider, customerID string) ([]model.FieldError, error) {
+			return validateOperator(phonePrefixRepository, provider, customerID)
+		},
+		Price: priceMobileBill,
+		Detail: func() interface{} {
+			return &model.PostpaidMobileBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			receipt.ProviderName = detail.(*model.PostpaidMobileBill).ProviderName
+		},
+	}
+}
+
+func validatePhoneNumber(customerID string) error {
+	if !phonePattern.MatchString(customerID) {
+		return errors.New("invalid phone number")
+	}
+
+	return nil
+}
+
+// validateOperator checks that the phone number is registered under the
+// operator picked on inquiry.
+func validateOperator(phonePrefixRepository repository.PhonePrefixRepository, productType, phone string) ([]model.FieldError, error) {
+	operator, ok := mobileOperators[productType]
+	if !ok {
+		return nil, fmt.Errorf("postpaid operator %s not found", productType)
+	}
+	prefix, err := phonePrefixRepository.GetPhonePrefixByPhoneRepository(phone)
+	if err != nil {
+		return nil, err
+	}
+	if prefix == nil || !hasProvider(operator.Providers, prefix.Provider) {
+		return []model.FieldError{
+			{Field: "customer_id", Rule: "operator", Message: fmt.Sprintf("phone number does not belong to %s", operator.Name)},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func priceMobileBill(inquiry *biller.Inquiry) (*biller.Bill, error) {
+	operator, ok := mobileOperators[inquiry.ProductType]
+	if !ok {
+		return nil, fmt.Errorf("postpaid operator %s not found", inquiry.ProductType)
+	}
+
+	plan := operator.Plans[subscriberIndex(inquiry.ProductType, inquiry.Payload.CustomerId, len(operator.Plans))]
+	// Usage is fixed per subscriber and period, so asking for the same bill
+	// again gives the same amount.
+	usage := subscriberIndex(inquiry.ProductType+inquiry.Payload.Period, inquiry.Payload.CustomerId, 60*4)
+	extraMinutes := usage % 60
+	extraDataMB := usage / 60 * 512
+	usageFee := float64(extraMinutes)*extraMinuteFee + float64(extraDataMB)*extraDataFeeMB
+	tax := (plan.Fee + usageFee) * ppnRate
+	price := plan.Fee + usageFee + tax
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Tagihan %s %s ", operator.Name, inquiry.Payload.Period),
+		Detail: &model.PostpaidMobileBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: operator.Name,
+			Type:         inquiry.ProductType,
+			Name:         inquiry.User.Name,
+			Period:       inquiry.Payload.Period,
+			PlanName:     plan.Name,
+			PlanFee:      plan.Fee,
+			ExtraMinutes: extraMinutes,
+			ExtraDataMB:  extraDataMB,
+			UsageFee:     usageFee,
+			Tax:          tax,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        price,
+		},
+	}, nil
+}
+
+func hasProvider(providers []string, provider string) bool {
+	for _, p := range providers {
+		if strings.EqualFold(p, provider) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// subscriberIndex picks a stable plan or package for a subscriber, so every
+// month's bill is for the same subscription.
+func subscriberIndex(productType, customerID string, n int) int {
+	return int(biller.Hash(productType+customerID) % uint32(n))
+}
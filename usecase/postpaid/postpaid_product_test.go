@@ -0,0 +1,67 @@
+package postpaid
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller/billertest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestValidatePhoneNumber(t *testing.T) {
+	assert.NoError(t, validatePhoneNumber("081100001111"))
+	assert.EqualError(t, validatePhoneNumber("+6281100001111"), "invalid phone number")
+	assert.Error(t, validatePhoneNumber("0811"))
+}
+
+func TestMobileProductPrice(t *testing.T) {
+	phonePrefixRepository := &mocks.PhonePrefixRepository{}
+	phonePrefixRepository.On("GetPhonePrefixByPhoneRepository", "081100001111").Return(&model.PhonePrefix{Prefix: "0811", Provider: "Halo"}, nil)
+	phonePrefixRepository.On("GetPhonePrefixByPhoneRepository", "089900001111").Return(nil, nil)
+	product := NewMobileProduct(phonePrefixRepository)
+
+	bill, err := product.Price(billertest.Inquiry("halo", "081100001111", "March-2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.PostpaidMobileBill)
+	assert.Equal(t, "Telkomsel Halo", detail.ProviderName)
+	assert.Equal(t, "March-2026", detail.Period)
+	assert.Equal(t, detail.PlanFee+detail.UsageFee+detail.Tax, bill.Price)
+
+	again, _ := product.Price(billertest.Inquiry("halo", "081100001111", "March-2026"))
+	assert.Equal(t, bill.Price, again.Price)
+	assert.Equal(t, detail.PlanName, again.Detail.(*model.PostpaidMobileBill).PlanName)
+
+	fieldErrors, err := product.ValidateForProvider("halo", "081100001111")
+	assert.NoError(t, err)
+	assert.Empty(t, fieldErrors)
+
+	fieldErrors, err = product.ValidateForProvider("matrix", "081100001111")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "operator", Message: "phone number does not belong to Indosat Matrix"},
+	}, fieldErrors)
+
+	fieldErrors, err = product.ValidateForProvider("halo", "089900001111")
+	assert.NoError(t, err)
+	assert.Equal(t, "phone number does not belong to Telkomsel Halo", fieldErrors[0].Message)
+
+	_, err = product.ValidateForProvider("xl", "081700001111")
+	assert.EqualError(t, err, "postpaid operator xl not found")
+
+	_, err = product.Price(billertest.Inquiry("xl", "081700001111", "March-2026"))
+	assert.EqualError(t, err, "postpaid operator xl not found")
+}
+
+func TestCableTvProductPrice(t *testing.T) {
+	assert.Error(t, validateTvCustomerNumber("1234"))
+
+	bill, err := CableTvProduct.Price(billertest.Inquiry("mncvision", "123456789", "March-2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.CableTvBill)
+	assert.Equal(t, "MNC Vision", detail.ProviderName)
+	assert.Equal(t, detail.PackageFee+detail.Tax, bill.Price)
+
+	_, err = CableTvProduct.Price(billertest.Inquiry("netflix", "123456789", "March-2026"))
+	assert.EqualError(t, err, "TV provider netflix not found")
+}
@@ -0,0 +1,90 @@
+package postpaid
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"regexp"
+)
+
+var tvCustomerPattern = regexp.MustCompile(`^[0-9]{8,16}$`)
+
+type tvPackage struct {
+	Name string
+	Fee  float64
+}
+
+type tvProvider struct {
+	Name     string
+	Packages []tvPackage
+}
+
+// tvProviders are keyed by the product ID users pick on inquiry.
+var tvProviders = map[string]tvProvider{
+	"mncvision": {
+		Name:     "MNC Vision",
+		Packages: []tvPackage{{"Family", 139000}, {"Venus", 199000}, {"Galaxy", 289000}},
+	},
+	"transvision": {
+		Name:     "Transvision",
+		Packages: []tvPackage{{"Basic", 99000}, {"Silver", 169000}, {"Gold", 249000}},
+	},
+	"firstmedia": {
+		Name:     "First Media",
+		Packages: []tvPackage{{"Family HD", 185000}, {"Extra HD", 275000}, {"Supreme X", 395000}},
+	},
+}
+
+// CableTvProduct plugs cable and satellite TV bills into the biller engine.
+// The inquiry's product ID selects the provider.
+var CableTvProduct = &biller.Product{
+	Code:               model.PRODUCT_CABLE_TV,
+	Category:           model.PRODUCT_CABLE_TV,
+	Name:               "TV KABEL",
+	TransactionPrefix:  "TV",
+	ValidateCustomerId: validateTvCustomerNumber,
+	Price:              priceTvBill,
+	Detail: func() interface{} {
+		return &model.CableTvBill{}
+	},
+	Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+		receipt.ProviderName = detail.(*model.CableTvBill).ProviderName
+	},
+}
+
+func validateTvCustomerNumber(customerID string) error {
+	if !tvCustomerPattern.MatchString(customerID) {
+		return errors.New("customer number must be 8 to 16 digits")
+	}
+
+	return nil
+}
+
+func priceTvBill(inquiry *biller.Inquiry) (*biller.Bill, error) {
+	provider, ok := tvProviders[inquiry.ProductType]
+	if !ok {
+		return nil, fmt.Errorf("TV provider %s not found", inquiry.ProductType)
+	}
+
+	pkg := provider.Packages[subscriberIndex(inquiry.ProductType, inquiry.Payload.CustomerId, len(provider.Packages))]
+	tax := pkg.Fee * ppnRate
+	price := pkg.Fee + tax
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Tagihan %s %s ", provider.Name, inquiry.Payload.Period),
+		Detail: &model.CableTvBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: provider.Name,
+			Type:         inquiry.ProductType,
+			Name:         inquiry.User.Name,
+			Period:       inquiry.Payload.Period,
+			PackageName:  pkg.Name,
+			PackageFee:   pkg.Fee,
+			Tax:          tax,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        price,
+		},
+	}, nil
+}
@@ -0,0 +1,91 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+	"strings"
+)
+
+// NewBulkCatalog lets admins upload and download pulsa and paket data
+// products, keyed by code, and adjust their prices per provider.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	products := func() ([]model.PulsaPaketData, error) {
+		var products []model.PulsaPaketData
+		err := bulkRepository.FindAllRepository(&products)
+		return products, err
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_PPD,
+		Columns: []string{"code", "name", "type", "provider", "price", "is_active", "description"},
+		Key:     []string{"code"},
+		Export: func() ([][]string, error) {
+			products, err := products()
+			if err != nil {
+				return nil, err
+			}
+
+			rows := make([][]string, len(products))
+			for i, product := range products {
+				rows[i] = []string{product.Code, product.Name, product.Type, product.Provider, bulk.FormatFloat(product.Price), bulk.FormatBool(product.IsActive), product.Description}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			products, err := products()
+			if err != nil {
+				return nil, err
+			}
+			existing := map[string]model.PulsaPaketData{}
+			for _, product := range products {
+				existing[product.Code] = product
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				product, update := existing[row.Required("code")]
+				product.Code = row.String("code")
+				product.Name = row.Required("name")
+				product.Type = strings.ToLower(row.Required("type"))
+				if product.Type != "" && product.Type != model.PULSA_TYPE && product.Type != model.PAKET_DATA_TYPE {
+					row.Errorf("type must be %s or %s", model.PULSA_TYPE, model.PAKET_DATA_TYPE)
+				}
+				product.Provider = row.Required("provider")
+				product.Price = row.Float("price")
+				if product.Price <= 0 {
+					row.Errorf("price must be greater than 0")
+				} else if product.Price < product.CostPrice {
+					row.Errorf("price must not be below the cost price %.0f", product.CostPrice)
+				}
+				product.IsActive = row.Bool("is_active", product.IsActive)
+				product.Description = row.String("description")
+				return &product, update
+			}, nil
+		},
+		Prices: func(provider string) ([]bulk.PricedItem, error) {
+			products, err := products()
+			if err != nil {
+				return nil, err
+			}
+
+			var items []bulk.PricedItem
+			for _, product := range products {
+				if provider != "" && !strings.EqualFold(product.Provider, provider) {
+					continue
+				}
+				product := product
+				items = append(items, bulk.PricedItem{
+					Code:      product.Code,
+					Name:      product.Name,
+					Price:     product.Price,
+					CostPrice: product.CostPrice,
+					WithPrice: func(price float64) interface{} {
+						product.Price = price
+						return &product
+					},
+				})
+			}
+			return items, nil
+		},
+	}
+}
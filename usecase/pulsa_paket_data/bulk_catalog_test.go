@@ -0,0 +1,67 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/bulk"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestBulkCatalogImport(t *testing.T) {
+	inactive := false
+	existing := model.PulsaPaketData{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "ppd-1"}, Code: "TSEL10", Name: "Telkomsel 10K", Type: model.PULSA_TYPE, Provider: "Telkomsel", Price: 10500, CostPrice: 10200, IsActive: &inactive}
+
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	mockBulkRepo.On("FindAllRepository", mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]model.PulsaPaketData) = []model.PulsaPaketData{existing}
+	}).Return(nil)
+	mockBulkRepo.On("SaveAllRepository", mock.Anything).Return(nil)
+
+	service := bulk.NewBulkUseCase(mockBulkRepo, NewBulkCatalog(mockBulkRepo))
+
+	result, err := service.ImportCatalogUseCase(model.CATALOG_PPD, "ppd.csv", strings.NewReader(
+		"code,name,type,provider,price,is_active,description\n"+
+			"TSEL10,Telkomsel 10K,Pulsa,Telkomsel,11000,,\n"+
+			"XL1GB,XL 1GB,data,XL,15000,true,1GB 30 hari\n"), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, 1, result.Created)
+	assert.Equal(t, 1, result.Updated)
+
+	records := mockBulkRepo.Calls[1].Arguments.Get(0).([]interface{})
+	updated := records[0].(*model.PulsaPaketData)
+	assert.Equal(t, "ppd-1", updated.ID)
+	assert.Equal(t, model.PULSA_TYPE, updated.Type)
+	assert.Equal(t, float64(11000), updated.Price)
+	assert.Equal(t, float64(10200), updated.CostPrice)
+	assert.False(t, *updated.IsActive)
+	assert.Equal(t, "", records[1].(*model.PulsaPaketData).ID)
+}
+
+func TestBulkCatalogImportInvalid(t *testing.T) {
+	existing := model.PulsaPaketData{Code: "TSEL10", CostPrice: 10200}
+
+	mockBulkRepo := mocks.NewBulkRepository(t)
+	mockBulkRepo.On("FindAllRepository", mock.Anything).Run(func(args mock.Arguments) {
+		*args.Get(0).(*[]model.PulsaPaketData) = []model.PulsaPaketData{existing}
+	}).Return(nil)
+
+	service := bulk.NewBulkUseCase(mockBulkRepo, NewBulkCatalog(mockBulkRepo))
+
+	result, err := service.ImportCatalogUseCase(model.CATALOG_PPD, "ppd.csv", strings.NewReader(
+		"code,name,type,provider,price,is_active,description\n"+
+			"TSEL10,Telkomsel 10K,pulsa,Telkomsel,10000,,\n"+
+			"XL1GB,XL 1GB,voucher,,15000,maybe,\n"), false)
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.BulkRowError{
+		{Row: 2, Message: "price must not be below the cost price 10200"},
+		{Row: 3, Message: "type must be pulsa or data"},
+		{Row: 3, Message: "provider is required"},
+		{Row: 3, Message: "is_active must be true or false"},
+	}, result.Errors)
+}
@@ -0,0 +1,40 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/catalog"
+)
+
+// NewCatalog exposes pulsa and paket data products to the catalog sync.
+func NewCatalog(ppdRepository repository.PulsaPaketDataRepository) *catalog.Catalog {
+	return &catalog.Catalog{
+		Code: model.CATALOG_PPD,
+		Items: func() ([]model.CatalogItem, error) {
+			products, err := ppdRepository.GetPulsaPaketDataCatalog()
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]model.CatalogItem, len(products))
+			for i, product := range products {
+				items[i] = model.CatalogItem{
+					ID:        product.ID,
+					Code:      product.Code,
+					Name:      product.Name,
+					CostPrice: product.CostPrice,
+					SellPrice: product.Price,
+					IsActive:  product.IsActive == nil || *product.IsActive,
+				}
+			}
+			return items, nil
+		},
+		Deactivate: func(id string) error {
+			inactive := false
+			return ppdRepository.UpdatePulsaById(id, model.PulsaPaketData{IsActive: &inactive})
+		},
+		SetPrice: func(id string, costPrice, sellPrice float64) error {
+			return ppdRepository.UpdatePulsaById(id, model.PulsaPaketData{CostPrice: costPrice, Price: sellPrice})
+		},
+	}
+}
@@ -0,0 +1,84 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+var (
+	mobileNumberPattern = regexp.MustCompile(`^08[1-9][0-9]{7,10}$`)
+	phonePrefixPattern  = regexp.MustCompile(`^08[1-9][0-9]{1,4}$`)
+)
+
+// NormalizePhoneNumber turns an Indonesian mobile number written in E.164
+// (+62812...), with the bare country code (62812...) or locally (0812...)
+// into its local 08xx form.
+func NormalizePhoneNumber(phone string) (string, error) {
+	local := localForm(phone)
+	if !mobileNumberPattern.MatchString(local) {
+		return "", fmt.Errorf("%s is not a valid mobile number", phone)
+	}
+
+	return local, nil
+}
+
+// E164 formats a normalized 08xx mobile number as +628xx.
+func E164(phone string) string {
+	return "+62" + strings.TrimPrefix(phone, "0")
+}
+
+func normalizePhonePrefix(prefix string) (string, error) {
+	local := localForm(prefix)
+	if !phonePrefixPattern.MatchString(local) {
+		return "", fmt.Errorf("invalid phone prefix %s", prefix)
+	}
+
+	return local, nil
+}
+
+// localForm drops separators people type between digits and replaces the
+// country code with the trunk prefix 0.
+func localForm(phone string) string {
+	digits := strings.Map(func(r rune) rune {
+		switch r {
+		case ' ', '-', '.', '(', ')':
+			return -1
+		}
+		return r
+	}, strings.TrimSpace(phone))
+
+	switch {
+	case strings.HasPrefix(digits, "+62"):
+		return "0" + digits[3:]
+	case strings.HasPrefix(digits, "62"):
+		return "0" + digits[2:]
+	}
+
+	return digits
+}
+
+// detectProvider normalizes a mobile number and looks up its provider in the
+// prefix table.
+func detectProvider(phonePrefixRepository repository.PhonePrefixRepository, phone string) (*model.PhoneProvider, error) {
+	local, err := NormalizePhoneNumber(phone)
+	if err != nil {
+		return nil, err
+	}
+
+	prefix, err := phonePrefixRepository.GetPhonePrefixByPhoneRepository(local)
+	if err != nil {
+		return nil, err
+	}
+	if prefix == nil {
+		return nil, fmt.Errorf("provider of %s is not supported", local)
+	}
+
+	return &model.PhoneProvider{
+		PhoneNumber: local,
+		E164:        E164(local),
+		Provider:    prefix.Provider,
+	}, nil
+}
@@ -0,0 +1,117 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type PhonePrefixUsecase interface {
+	CreatePhonePrefixUseCase(payload *model.PhonePrefix) (*model.PhonePrefix, error)
+	GetAllPhonePrefixUseCase(provider string, page, limit int) ([]*model.PhonePrefix, error)
+	GetPhonePrefixByIdUseCase(id string) (*model.PhonePrefix, error)
+	UpdatePhonePrefixByIdUseCase(id string, payload *model.PhonePrefix) (*model.PhonePrefix, error)
+	DeletePhonePrefixByIdUseCase(id string) error
+	DetectProviderUseCase(phone string) (*model.PhoneProvider, error)
+}
+
+type phonePrefixUsecase struct {
+	phonePrefixRepository repository.PhonePrefixRepository
+}
+
+func NewPhonePrefixUsecase(phonePrefixRepository repository.PhonePrefixRepository) *phonePrefixUsecase {
+	return &phonePrefixUsecase{
+		phonePrefixRepository: phonePrefixRepository,
+	}
+}
+
+func (uc *phonePrefixUsecase) CreatePhonePrefixUseCase(payload *model.PhonePrefix) (*model.PhonePrefix, error) {
+	prefix, err := normalizePhonePrefix(payload.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	payload.Prefix = prefix
+	payload.Provider = strings.TrimSpace(payload.Provider)
+	if payload.Provider == "" {
+		return nil, errors.New("provider is required")
+	}
+
+	existing, err := uc.phonePrefixRepository.GetPhonePrefixByPrefixRepository(payload.Prefix)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("phone prefix %s already belongs to %s", payload.Prefix, existing.Provider)
+	}
+
+	created, err := uc.phonePrefixRepository.CreatePhonePrefixRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating phone prefix in database: %w", err)
+	}
+
+	return created, nil
+}
+
+func (uc *phonePrefixUsecase) GetAllPhonePrefixUseCase(provider string, page, limit int) ([]*model.PhonePrefix, error) {
+	return uc.phonePrefixRepository.GetAllPhonePrefixRepository(provider, page, limit)
+}
+
+func (uc *phonePrefixUsecase) GetPhonePrefixByIdUseCase(id string) (*model.PhonePrefix, error) {
+	prefix, err := uc.phonePrefixRepository.GetPhonePrefixByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("phone prefix not found")
+	}
+
+	return prefix, nil
+}
+
+func (uc *phonePrefixUsecase) UpdatePhonePrefixByIdUseCase(id string, payload *model.PhonePrefix) (*model.PhonePrefix, error) {
+	prefix, err := uc.phonePrefixRepository.GetPhonePrefixByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update phone prefix: %v", err)
+	}
+
+	if payload.Prefix != "" {
+		normalized, err := normalizePhonePrefix(payload.Prefix)
+		if err != nil {
+			return nil, err
+		}
+		if normalized != prefix.Prefix {
+			existing, err := uc.phonePrefixRepository.GetPhonePrefixByPrefixRepository(normalized)
+			if err != nil {
+				return nil, err
+			}
+			if existing != nil {
+				return nil, fmt.Errorf("phone prefix %s already belongs to %s", normalized, existing.Provider)
+			}
+		}
+		prefix.Prefix = normalized
+	}
+	if provider := strings.TrimSpace(payload.Provider); provider != "" {
+		prefix.Provider = provider
+	}
+	prefix.UpdatedAt = time.Now()
+
+	updated, err := uc.phonePrefixRepository.UpdatePhonePrefixByIdRepository(id, prefix)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update phone prefix: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *phonePrefixUsecase) DeletePhonePrefixByIdUseCase(id string) error {
+	err := uc.phonePrefixRepository.DeletePhonePrefixByIdRepository(id)
+	if err != nil {
+		return errors.New("phone prefix not found")
+	}
+
+	return nil
+}
+
+func (uc *phonePrefixUsecase) DetectProviderUseCase(phone string) (*model.PhoneProvider, error) {
+	return detectProvider(uc.phonePrefixRepository, phone)
+}
@@ -0,0 +1,47 @@
+package pulsa
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestCreatePhonePrefixSuccess(t *testing.T) {
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPrefixRepository", "08951").Return(nil, nil)
+	mockPhonePrefixRepo.On("CreatePhonePrefixRepository", mock.Anything).Return(func(prefix *model.PhonePrefix) *model.PhonePrefix {
+		return prefix
+	}, nil)
+
+	service := NewPhonePrefixUsecase(mockPhonePrefixRepo)
+
+	result, err := service.CreatePhonePrefixUseCase(&model.PhonePrefix{Prefix: "+62 8951", Provider: " Three "})
+
+	assert.NoError(t, err)
+	assert.Equal(t, "08951", result.Prefix)
+	assert.Equal(t, "Three", result.Provider)
+}
+
+func TestCreatePhonePrefixInvalid(t *testing.T) {
+	service := NewPhonePrefixUsecase(mocks.NewPhonePrefixRepository(t))
+
+	_, err := service.CreatePhonePrefixUseCase(&model.PhonePrefix{Prefix: "021", Provider: "Telkom"})
+	assert.EqualError(t, err, "invalid phone prefix 021")
+
+	_, err = service.CreatePhonePrefixUseCase(&model.PhonePrefix{Prefix: "0812"})
+	assert.EqualError(t, err, "provider is required")
+}
+
+func TestCreatePhonePrefixDuplicate(t *testing.T) {
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPrefixRepository", "0812").Return(&model.PhonePrefix{Prefix: "0812", Provider: "Telkomsel"}, nil)
+
+	service := NewPhonePrefixUsecase(mockPhonePrefixRepo)
+
+	_, err := service.CreatePhonePrefixUseCase(&model.PhonePrefix{Prefix: "0812", Provider: "XL"})
+
+	assert.EqualError(t, err, "phone prefix 0812 already belongs to Telkomsel")
+}
@@ -4,15 +4,28 @@ import (
 	"BE-Golang/dto"
 	"BE-Golang/model"
 	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/fulfilment"
+	usecaseMocks "BE-Golang/usecase/mocks"
 	"errors"
 	"testing"
+	"time"
 
 	"github.com/google/uuid"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 )
 
 var mockID = uuid.New().String()
 
+func availabilityRepository(t *testing.T) *mocks.ProductAvailabilityRepository {
+	repo := mocks.NewProductAvailabilityRepository(t)
+	repo.On("GetProductAvailabilityRepository", mock.Anything, mock.Anything).Return(nil, nil).Maybe()
+	repo.On("AddProductAvailabilityFailureRepository", mock.Anything, mock.Anything).Return(nil).Maybe()
+	repo.On("TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil).Maybe()
+	repo.On("ResetProductAvailabilityFailuresRepository", mock.Anything, mock.Anything).Return(nil).Maybe()
+	return repo
+}
+
 func TestCreatePulsaPaketDataSuccess(t *testing.T) {
 	mockPPD := model.PulsaPaketData{
 		Name:     "Pulsa 10000",
@@ -23,13 +36,15 @@ func TestCreatePulsaPaketDataSuccess(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("CreatePulsaPaketData", mockPPD).Return(mockPPD, nil)
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	result, err := service.CreatePulsaPaketData(mockPPD)
 
@@ -52,13 +67,15 @@ func TestCreatePulsaPaketDataError(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("CreatePulsaPaketData", mockPPD).Return(mockPPD, errors.New("code already exists"))
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	_, err := service.CreatePulsaPaketData(mockPPD)
 
@@ -82,19 +99,22 @@ func TestGetAllPulsaPaketDataSuccess(t *testing.T) {
 	mockPayload := dto.PulsaDto{
 		Type:        "pulsa",
 		Provider:    "Telkomsel",
-		PhoneNumber: "081323",
+		PhoneNumber: "081323456789",
 	}
 
 	isUser := false
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081323456789").Return(&model.PhonePrefix{Prefix: "0813", Provider: "Telkomsel"}, nil)
 	mockPPDRepository.On("GetAllPulsaPaketData", mockPayload, &isUser).Return(mockPPD, nil)
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	if provider != "" {
 		mockPayload.Provider = provider
@@ -131,7 +151,7 @@ func TestGetAllPulsaPaketDataError2(t *testing.T) {
 	mockPayload = append(mockPayload, dto.PulsaDto{
 		Type:        "pulsa",
 		Provider:    "Telkomsel",
-		PhoneNumber: "081323",
+		PhoneNumber: "081323456789",
 	})
 
 	for _, v := range mockPayload {
@@ -139,10 +159,12 @@ func TestGetAllPulsaPaketDataError2(t *testing.T) {
 			isUser := true
 
 			mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+			mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+			mockProviderRepo := mocks.NewPPDProviderRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockTransactionRepo := mocks.NewTransactionRepository(t)
 			mockDiscountRepo := mocks.NewDiscountRepository(t)
-			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 			_, err := service.GetAllPulsaPaketData(v, &isUser)
 
@@ -154,12 +176,15 @@ func TestGetAllPulsaPaketDataError2(t *testing.T) {
 			isUser := true
 
 			mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+			mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+			mockProviderRepo := mocks.NewPPDProviderRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockTransactionRepo := mocks.NewTransactionRepository(t)
 			mockDiscountRepo := mocks.NewDiscountRepository(t)
+			mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081323456789").Return(&model.PhonePrefix{Prefix: "0813", Provider: "Telkomsel"}, nil)
 			mockPPDRepository.On("GetAllPulsaPaketData", v, &isUser).Return(mockPPD, errors.New("failed get all ppd"))
 
-			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 			_, err := service.GetAllPulsaPaketData(v, &isUser)
 
@@ -172,63 +197,64 @@ func TestGetAllPulsaPaketDataError2(t *testing.T) {
 
 }
 
-func TestGetProviderByPhone(t *testing.T) {
-	// Test cases
+func TestNormalizePhoneNumber(t *testing.T) {
 	testCases := []struct {
 		phone    string
 		expected string
 	}{
-		{"0852xxxxxxxx", "AS"},
-		{"0853xxxxxxxx", "AS"},
-		{"0823xxxxxxxx", "AS"},
-		{"0851xxxxxxxx", "AS"},
-		{"0811xxxxxxxx", "Halo"},
-		{"0812xxxxxxxx", "Telkomsel"},
-		{"0813xxxxxxxx", "Telkomsel"},
-		{"0821xxxxxxxx", "Telkomsel"},
-		{"0822xxxxxxxx", "Telkomsel"},
-		{"0814xxxxxxxx", "Indosat"},
-		{"0815xxxxxxxx", "Indosat"},
-		{"0816xxxxxxxx", "Indosat"},
-		{"0855xxxxxxxx", "Indosat"},
-		{"0856xxxxxxxx", "Indosat"},
-		{"0857xxxxxxxx", "Indosat"},
-		{"0858xxxxxxxx", "Indosat"},
-		{"0817xxxxxxxx", "XL"},
-		{"0818xxxxxxxx", "XL"},
-		{"0859xxxxxxxx", "XL"},
-		{"0877xxxxxxxx", "XL"},
-		{"0878xxxxxxxx", "XL"},
-		{"0838xxxxxxxx", "Axis"},
-		{"0831xxxxxxxx", "Axis"},
-		{"0832xxxxxxxx", "Axis"},
-		{"0833xxxxxxxx", "Axis"},
-		{"0895xxxxxxxx", "Three"},
-		{"0896xxxxxxxx", "Three"},
-		{"0897xxxxxxxx", "Three"},
-		{"0898xxxxxxxx", "Three"},
-		{"0899xxxxxxxx", "Three"},
-		{"0881xxxxxxxx", "Smatfren"},
-		{"0882xxxxxxxx", "Smatfren"},
-		{"0883xxxxxxxx", "Smatfren"},
-		{"0884xxxxxxxx", "Smatfren"},
-		{"0885xxxxxxxx", "Smatfren"},
-		{"0886xxxxxxxx", "Smatfren"},
-		{"0887xxxxxxxx", "Smatfren"},
-		{"0888xxxxxxxx", "Smatfren"},
-		{"0889xxxxxxxx", "Smatfren"},
-		{"", ""},    // Empty phone case
-		{"123", ""}, // Invalid phone case
-		{"023473ß", ""},
+		{"081234567890", "081234567890"},
+		{"+6281234567890", "081234567890"},
+		{"6281234567890", "081234567890"},
+		{"+62 812-3456-7890", "081234567890"},
+		{"(0895) 1234 5678", "089512345678"},
+		{"0812345", ""},
+		{"02112345678", ""},
+		{"0812345678901234", ""},
+		{"", ""},
 	}
 
-	// Iterate over test cases
 	for _, tc := range testCases {
-		result := getProviderByPhone(tc.phone)
-		if result != tc.expected {
-			t.Errorf("Phone: %s, Expected: %s, Got: %s", tc.phone, tc.expected, result)
+		result, err := NormalizePhoneNumber(tc.phone)
+		if tc.expected == "" {
+			assert.Error(t, err, tc.phone)
+		} else {
+			assert.NoError(t, err, tc.phone)
 		}
+		assert.Equal(t, tc.expected, result, tc.phone)
 	}
+
+	assert.Equal(t, "+6281234567890", E164("081234567890"))
+}
+
+func TestDetectProvider(t *testing.T) {
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "088112345678").Return(&model.PhonePrefix{Prefix: "0881", Provider: "Smartfren"}, nil)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081012345678").Return(nil, nil)
+
+	phone, err := detectProvider(mockPhonePrefixRepo, "+62 881 1234 5678")
+	assert.NoError(t, err)
+	assert.Equal(t, &model.PhoneProvider{PhoneNumber: "088112345678", E164: "+6288112345678", Provider: "Smartfren"}, phone)
+
+	_, err = detectProvider(mockPhonePrefixRepo, "6281012345678")
+	assert.EqualError(t, err, "provider of 081012345678 is not supported")
+
+	_, err = detectProvider(mockPhonePrefixRepo, "123")
+	assert.EqualError(t, err, "123 is not a valid mobile number")
+}
+
+func TestGetAllPulsaPaketDataProviderMismatch(t *testing.T) {
+	isUser := true
+
+	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081723456789").Return(&model.PhonePrefix{Prefix: "0817", Provider: "XL"}, nil)
+
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mocks.NewUserRepository(t), mocks.NewTransactionRepository(t), mocks.NewDiscountRepository(t), availabilityRepository(t), nil)
+
+	_, err := service.GetAllPulsaPaketData(dto.PulsaDto{Provider: "Telkomsel", PhoneNumber: "081723456789"}, &isUser)
+
+	assert.EqualError(t, err, "081723456789 is not a Telkomsel number")
 }
 
 func TestGetPulsaPaketDataByIdSuccess(t *testing.T) {
@@ -241,13 +267,15 @@ func TestGetPulsaPaketDataByIdSuccess(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(mockPPD, nil)
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	result, err := service.GetPulsaPaketDataById(mockID)
 
@@ -271,13 +299,15 @@ func TestGetPulsaPaketDataByIdError(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(mockPPD, errors.New("not found"))
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	_, err := service.GetPulsaPaketDataById(mockID)
 
@@ -297,6 +327,8 @@ func TestUpdatePulsaByIdSuccess(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
@@ -304,7 +336,7 @@ func TestUpdatePulsaByIdSuccess(t *testing.T) {
 	mockPPDRepository.On("UpdatePulsaById", mockID, mockPPD).Return(nil)
 	mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(mockPPD, nil)
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	result, err := service.UpdatePulsaById(mockID, mockPPD)
 	if err != nil {
@@ -338,12 +370,14 @@ func TestUpdatePulsaByIdError(t *testing.T) {
 	for _, v := range testCase {
 		if v.newCase == "UpdatePulsaById" {
 			mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+			mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+			mockProviderRepo := mocks.NewPPDProviderRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockTransactionRepo := mocks.NewTransactionRepository(t)
 			mockDiscountRepo := mocks.NewDiscountRepository(t)
 			mockPPDRepository.On("UpdatePulsaById", mockID, mockPPD).Return(errors.New("not found"))
 
-			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 			_, err := service.UpdatePulsaById(mockID, mockPPD)
 
@@ -353,6 +387,8 @@ func TestUpdatePulsaByIdError(t *testing.T) {
 			}
 		} else if v.newCase == "GetPulsaPaketDataById" {
 			mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+			mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+			mockProviderRepo := mocks.NewPPDProviderRepository(t)
 			mockUserRepo := mocks.NewUserRepository(t)
 			mockTransactionRepo := mocks.NewTransactionRepository(t)
 			mockDiscountRepo := mocks.NewDiscountRepository(t)
@@ -360,7 +396,7 @@ func TestUpdatePulsaByIdError(t *testing.T) {
 			mockPPDRepository.On("UpdatePulsaById", mockID, mockPPD).Return(nil)
 			mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(mockPPD, errors.New("failed"))
 
-			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 			_, err := service.UpdatePulsaById(mockID, mockPPD)
 
 			if err != nil {
@@ -375,13 +411,15 @@ func TestUpdatePulsaByIdError(t *testing.T) {
 func TestDeletePulsaByIdSuccess(t *testing.T) {
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("DeletePulsaById", mockID).Return(nil)
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	err := service.DeletePulsaById(mockID)
 
@@ -394,13 +432,15 @@ func TestDeletePulsaByIdSuccess(t *testing.T) {
 func TestDeletePulsaByIdError(t *testing.T) {
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("DeletePulsaById", mockID).Return(errors.New("not found"))
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	err := service.DeletePulsaById(mockID)
 
@@ -417,13 +457,15 @@ func TestCreateTransactionPPDError(t *testing.T) {
 	}
 
 	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
 	mockUserRepo := mocks.NewUserRepository(t)
 	mockTransactionRepo := mocks.NewTransactionRepository(t)
 	mockDiscountRepo := mocks.NewDiscountRepository(t)
 
 	mockPPDRepository.On("GetPulsaPaketDataById", payload.ProductID).Return(model.PulsaPaketData{}, errors.New("not found"))
 
-	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 	_, err := service.CreateTransactionPPD(userID, payload)
 
@@ -432,6 +474,27 @@ func TestCreateTransactionPPDError(t *testing.T) {
 	}
 }
 
+func TestCreateTransactionPPDProviderMismatch(t *testing.T) {
+	userID := uuid.New().String()
+	payload := dto.TransactionPPDDto{
+		ProductID:   mockID,
+		PhoneNumber: "+6281723456789",
+	}
+
+	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
+
+	mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(model.PulsaPaketData{Provider: "Telkomsel"}, nil)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081723456789").Return(&model.PhonePrefix{Prefix: "0817", Provider: "XL"}, nil)
+
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mocks.NewUserRepository(t), mocks.NewTransactionRepository(t), mocks.NewDiscountRepository(t), availabilityRepository(t), nil)
+
+	_, err := service.CreateTransactionPPD(userID, payload)
+
+	assert.EqualError(t, err, "081723456789 is not a Telkomsel number")
+}
+
 // func TestCreateTransactionPPDSuccess(t *testing.T) {
 // 	userID := uuid.New().String()
 // 	ppdID := uuid.New().String()
@@ -489,7 +552,7 @@ func TestCreateTransactionPPDError(t *testing.T) {
 // 	mockUser.Amount -= mockTransaction.TotalPrice
 // 	mockUserRepo.On("UpdateUserAmountByIDRepository", mockUser.ID, mockUser).Return(mockUser, nil)
 
-// 	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+// 	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 // 	result, err := service.CreateTransactionPPD(userID, mockPayload)
 // 	if err != nil {
@@ -525,7 +588,7 @@ func TestCreateTransactionPPDError(t *testing.T) {
 // 			mockDiscountRepo := mocks.NewDiscountRepository(t)
 // 			mockPPDRepository.On("UpdatePulsaById", mockID, mockPPD).Return(errors.New("not found"))
 
-// 			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+// 			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 
 // 			_, err := service.UpdatePulsaById(mockID, mockPPD)
 
@@ -542,7 +605,7 @@ func TestCreateTransactionPPDError(t *testing.T) {
 // 			mockPPDRepository.On("UpdatePulsaById", mockID, mockPPD).Return(nil)
 // 			mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(mockPPD, errors.New("failed"))
 
-// 			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockUserRepo, mockTransactionRepo, mockDiscountRepo)
+// 			service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
 // 			_, err := service.UpdatePulsaById(mockID, mockPPD)
 
 // 			if err != nil {
@@ -553,3 +616,171 @@ func TestCreateTransactionPPDError(t *testing.T) {
 // 	}
 
 // }
+
+func TestCreateTransactionPPDProcessing(t *testing.T) {
+	userID := uuid.New().String()
+	payload := dto.TransactionPPDDto{
+		ProductID:   mockID,
+		PhoneNumber: "081234567890",
+	}
+
+	mockPPDRepository := mocks.NewPulsaPaketDataRepository(t)
+	mockPhonePrefixRepo := mocks.NewPhonePrefixRepository(t)
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockTransactionRepo := mocks.NewTransactionRepository(t)
+	mockDiscountRepo := mocks.NewDiscountRepository(t)
+
+	mockPPDRepository.On("GetPulsaPaketDataById", mockID).Return(model.PulsaPaketData{Name: "Pulsa 10000", Type: model.PULSA_TYPE, Provider: "Telkomsel", Price: 11000}, nil)
+	mockPhonePrefixRepo.On("GetPhonePrefixByPhoneRepository", "081234567890").Return(&model.PhonePrefix{Prefix: "0812", Provider: "Telkomsel"}, nil)
+	mockDiscountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+	mockTransactionRepo.On("CreatePaidTransactionRepository", mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.UserID == userID && transaction.TotalPrice == 11000+model.ADMIN_FEE
+	})).Return(func(transaction *model.Transaction) *model.Transaction {
+		return transaction
+	}, nil)
+
+	service := NewPulsaPaketDataUsecase(mockPPDRepository, mockPhonePrefixRepo, mockProviderRepo, mockUserRepo, mockTransactionRepo, mockDiscountRepo, availabilityRepository(t), nil)
+	service.sendMail = func(payload model.PayloadMail) { t.Errorf("unexpected receipt for %s", payload.OrderId) }
+
+	result, err := service.CreateTransactionPPD(userID, payload)
+
+	assert.NoError(t, err)
+	assert.Equal(t, model.STATUS_PROCESSING, result.Status)
+	assert.Contains(t, result.ID, "PPD-")
+}
+
+func processingPPD(createdAt time.Time) *model.Transaction {
+	transaction := &model.Transaction{
+		ID:          "PPD-1",
+		UserID:      "user",
+		Status:      model.STATUS_PROCESSING,
+		ProductType: model.PULSA_TYPE,
+		TotalPrice:  13500,
+		ProductDetail: model.TransactionPPD{
+			Phone:    "081234567890",
+			Name:     "Pulsa 10000",
+			Provider: "Telkomsel",
+		},
+	}
+	transaction.CreatedAt = createdAt
+
+	return transaction
+}
+
+// submittedPPD is a processing purchase that has already been sent to the
+// provider.
+func submittedPPD(createdAt time.Time) *model.Transaction {
+	transaction := processingPPD(createdAt)
+	detail := transaction.ProductDetail.(model.TransactionPPD)
+	detail.SubmittedAt = &createdAt
+	transaction.ProductDetail = detail
+
+	return transaction
+}
+
+// newFulfilmentService returns a service whose only pending purchase is
+// answered with result. A submitted purchase is polled, any other is sent to
+// the provider.
+func newFulfilmentService(t *testing.T, result *model.PPDPurchaseResult, createdAt time.Time, submitted bool) (*pulsaPaketDataUsecase, *mocks.UserRepository, *mocks.TransactionRepository, *usecaseMocks.NotificationUseCase) {
+	mockProviderRepo := mocks.NewPPDProviderRepository(t)
+	mockUserRepo := mocks.NewUserRepository(t)
+	mockTransactionRepo := mocks.NewTransactionRepository(t)
+	mockNotificationUseCase := usecaseMocks.NewNotificationUseCase(t)
+
+	if submitted {
+		mockTransactionRepo.On("GetProcessingTransactionsByPrefixRepository", "PPD", fulfilment.BatchSize).Return([]*model.Transaction{submittedPPD(createdAt)}, nil)
+		mockProviderRepo.On("PurchaseStatusPPDRepository", "PPD-1", mock.Anything).Return(result, nil)
+	} else {
+		mockTransactionRepo.On("GetProcessingTransactionsByPrefixRepository", "PPD", fulfilment.BatchSize).Return([]*model.Transaction{processingPPD(createdAt)}, nil)
+		mockTransactionRepo.On("SubmitProcessingTransactionRepository", "PPD-1", mock.MatchedBy(func(update *model.Transaction) bool {
+			return update.Status == model.STATUS_PROCESSING && update.ProductDetail.(*model.TransactionPPD).SubmittedAt != nil
+		})).Return(true, nil).Once()
+		mockProviderRepo.On("PurchasePPDRepository", "PPD-1", mock.Anything).Return(result, nil)
+	}
+
+	service := NewPulsaPaketDataUsecase(mocks.NewPulsaPaketDataRepository(t), mocks.NewPhonePrefixRepository(t), mockProviderRepo, mockUserRepo, mockTransactionRepo, mocks.NewDiscountRepository(t), availabilityRepository(t), mockNotificationUseCase)
+
+	return service, mockUserRepo, mockTransactionRepo, mockNotificationUseCase
+}
+
+func TestRunPendingTransactionPPDSuccess(t *testing.T) {
+	now := time.Now()
+	service, mockUserRepo, mockTransactionRepo, mockNotificationUseCase := newFulfilmentService(t, &model.PPDPurchaseResult{Status: model.STATUS_SUCCESSFUL, SerialNumber: "SN123"}, now, false)
+
+	var sent []model.PayloadMail
+	service.sendMail = func(payload model.PayloadMail) { sent = append(sent, payload) }
+
+	mockTransactionRepo.On("UpdateProcessingTransactionRepository", "PPD-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		detail := update.ProductDetail.(*model.TransactionPPD)
+		return update.Status == model.STATUS_SUCCESSFUL && detail.SerialNumber == "SN123" && detail.Attempts == 0
+	})).Return(nil).Once()
+	mockNotificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Pembelian Berhasil"
+	})).Return(nil)
+	mockUserRepo.On("GetUserByIDRepository", "user").Return(&model.User{Name: "Budi", Email: "budi@mail.com"}, nil)
+
+	err := service.RunPendingTransactionPPD(now)
+
+	assert.NoError(t, err)
+	assert.Len(t, sent, 1)
+	assert.Equal(t, "SN123", sent[0].SerialNumber)
+	assert.Equal(t, "081234567890", sent[0].Phone)
+}
+
+func TestRunPendingTransactionPPDRejectedRefunds(t *testing.T) {
+	now := time.Now()
+	service, _, mockTransactionRepo, mockNotificationUseCase := newFulfilmentService(t, &model.PPDPurchaseResult{Status: model.STATUS_FAIL, Message: "rejected"}, now, true)
+	service.sendMail = func(payload model.PayloadMail) { t.Errorf("unexpected receipt for %s", payload.OrderId) }
+
+	mockTransactionRepo.On("RefundProcessingTransactionRepository", mock.MatchedBy(func(transaction *model.Transaction) bool {
+		return transaction.ID == "PPD-1" && transaction.UserID == "user" && transaction.TotalPrice == 13500
+	}), mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.Status == model.STATUS_FAIL && update.ProductDetail.(*model.TransactionPPD).Error == "rejected"
+	})).Return(nil)
+	mockNotificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Pembelian Gagal"
+	})).Return(nil)
+
+	err := service.RunPendingTransactionPPD(now)
+
+	assert.NoError(t, err)
+}
+
+func TestRunPendingTransactionPPDStillPending(t *testing.T) {
+	now := time.Now()
+	service, _, mockTransactionRepo, _ := newFulfilmentService(t, &model.PPDPurchaseResult{Status: model.STATUS_PROCESSING}, now, true)
+
+	mockTransactionRepo.On("UpdateProcessingTransactionRepository", "PPD-1", mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.Status == model.STATUS_PROCESSING && update.ProductDetail.(*model.TransactionPPD).Attempts == 1
+	})).Return(nil)
+
+	err := service.RunPendingTransactionPPD(now)
+
+	assert.NoError(t, err)
+}
+
+func TestRunPendingTransactionPPDTimesOut(t *testing.T) {
+	now := time.Now()
+	service, _, mockTransactionRepo, mockNotificationUseCase := newFulfilmentService(t, &model.PPDPurchaseResult{Status: model.STATUS_PROCESSING}, now.Add(-time.Hour), true)
+
+	mockTransactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(update *model.Transaction) bool {
+		return update.Status == model.STATUS_FAIL && update.ProductDetail.(*model.TransactionPPD).Error == "purchase timed out at the provider"
+	})).Return(nil)
+	mockNotificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	err := service.RunPendingTransactionPPD(now)
+
+	assert.NoError(t, err)
+}
+
+func TestRunPendingTransactionPPDNoRefundWhenAlreadyFinished(t *testing.T) {
+	now := time.Now()
+	service, _, mockTransactionRepo, _ := newFulfilmentService(t, &model.PPDPurchaseResult{Status: model.STATUS_FAIL}, now, true)
+
+	mockTransactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.Anything).Return(errors.New("transaction is no longer processing"))
+
+	err := service.RunPendingTransactionPPD(now)
+
+	assert.Error(t, err)
+}
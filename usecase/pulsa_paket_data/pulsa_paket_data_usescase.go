@@ -4,13 +4,26 @@ import (
 	"BE-Golang/dto"
 	"BE-Golang/model"
 	"BE-Golang/repository"
+	"BE-Golang/usecase/availability"
+	"BE-Golang/usecase/fulfilment"
 	"BE-Golang/usecase/mail"
+	"BE-Golang/usecase/notification"
 	"errors"
 	"fmt"
+	"log"
+	"strings"
+	"time"
 
 	"github.com/google/uuid"
 )
 
+// purchaseTimeout is how long a purchase may stay pending at the provider
+// before it is failed and refunded.
+const purchaseTimeout = 30 * time.Minute
+
+// transactionPrefix starts the ID of every pulsa and data purchase.
+const transactionPrefix = "PPD"
+
 type PulsaPaketDataUsecase interface {
 	CreatePulsaPaketData(data model.PulsaPaketData) (model.PPDResponse, error)
 	GetAllPulsaPaketData(data dto.PulsaDto, isUser *bool) ([]model.PPDResponse, error)
@@ -18,22 +31,58 @@ type PulsaPaketDataUsecase interface {
 	UpdatePulsaById(id string, data model.PulsaPaketData) (model.PPDResponse, error)
 	DeletePulsaById(id string) error
 	CreateTransactionPPD(userID string, payload dto.TransactionPPDDto) (*model.Transaction, error)
+	GetTransactionPPD(userID, transactionID string) (*model.Transaction, error)
+	RunPendingTransactionPPD(now time.Time) error
 }
 
 type pulsaPaketDataUsecase struct {
 	ppdRepository         repository.PulsaPaketDataRepository
+	phonePrefixRepository repository.PhonePrefixRepository
+	providerRepository    repository.PPDProviderRepository
 	userRepository        repository.UserRepository
 	transactionRepository repository.TransactionRepository
 	discountRepository    repository.DiscountRepository
+	availability          repository.ProductAvailabilityRepository
+	purchases             *fulfilment.Purchase
+	sendMail              func(payload model.PayloadMail)
+	now                   func() time.Time
 }
 
-func NewPulsaPaketDataUsecase(ppdRepository repository.PulsaPaketDataRepository, userRepository repository.UserRepository, transactionRepository repository.TransactionRepository, discountRepository repository.DiscountRepository) *pulsaPaketDataUsecase {
-	return &pulsaPaketDataUsecase{
+func NewPulsaPaketDataUsecase(ppdRepository repository.PulsaPaketDataRepository, phonePrefixRepository repository.PhonePrefixRepository, providerRepository repository.PPDProviderRepository, userRepository repository.UserRepository, transactionRepository repository.TransactionRepository, discountRepository repository.DiscountRepository, availabilityRepository repository.ProductAvailabilityRepository, notificationUseCase notification.NotificationUseCase) *pulsaPaketDataUsecase {
+	uc := &pulsaPaketDataUsecase{
 		ppdRepository:         ppdRepository,
+		phonePrefixRepository: phonePrefixRepository,
+		providerRepository:    providerRepository,
 		userRepository:        userRepository,
 		transactionRepository: transactionRepository,
 		discountRepository:    discountRepository,
+		availability:          availabilityRepository,
+		sendMail:              mail.SendingMail,
+		now:                   time.Now,
+	}
+	uc.purchases = &fulfilment.Purchase{
+		Name:          "ppd purchase",
+		Prefix:        transactionPrefix,
+		Timeout:       purchaseTimeout,
+		Transactions:  transactionRepository,
+		Notifications: notificationUseCase,
+		NewDetail:     func() fulfilment.Detail { return &model.TransactionPPD{} },
+		Send: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+			return purchaseResult(providerRepository.PurchasePPDRepository(transactionID, detail.(*model.TransactionPPD)))
+		},
+		Poll: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+			return purchaseResult(providerRepository.PurchaseStatusPPDRepository(transactionID, detail.(*model.TransactionPPD)))
+		},
+		Notification:        purchaseNotification,
+		Receipt:             uc.sendReceipt,
+		Availability:        availabilityRepository,
+		AvailabilityProduct: model.AVAILABILITY_PPD,
+		Provider: func(detail fulfilment.Detail) string {
+			return detail.(*model.TransactionPPD).Provider
+		},
 	}
+
+	return uc
 }
 
 func (u *pulsaPaketDataUsecase) CreatePulsaPaketData(data model.PulsaPaketData) (model.PPDResponse, error) {
@@ -58,16 +107,23 @@ func (u *pulsaPaketDataUsecase) CreatePulsaPaketData(data model.PulsaPaketData)
 }
 
 func (u *pulsaPaketDataUsecase) GetAllPulsaPaketData(data dto.PulsaDto, isUser *bool) ([]model.PPDResponse, error) {
-	var provider string
 	if isUser != nil {
-		provider = getProviderByPhone(data.PhoneNumber)
-		if provider == "" && data.Provider == "" {
+		if data.PhoneNumber != "" {
+			phone, err := detectProvider(u.phonePrefixRepository, data.PhoneNumber)
+			if err != nil {
+				return []model.PPDResponse{}, err
+			}
+			if data.Provider != "" && !strings.EqualFold(data.Provider, phone.Provider) {
+				return []model.PPDResponse{}, fmt.Errorf("%s is not a %s number", phone.PhoneNumber, data.Provider)
+			}
+			data.Provider = phone.Provider
+		}
+		if data.Provider == "" {
 			return []model.PPDResponse{}, errors.New("provider is not supported")
 		}
-	}
-
-	if provider != "" {
-		data.Provider = provider
+		if err := availability.Check(u.availability, model.AVAILABILITY_PPD, data.Provider, data.Provider, u.now()); err != nil {
+			return []model.PPDResponse{}, err
+		}
 	}
 
 	ppd, err := u.ppdRepository.GetAllPulsaPaketData(data, isUser)
@@ -140,10 +196,21 @@ func (uc *pulsaPaketDataUsecase) CreateTransactionPPD(userID string, payload dto
 		return &model.Transaction{}, fmt.Errorf("error ppd id not found")
 	}
 
+	phone, err := detectProvider(uc.phonePrefixRepository, payload.PhoneNumber)
+	if err != nil {
+		return &model.Transaction{}, err
+	}
+	if !strings.EqualFold(phone.Provider, ppd.Provider) {
+		return &model.Transaction{}, fmt.Errorf("%s is not a %s number", phone.PhoneNumber, ppd.Provider)
+	}
+	if err := availability.Check(uc.availability, model.AVAILABILITY_PPD, ppd.Provider, ppd.Name, uc.now()); err != nil {
+		return &model.Transaction{}, err
+	}
+
 	discount, _ := uc.discountRepository.GetDiscountByIdRepository(payload.DiscountID)
 
 	td := model.TransactionPPD{
-		Phone:       payload.PhoneNumber,
+		Phone:       phone.PhoneNumber,
 		Name:        ppd.Name,
 		Code:        ppd.Code,
 		Provider:    ppd.Provider,
@@ -152,9 +219,9 @@ func (uc *pulsaPaketDataUsecase) CreateTransactionPPD(userID string, payload dto
 	}
 
 	transaction := &model.Transaction{
-		ID:            uuid.New().String(),
+		ID:            fmt.Sprintf("%s-%s", transactionPrefix, uuid.New().String()),
 		UserID:        userID,
-		Status:        model.STATUS_SUCCESSFUL,
+		Status:        model.STATUS_PROCESSING,
 		ProductType:   ppd.Type,
 		Description:   fmt.Sprintf("Pembelian Pulsa Paket Data  %s ", ppd.Type),
 		AdminFee:      model.ADMIN_FEE,
@@ -164,132 +231,85 @@ func (uc *pulsaPaketDataUsecase) CreateTransactionPPD(userID string, payload dto
 		DiscountPrice: discount.DiscountPrice,
 	}
 
-	user, err := uc.userRepository.GetUserByIDRepository(userID)
-
-	if err != nil {
-		return &model.Transaction{}, fmt.Errorf("error user id not found")
+	if err := uc.purchases.Create(transaction); err != nil {
+		return &model.Transaction{}, err
 	}
 
-	if user.Amount < transaction.TotalPrice {
-		return &model.Transaction{}, errors.New("your balance is not enough")
+	return transaction, nil
+}
+
+func (uc *pulsaPaketDataUsecase) GetTransactionPPD(userID, transactionID string) (*model.Transaction, error) {
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != userID || !strings.HasPrefix(transaction.ID, transactionPrefix+"-") {
+		return nil, fmt.Errorf("pulsa or paket data transaction with ID %s not found", transactionID)
 	}
 
-	ts, err := uc.transactionRepository.CreateTransactionByUserIdRepository(transaction)
+	return transaction, nil
+}
+
+// RunPendingTransactionPPD sends processing pulsa and data purchases to the
+// provider once and then follows their status. Purchases the provider
+// rejects, or reports pending past purchaseTimeout, are failed and the user's
+// balance is refunded.
+func (uc *pulsaPaketDataUsecase) RunPendingTransactionPPD(now time.Time) error {
+	return uc.purchases.RunPending(now)
+}
 
+func purchaseResult(result *model.PPDPurchaseResult, err error) (*fulfilment.Result, error) {
 	if err != nil {
-		return &model.Transaction{}, fmt.Errorf("failed transaction %s", err)
+		return nil, err
 	}
 
-	user.Amount -= transaction.TotalPrice
-	_, err = uc.userRepository.UpdateUserAmountByIDRepository(userID, user)
+	return &fulfilment.Result{
+		Status:            result.Status,
+		ProviderReference: result.ProviderReference,
+		Message:           result.Message,
+		Deliver: func(detail fulfilment.Detail) error {
+			detail.(*model.TransactionPPD).SerialNumber = result.SerialNumber
+			return nil
+		},
+	}, nil
+}
+
+func purchaseNotification(transaction *model.Transaction, status string, detail fulfilment.Detail) *model.Notification {
+	purchase := detail.(*model.TransactionPPD)
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_PPD,
+		Title:    "Pembelian Berhasil",
+		Message:  fmt.Sprintf("Pembelian %s ke %s berhasil. SN %s.", purchase.Name, purchase.Phone, purchase.SerialNumber),
+	}
+	if status == model.STATUS_FAIL {
+		notification.Title = "Pembelian Gagal"
+		notification.Message = fmt.Sprintf("Pembelian %s ke %s gagal. Rp%.0f telah dikembalikan ke saldo Anda.", purchase.Name, purchase.Phone, transaction.TotalPrice)
+	}
+
+	return notification
+}
 
+// sendReceipt mails the receipt of a successful purchase with the provider's
+// serial number.
+func (uc *pulsaPaketDataUsecase) sendReceipt(transaction *model.Transaction, detail fulfilment.Detail) {
+	purchase := detail.(*model.TransactionPPD)
+	user, err := uc.userRepository.GetUserByIDRepository(transaction.UserID)
 	if err != nil {
-		return &model.Transaction{}, fmt.Errorf("error update amount")
+		log.Printf("ppd purchase %s: failed to send receipt: %v", transaction.ID, err)
+		return
 	}
 
-	mailsend := model.PayloadMail{
+	uc.sendMail(model.PayloadMail{
 		OrderId:       transaction.ID,
 		CustomerName:  user.Name,
-		Status:        ts.Status,
+		Status:        model.STATUS_SUCCESSFUL,
 		ProductType:   "PPD",
+		ProviderName:  purchase.Provider,
 		RecipentEmail: user.Email,
-		Phone:         td.Phone,
-		TransactionAt: ts.UpdatedAt,
-		AdminFee:      0,
+		Phone:         purchase.Phone,
+		SerialNumber:  purchase.SerialNumber,
+		TransactionAt: time.Now(),
+		AdminFee:      transaction.AdminFee,
 		Description:   transaction.Description,
-		Price:         transaction.TotalPrice,
+		DiscountPrice: transaction.DiscountPrice,
+		Price:         transaction.Price,
 		TotalPrice:    transaction.TotalPrice,
-	}
-
-	mail.SendingMail(mailsend)
-
-	return ts, nil
-}
-
-func getProviderByPhone(phone string) string {
-	if len(phone) < 4 {
-		return ""
-	}
-	codeProvider := phone[0:4]
-	switch codeProvider {
-	case "0852":
-		return "AS"
-	case "0853":
-		return "AS"
-	case "0823":
-		return "AS"
-	case "0851":
-		return "AS"
-	case "0811":
-		return "Halo"
-	case "0812":
-		return "Telkomsel"
-	case "0813":
-		return "Telkomsel"
-	case "0821":
-		return "Telkomsel"
-	case "0822":
-		return "Telkomsel"
-	case "0814":
-		return "Indosat"
-	case "0815":
-		return "Indosat"
-	case "0816":
-		return "Indosat"
-	case "0855":
-		return "Indosat"
-	case "0856":
-		return "Indosat"
-	case "0857":
-		return "Indosat"
-	case "0858":
-		return "Indosat"
-	case "0817":
-		return "XL"
-	case "0818":
-		return "XL"
-	case "0859":
-		return "XL"
-	case "0877":
-		return "XL"
-	case "0878":
-		return "XL"
-	case "0838":
-		return "Axis"
-	case "0831":
-		return "Axis"
-	case "0832":
-		return "Axis"
-	case "0833":
-		return "Axis"
-	case "0895":
-		return "Three"
-	case "0896":
-		return "Three"
-	case "0897":
-		return "Three"
-	case "0898":
-		return "Three"
-	case "0899":
-		return "Three"
-	case "0881":
-		return "Smatfren"
-	case "0882":
-		return "Smatfren"
-	case "0883":
-		return "Smatfren"
-	case "0884":
-		return "Smatfren"
-	case "0885":
-		return "Smatfren"
-	case "0886":
-		return "Smatfren"
-	case "0887":
-		return "Smatfren"
-	case "0888":
-		return "Smatfren"
-	case "0889":
-		return "Smatfren"
-	}
-	return ""
+	})
 }
@@ -0,0 +1,148 @@
+package reminder
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/notification"
+	"fmt"
+	"log"
+	"time"
+)
+
+// Providers do not return a due date on inquiry, so reminders use the day most
+// billers in each category close their billing period.
+var typicalDueDays = map[string]int{
+	model.PRODUCT_PDAM:            20,
+	model.PRODUCT_WIFI:            20,
+	model.PRODUCT_INSURANCE:       10,
+	model.PRODUCT_ELECTRICITY:     20,
+	model.PRODUCT_POSTPAID_MOBILE: 15,
+	model.PRODUCT_CABLE_TV:        20,
+}
+
+type BillReminderUseCase interface {
+	RunBillRemindersUseCase(now time.Time) error
+}
+
+type billReminderUseCase struct {
+	savedBillerRepository  repository.SavedBillerRepository
+	transactionRepository  repository.TransactionRepository
+	billReminderRepository repository.BillReminderRepository
+	notificationUseCase    notification.NotificationUseCase
+}
+
+func NewBillReminderUseCase(savedBillerRepository repository.SavedBillerRepository, transactionRepository repository.TransactionRepository, billReminderRepository repository.BillReminderRepository, notificationUseCase notification.NotificationUseCase) *billReminderUseCase {
+	return &billReminderUseCase{
+		savedBillerRepository:  savedBillerRepository,
+		transactionRepository:  transactionRepository,
+		billReminderRepository: billReminderRepository,
+		notificationUseCase:    notificationUseCase,
+	}
+}
+
+func (uc *billReminderUseCase) RunBillRemindersUseCase(now time.Time) error {
+	billers, err := uc.savedBillerRepository.GetAllSavedBillersRepository()
+	if err != nil {
+		return err
+	}
+
+	period := biller.CurrentPeriod(now)
+	preferences := map[string]*model.NotificationPreference{}
+
+	failed := 0
+	for _, biller := range billers {
+		dueDay, ok := typicalDueDays[biller.Category]
+		if !ok || biller.ProductId == "plnpre" || biller.LastPaidPeriod == period {
+			continue
+		}
+
+		preference, ok := preferences[biller.UserID]
+		if !ok {
+			preference, err = uc.notificationUseCase.GetNotificationPreferenceUseCase(biller.UserID)
+			if err != nil {
+				log.Printf("bill reminder %s: %v", biller.ID, err)
+				failed++
+				continue
+			}
+			preferences[biller.UserID] = preference
+		}
+
+		// Skipped reminders are not recorded, so they go out on the first run after quiet hours.
+		if notification.IsQuietHours(preference, now) {
+			continue
+		}
+
+		if err := uc.remindBiller(biller, preference, period, dueDay, now); err != nil {
+			log.Printf("bill reminder %s: %v", biller.ID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to process %d of %d bill reminders", failed, len(billers))
+	}
+
+	return nil
+}
+
+func (uc *billReminderUseCase) remindBiller(biller *model.SavedBiller, preference *model.NotificationPreference, period string, dueDay int, now time.Time) error {
+	dueDate := time.Date(now.Year(), now.Month(), dueDay, 0, 0, 0, 0, now.Location())
+
+	var kind string
+	switch {
+	case !now.Before(dueDate.AddDate(0, 0, 1)):
+		kind = model.REMINDER_OVERDUE
+	case !now.Before(dueDate.AddDate(0, 0, -preference.ReminderDaysBefore)):
+		kind = model.REMINDER_BEFORE_DUE
+	default:
+		return nil
+	}
+
+	sent, err := uc.billReminderRepository.IsBillReminderSentRepository(biller.ID, period, kind)
+	if err != nil {
+		return err
+	}
+	if sent {
+		return nil
+	}
+
+	existing, err := uc.transactionRepository.GetProductDetailsByPeriodAndCustomerID(model.GetProductDetail{
+		ProductId:  biller.ProductId,
+		Period:     period,
+		CustomerId: biller.CustomerId,
+	})
+	if err == nil && existing.Status == model.STATUS_SUCCESSFUL {
+		return nil
+	}
+
+	name := biller.Nickname
+	if name == "" {
+		name = biller.CustomerId
+	}
+
+	payload := &model.Notification{
+		Category: model.NOTIFICATION_BILL_REMINDER,
+		Title:    "Tagihan Segera Jatuh Tempo",
+		Message: fmt.Sprintf("Tagihan %s (%s) periode %s jatuh tempo pada %s. Bayar sekarang agar tidak terkena denda.",
+			biller.ProductId, name, period, dueDate.Format("02-01-2006")),
+	}
+	if kind == model.REMINDER_OVERDUE {
+		payload.Title = "Tagihan Belum Dibayar"
+		payload.Message = fmt.Sprintf("Tagihan %s (%s) periode %s belum dibayar dan sudah melewati tanggal jatuh tempo %s.",
+			biller.ProductId, name, period, dueDate.Format("02-01-2006"))
+	}
+
+	if err := uc.notificationUseCase.SendNotificationUseCase(biller.UserID, payload); err != nil {
+		return err
+	}
+
+	_, err = uc.billReminderRepository.CreateBillReminderRepository(&model.BillReminder{
+		UserID:        biller.UserID,
+		SavedBillerID: biller.ID,
+		Period:        period,
+		Kind:          kind,
+	})
+
+	return err
+}
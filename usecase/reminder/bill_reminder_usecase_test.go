@@ -0,0 +1,115 @@
+package reminder
+
+import (
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type BillReminderUseCaseTest struct {
+	suite.Suite
+	billReminderUseCase BillReminderUseCase
+	savedBillerRepo     *repoMocks.SavedBillerRepository
+	transactionRepo     *repoMocks.TransactionRepository
+	billReminderRepo    *repoMocks.BillReminderRepository
+	notificationUseCase *mocks.NotificationUseCase
+}
+
+func TestBillReminderUseCase(t *testing.T) {
+	suite.Run(t, new(BillReminderUseCaseTest))
+}
+
+func (m *BillReminderUseCaseTest) SetupTest() {
+	m.savedBillerRepo = &repoMocks.SavedBillerRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.billReminderRepo = &repoMocks.BillReminderRepository{}
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.billReminderUseCase = NewBillReminderUseCase(m.savedBillerRepo, m.transactionRepo, m.billReminderRepo, m.notificationUseCase)
+}
+
+func (m *BillReminderUseCaseTest) TestRunBillRemindersBeforeDue() {
+	now := time.Date(2026, time.March, 18, 9, 0, 0, 0, time.Local)
+	biller := &model.SavedBiller{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "biller"}, UserID: "user", Category: model.PRODUCT_PDAM, ProductId: "pdam", CustomerId: "123456", Nickname: "Rumah"}
+
+	m.savedBillerRepo.On("GetAllSavedBillersRepository").Return([]*model.SavedBiller{biller}, nil)
+	m.notificationUseCase.On("GetNotificationPreferenceUseCase", "user").Return(&model.NotificationPreference{ReminderDaysBefore: 3}, nil)
+	m.billReminderRepo.On("IsBillReminderSentRepository", "biller", "March-2026", model.REMINDER_BEFORE_DUE).Return(false, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(nil, errors.New("record not found"))
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Tagihan Segera Jatuh Tempo"
+	})).Return(nil)
+	m.billReminderRepo.On("CreateBillReminderRepository", mock.Anything).Return(&model.BillReminder{}, nil)
+
+	err := m.billReminderUseCase.RunBillRemindersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.billReminderRepo.AssertCalled(m.T(), "CreateBillReminderRepository", &model.BillReminder{UserID: "user", SavedBillerID: "biller", Period: "March-2026", Kind: model.REMINDER_BEFORE_DUE})
+}
+
+func (m *BillReminderUseCaseTest) TestRunBillRemindersOverdue() {
+	now := time.Date(2026, time.March, 12, 9, 0, 0, 0, time.Local)
+	biller := &model.SavedBiller{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "biller"}, UserID: "user", Category: model.PRODUCT_INSURANCE, ProductId: "bpjsks", CustomerId: "123456"}
+
+	m.savedBillerRepo.On("GetAllSavedBillersRepository").Return([]*model.SavedBiller{biller}, nil)
+	m.notificationUseCase.On("GetNotificationPreferenceUseCase", "user").Return(&model.NotificationPreference{ReminderDaysBefore: 3}, nil)
+	m.billReminderRepo.On("IsBillReminderSentRepository", "biller", "March-2026", model.REMINDER_OVERDUE).Return(false, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(&model.Transaction{Status: model.STATUS_UNPAID}, nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Tagihan Belum Dibayar"
+	})).Return(nil)
+	m.billReminderRepo.On("CreateBillReminderRepository", mock.Anything).Return(&model.BillReminder{}, nil)
+
+	err := m.billReminderUseCase.RunBillRemindersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.notificationUseCase.AssertNumberOfCalls(m.T(), "SendNotificationUseCase", 1)
+}
+
+func (m *BillReminderUseCaseTest) TestRunBillRemindersSkipsPaidAndEarly() {
+	now := time.Date(2026, time.March, 5, 9, 0, 0, 0, time.Local)
+	paid := &model.SavedBiller{UserID: "user", Category: model.PRODUCT_PDAM, ProductId: "pdam", LastPaidPeriod: "March-2026"}
+	early := &model.SavedBiller{UserID: "user", Category: model.PRODUCT_WIFI, ProductId: "indihome"}
+	token := &model.SavedBiller{UserID: "user", Category: model.PRODUCT_ELECTRICITY, ProductId: "plnpre"}
+
+	m.savedBillerRepo.On("GetAllSavedBillersRepository").Return([]*model.SavedBiller{paid, early, token}, nil)
+	m.notificationUseCase.On("GetNotificationPreferenceUseCase", "user").Return(&model.NotificationPreference{ReminderDaysBefore: 3}, nil)
+
+	err := m.billReminderUseCase.RunBillRemindersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func (m *BillReminderUseCaseTest) TestRunBillRemindersRespectsQuietHours() {
+	now := time.Date(2026, time.March, 25, 23, 0, 0, 0, time.Local)
+	biller := &model.SavedBiller{UserID: "user", Category: model.PRODUCT_PDAM, ProductId: "pdam"}
+
+	m.savedBillerRepo.On("GetAllSavedBillersRepository").Return([]*model.SavedBiller{biller}, nil)
+	m.notificationUseCase.On("GetNotificationPreferenceUseCase", "user").Return(&model.NotificationPreference{QuietHoursStart: "22:00", QuietHoursEnd: "06:00"}, nil)
+
+	err := m.billReminderUseCase.RunBillRemindersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.billReminderRepo.AssertNotCalled(m.T(), "IsBillReminderSentRepository", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *BillReminderUseCaseTest) TestRunBillRemindersAlreadySent() {
+	now := time.Date(2026, time.March, 25, 9, 0, 0, 0, time.Local)
+	biller := &model.SavedBiller{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "biller"}, UserID: "user", Category: model.PRODUCT_PDAM, ProductId: "pdam"}
+
+	m.savedBillerRepo.On("GetAllSavedBillersRepository").Return([]*model.SavedBiller{biller}, nil)
+	m.notificationUseCase.On("GetNotificationPreferenceUseCase", "user").Return(&model.NotificationPreference{}, nil)
+	m.billReminderRepo.On("IsBillReminderSentRepository", "biller", "March-2026", model.REMINDER_OVERDUE).Return(true, nil)
+
+	err := m.billReminderUseCase.RunBillRemindersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
@@ -0,0 +1,217 @@
+package savedbiller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+// dueBillWorkers caps the bill inquiries one due bills request runs at once.
+const dueBillWorkers = 4
+
+type SavedBillerUseCase interface {
+	CreateSavedBillerUseCase(userID string, payload dto.SavedBillerDto) (*model.SavedBiller, error)
+	GetSavedBillersByUserIdUseCase(userID, category string, page, limit int) ([]*model.SavedBiller, error)
+	GetSavedBillerByIdUseCase(userID, billerID string) (*model.SavedBiller, error)
+	UpdateSavedBillerUseCase(userID, billerID string, payload dto.SavedBillerDto) (*model.SavedBiller, error)
+	DeleteSavedBillerUseCase(userID, billerID string) error
+	GetDueBillsUseCase(userID string) ([]*model.DueBill, error)
+}
+
+type savedBillerUseCase struct {
+	savedBillerRepository repository.SavedBillerRepository
+	transactionRepository repository.TransactionRepository
+	billerUseCase         biller.BillerUseCase
+}
+
+func NewSavedBillerUseCase(savedBillerRepository repository.SavedBillerRepository, transactionRepository repository.TransactionRepository, billerUseCase biller.BillerUseCase) *savedBillerUseCase {
+	return &savedBillerUseCase{
+		savedBillerRepository: savedBillerRepository,
+		transactionRepository: transactionRepository,
+		billerUseCase:         billerUseCase,
+	}
+}
+
+func (uc *savedBillerUseCase) CreateSavedBillerUseCase(userID string, payload dto.SavedBillerDto) (*model.SavedBiller, error) {
+	if _, err := uc.billerUseCase.GetProductUseCase(payload.Category); err != nil {
+		return nil, fmt.Errorf("category %s is not a bill product", payload.Category)
+	}
+	if payload.ProductId == "" {
+		return nil, errors.New("product_id is required")
+	}
+	if payload.CustomerId == "" {
+		return nil, errors.New("customer_id is required")
+	}
+
+	productID := strings.ToLower(payload.ProductId)
+
+	existing, err := uc.savedBillerRepository.GetSavedBillerByCustomerIdRepository(userID, productID, payload.CustomerId)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, errors.New("biller already saved")
+	}
+
+	biller := &model.SavedBiller{
+		UserID:       userID,
+		Category:     payload.Category,
+		ProductId:    productID,
+		CustomerId:   payload.CustomerId,
+		ProviderName: payload.ProviderName,
+		Nickname:     payload.Nickname,
+	}
+
+	resp, err := uc.savedBillerRepository.CreateSavedBillerRepository(biller)
+	if err != nil {
+		return nil, fmt.Errorf("error creating saved biller in database: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *savedBillerUseCase) GetSavedBillersByUserIdUseCase(userID, category string, page, limit int) ([]*model.SavedBiller, error) {
+	billers, err := uc.savedBillerRepository.GetSavedBillersByUserIdRepository(userID, category, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return billers, nil
+}
+
+func (uc *savedBillerUseCase) GetSavedBillerByIdUseCase(userID, billerID string) (*model.SavedBiller, error) {
+	biller, err := uc.savedBillerRepository.GetSavedBillerByIdRepository(billerID)
+	if err != nil || biller.UserID != userID {
+		return nil, errors.New("saved biller not found")
+	}
+
+	return biller, nil
+}
+
+func (uc *savedBillerUseCase) UpdateSavedBillerUseCase(userID, billerID string, payload dto.SavedBillerDto) (*model.SavedBiller, error) {
+	biller, err := uc.GetSavedBillerByIdUseCase(userID, billerID)
+	if err != nil {
+		return nil, err
+	}
+
+	biller.Nickname = payload.Nickname
+	if payload.ProviderName != "" {
+		biller.ProviderName = payload.ProviderName
+	}
+	biller.UpdatedAt = time.Now()
+
+	resp, err := uc.savedBillerRepository.UpdateSavedBillerByIdRepository(billerID, biller)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update saved biller: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *savedBillerUseCase) DeleteSavedBillerUseCase(userID, billerID string) error {
+	if _, err := uc.GetSavedBillerByIdUseCase(userID, billerID); err != nil {
+		return err
+	}
+
+	if err := uc.savedBillerRepository.DeleteSavedBillerByIdRepository(billerID); err != nil {
+		return fmt.Errorf("failed to delete saved biller: %v", err)
+	}
+
+	return nil
+}
+
+func (uc *savedBillerUseCase) GetDueBillsUseCase(userID string) ([]*model.DueBill, error) {
+	billers, err := uc.savedBillerRepository.GetAllSavedBillersByUserIdRepository(userID)
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+
+	var postpaid []*model.SavedBiller
+	for _, biller := range billers {
+		// Prepaid electricity has no monthly bill to look up.
+		if biller.Category == model.PRODUCT_ELECTRICITY && biller.ProductId == "plnpre" {
+			continue
+		}
+		postpaid = append(postpaid, biller)
+	}
+
+	dueBills := make([]*model.DueBill, len(postpaid))
+
+	// At most dueBillWorkers inquiries run at once, so a user with many saved
+	// billers does not flood the provider.
+	var wg sync.WaitGroup
+	workers := make(chan struct{}, dueBillWorkers)
+	for i, biller := range postpaid {
+		wg.Add(1)
+		workers <- struct{}{}
+		go func(i int, biller *model.SavedBiller) {
+			defer func() {
+				<-workers
+				wg.Done()
+			}()
+			dueBills[i] = uc.getDueBill(userID, biller, uc.billingPeriod(biller.Category, now), now)
+		}(i, biller)
+	}
+	wg.Wait()
+
+	return dueBills, nil
+}
+
+// getDueBill shows a paid bill or an open inquiry of the period as it is. Only
+// a bill without either is inquired.
+func (uc *savedBillerUseCase) getDueBill(userID string, biller *model.SavedBiller, period string, now time.Time) *model.DueBill {
+	dueBill := &model.DueBill{Biller: biller}
+
+	existing, err := uc.transactionRepository.GetProductDetailsByPeriodAndCustomerID(model.GetProductDetail{
+		ProductId:  biller.ProductId,
+		Period:     period,
+		CustomerId: biller.CustomerId,
+	})
+	if err == nil && existing.Status == model.STATUS_SUCCESSFUL {
+		dueBill.Transaction = existing
+		dueBill.IsPaid = true
+		return dueBill
+	}
+	if err == nil && existing.UserID == userID && existing.Status == model.STATUS_UNPAID &&
+		(existing.ExpiresAt == nil || now.Before(*existing.ExpiresAt)) {
+		dueBill.Transaction = existing
+		return dueBill
+	}
+
+	transaction, err := uc.billInquiry(userID, biller)
+	if err != nil {
+		dueBill.Error = err.Error()
+		return dueBill
+	}
+
+	dueBill.Transaction = transaction
+	return dueBill
+}
+
+// billingPeriod falls back to the monthly period for categories that are no
+// longer registered as bill products.
+func (uc *savedBillerUseCase) billingPeriod(category string, now time.Time) string {
+	product, err := uc.billerUseCase.GetProductUseCase(category)
+	if err != nil {
+		return biller.CurrentPeriod(now)
+	}
+
+	return product.BillingPeriod(now)
+}
+
+func (uc *savedBillerUseCase) billInquiry(userID string, biller *model.SavedBiller) (*model.Transaction, error) {
+	payload := &model.OyBillerApi{
+		CustomerId: biller.CustomerId,
+		ProductId:  biller.ProductId,
+	}
+
+	return uc.billerUseCase.BillInquiryUseCase(biller.Category, userID, payload)
+}
@@ -0,0 +1,150 @@
+package savedbiller
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller"
+	"BE-Golang/usecase/mocks"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type SavedBillerUseCaseTest struct {
+	suite.Suite
+	savedBillerUseCase SavedBillerUseCase
+	savedBillerRepo    *repoMocks.SavedBillerRepository
+	transactionRepo    *repoMocks.TransactionRepository
+	billerUseCase      *mocks.BillerUseCase
+}
+
+func TestSavedBillerUseCase(t *testing.T) {
+	suite.Run(t, new(SavedBillerUseCaseTest))
+}
+
+func (m *SavedBillerUseCaseTest) SetupTest() {
+	m.savedBillerRepo = &repoMocks.SavedBillerRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.billerUseCase = &mocks.BillerUseCase{}
+	m.billerUseCase.On("GetProductUseCase", model.PRODUCT_PDAM).Return(&biller.Product{Code: model.PRODUCT_PDAM}, nil)
+	m.billerUseCase.On("GetProductUseCase", model.PRODUCT_ELECTRICITY).Return(&biller.Product{Code: model.PRODUCT_ELECTRICITY}, nil)
+	m.billerUseCase.On("GetProductUseCase", mock.Anything).Return(nil, errors.New("bill product not found"))
+	m.savedBillerUseCase = NewSavedBillerUseCase(m.savedBillerRepo, m.transactionRepo, m.billerUseCase)
+}
+
+func (m *SavedBillerUseCaseTest) TestCreateSavedBillerSuccess() {
+	m.savedBillerRepo.On("GetSavedBillerByCustomerIdRepository", "user", "plnpost", "123456").Return(nil, nil)
+	m.savedBillerRepo.On("CreateSavedBillerRepository", mock.Anything).Return(func(biller *model.SavedBiller) *model.SavedBiller {
+		return biller
+	}, nil)
+
+	resp, err := m.savedBillerUseCase.CreateSavedBillerUseCase("user", dto.SavedBillerDto{
+		Category:   model.PRODUCT_ELECTRICITY,
+		ProductId:  "PLNPOST",
+		CustomerId: "123456",
+		Nickname:   "Rumah",
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "plnpost", resp.ProductId)
+	assert.Equal(m.T(), "Rumah", resp.Nickname)
+}
+
+func (m *SavedBillerUseCaseTest) TestCreateSavedBillerAlreadySaved() {
+	m.savedBillerRepo.On("GetSavedBillerByCustomerIdRepository", "user", "pdam", "123456").Return(&model.SavedBiller{}, nil)
+
+	_, err := m.savedBillerUseCase.CreateSavedBillerUseCase("user", dto.SavedBillerDto{
+		Category:   model.PRODUCT_PDAM,
+		ProductId:  "pdam",
+		CustomerId: "123456",
+	})
+
+	assert.EqualError(m.T(), err, "biller already saved")
+}
+
+func (m *SavedBillerUseCaseTest) TestCreateSavedBillerUnknownCategory() {
+	_, err := m.savedBillerUseCase.CreateSavedBillerUseCase("user", dto.SavedBillerDto{
+		Category:   "pulsa",
+		ProductId:  "telkomsel",
+		CustomerId: "123456",
+	})
+
+	assert.EqualError(m.T(), err, "category pulsa is not a bill product")
+}
+
+func (m *SavedBillerUseCaseTest) TestCreateSavedBillerMissingCustomerId() {
+	_, err := m.savedBillerUseCase.CreateSavedBillerUseCase("user", dto.SavedBillerDto{
+		Category:  model.PRODUCT_PDAM,
+		ProductId: "pdam",
+	})
+
+	assert.EqualError(m.T(), err, "customer_id is required")
+}
+
+func (m *SavedBillerUseCaseTest) TestUpdateSavedBillerNickname() {
+	biller := &model.SavedBiller{UserID: "user", Nickname: "old", ProviderName: "PDAM Bandung"}
+
+	m.savedBillerRepo.On("GetSavedBillerByIdRepository", "id").Return(biller, nil)
+	m.savedBillerRepo.On("UpdateSavedBillerByIdRepository", "id", biller).Return(biller, nil)
+
+	resp, err := m.savedBillerUseCase.UpdateSavedBillerUseCase("user", "id", dto.SavedBillerDto{Nickname: "Kos"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "Kos", resp.Nickname)
+	assert.Equal(m.T(), "PDAM Bandung", resp.ProviderName)
+}
+
+func (m *SavedBillerUseCaseTest) TestDeleteSavedBillerNotOwner() {
+	m.savedBillerRepo.On("GetSavedBillerByIdRepository", "id").Return(&model.SavedBiller{UserID: "other"}, nil)
+
+	err := m.savedBillerUseCase.DeleteSavedBillerUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "saved biller not found")
+	m.savedBillerRepo.AssertNotCalled(m.T(), "DeleteSavedBillerByIdRepository", mock.Anything)
+}
+
+func (m *SavedBillerUseCaseTest) TestGetDueBills() {
+	pdamBiller := &model.SavedBiller{Category: model.PRODUCT_PDAM, ProductId: "pdam", CustomerId: "111"}
+	wifiBiller := &model.SavedBiller{Category: model.PRODUCT_WIFI, ProductId: "indihome", CustomerId: "222"}
+	bpjsBiller := &model.SavedBiller{Category: model.PRODUCT_INSURANCE, ProductId: "bpjsks", CustomerId: "333"}
+	tokenBiller := &model.SavedBiller{Category: model.PRODUCT_ELECTRICITY, ProductId: "plnpre", CustomerId: "444"}
+
+	paid := &model.Transaction{ID: "WIFI-1", Status: model.STATUS_SUCCESSFUL}
+	unpaid := &model.Transaction{ID: "PDAM-1", Status: model.STATUS_UNPAID}
+
+	m.savedBillerRepo.On("GetAllSavedBillersByUserIdRepository", "user").Return([]*model.SavedBiller{pdamBiller, wifiBiller, bpjsBiller, tokenBiller}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.MatchedBy(func(p model.GetProductDetail) bool { return p.CustomerId == "222" })).Return(paid, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(nil, errors.New("record not found"))
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_PDAM, "user", &model.OyBillerApi{CustomerId: "111", ProductId: "pdam"}).Return(unpaid, nil)
+	m.billerUseCase.On("BillInquiryUseCase", model.PRODUCT_INSURANCE, "user", mock.Anything).Return(nil, errors.New("invalid customer ID"))
+
+	resp, err := m.savedBillerUseCase.GetDueBillsUseCase("user")
+
+	assert.NoError(m.T(), err)
+	assert.Len(m.T(), resp, 3)
+	assert.Equal(m.T(), unpaid, resp[0].Transaction)
+	assert.False(m.T(), resp[0].IsPaid)
+	assert.True(m.T(), resp[1].IsPaid)
+	assert.Equal(m.T(), "invalid customer ID", resp[2].Error)
+	m.billerUseCase.AssertNotCalled(m.T(), "BillInquiryUseCase", model.PRODUCT_ELECTRICITY, mock.Anything, mock.Anything)
+}
+
+func (m *SavedBillerUseCaseTest) TestGetDueBillsReusesOpenInquiry() {
+	pdamBiller := &model.SavedBiller{Category: model.PRODUCT_PDAM, ProductId: "pdam", CustomerId: "111"}
+	expiresAt := time.Now().Add(time.Hour)
+	open := &model.Transaction{ID: "PDAM-1", UserID: "user", Status: model.STATUS_UNPAID, ExpiresAt: &expiresAt}
+
+	m.savedBillerRepo.On("GetAllSavedBillersByUserIdRepository", "user").Return([]*model.SavedBiller{pdamBiller}, nil)
+	m.transactionRepo.On("GetProductDetailsByPeriodAndCustomerID", mock.Anything).Return(open, nil)
+
+	resp, err := m.savedBillerUseCase.GetDueBillsUseCase("user")
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), open, resp[0].Transaction)
+	m.billerUseCase.AssertNotCalled(m.T(), "BillInquiryUseCase", mock.Anything, mock.Anything, mock.Anything)
+}
@@ -0,0 +1,316 @@
+package transfer
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/notification"
+	"BE-Golang/usecase/users"
+	"errors"
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/robfig/cron/v3"
+)
+
+type ScheduledTransferUseCase interface {
+	CreateScheduledTransferUseCase(userID string, payload dto.ScheduledTransferDto) (*model.ScheduledTransfer, error)
+	GetScheduledTransfersByUserIdUseCase(userID, status string, page, limit int) ([]*model.ScheduledTransfer, error)
+	GetScheduledTransferByIdUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error)
+	PauseScheduledTransferUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error)
+	ResumeScheduledTransferUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error)
+	CancelScheduledTransferUseCase(userID, scheduleID string) error
+	RunDueScheduledTransfersUseCase(now time.Time) error
+}
+
+type scheduledTransferUseCase struct {
+	scheduledTransferRepository repository.ScheduledTransferRepository
+	userRepository              repository.UserRepository
+	userUsecase                 users.UserUsecase
+	notificationUseCase         notification.NotificationUseCase
+}
+
+func NewScheduledTransferUseCase(scheduledTransferRepository repository.ScheduledTransferRepository, userRepository repository.UserRepository, userUsecase users.UserUsecase, notificationUseCase notification.NotificationUseCase) *scheduledTransferUseCase {
+	return &scheduledTransferUseCase{
+		scheduledTransferRepository: scheduledTransferRepository,
+		userRepository:              userRepository,
+		userUsecase:                 userUsecase,
+		notificationUseCase:         notificationUseCase,
+	}
+}
+
+func (uc *scheduledTransferUseCase) CreateScheduledTransferUseCase(userID string, payload dto.ScheduledTransferDto) (*model.ScheduledTransfer, error) {
+	if payload.Amount <= 0 {
+		return nil, errors.New("amount must be greater than 0")
+	}
+	if payload.PhoneNumber == "" {
+		return nil, errors.New("phone number is required")
+	}
+
+	recipient, err := uc.userRepository.GetUserByPhone(payload.PhoneNumber)
+	if err != nil {
+		return nil, errors.New("recipient not found")
+	}
+	if recipient.ID == userID {
+		return nil, errors.New("cannot schedule a transfer to yourself")
+	}
+
+	now := time.Now()
+	var nextRunAt time.Time
+
+	if payload.CronExpression != "" {
+		schedule, err := cron.ParseStandard(payload.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression: %v", err)
+		}
+
+		from := now
+		if payload.StartAt.After(now) {
+			from = payload.StartAt.Add(-time.Second)
+		}
+		nextRunAt = schedule.Next(from)
+	} else {
+		if payload.StartAt.IsZero() {
+			return nil, errors.New("start_at is required for a one-time transfer")
+		}
+		if payload.StartAt.Before(now) {
+			return nil, errors.New("start_at must be in the future")
+		}
+		nextRunAt = payload.StartAt
+	}
+
+	maxRetry := config.AppConfig.TransferMaxRetry
+	if payload.MaxRetry != nil && *payload.MaxRetry >= 0 {
+		maxRetry = *payload.MaxRetry
+	}
+
+	scheduledTransfer := &model.ScheduledTransfer{
+		UserID:         userID,
+		PhoneNumber:    recipient.Phone,
+		Amount:         payload.Amount,
+		Note:           payload.Note,
+		CronExpression: payload.CronExpression,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+		NextRunAt:      nextRunAt,
+		MaxRetry:       maxRetry,
+	}
+
+	resp, err := uc.scheduledTransferRepository.CreateScheduledTransferRepository(scheduledTransfer)
+	if err != nil {
+		return nil, fmt.Errorf("error creating scheduled transfer in database: %w", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *scheduledTransferUseCase) GetScheduledTransfersByUserIdUseCase(userID, status string, page, limit int) ([]*model.ScheduledTransfer, error) {
+	schedules, err := uc.scheduledTransferRepository.GetScheduledTransfersByUserIdRepository(userID, status, page, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	return schedules, nil
+}
+
+func (uc *scheduledTransferUseCase) GetScheduledTransferByIdUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error) {
+	schedule, err := uc.scheduledTransferRepository.GetScheduledTransferByIdRepository(scheduleID)
+	if err != nil || schedule.UserID != userID {
+		return nil, errors.New("scheduled transfer not found")
+	}
+
+	return schedule, nil
+}
+
+func (uc *scheduledTransferUseCase) PauseScheduledTransferUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error) {
+	schedule, err := uc.GetScheduledTransferByIdUseCase(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Status != model.SCHEDULE_STATUS_ACTIVE {
+		return nil, errors.New("only active scheduled transfers can be paused")
+	}
+
+	schedule.Status = model.SCHEDULE_STATUS_PAUSED
+	schedule.UpdatedAt = time.Now()
+
+	resp, err := uc.scheduledTransferRepository.UpdateScheduledTransferByIdRepository(scheduleID, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to pause scheduled transfer: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *scheduledTransferUseCase) ResumeScheduledTransferUseCase(userID, scheduleID string) (*model.ScheduledTransfer, error) {
+	schedule, err := uc.GetScheduledTransferByIdUseCase(userID, scheduleID)
+	if err != nil {
+		return nil, err
+	}
+
+	if schedule.Status != model.SCHEDULE_STATUS_PAUSED {
+		return nil, errors.New("only paused scheduled transfers can be resumed")
+	}
+
+	now := time.Now()
+
+	// Occurrences missed while paused are skipped rather than sent all at once.
+	if schedule.CronExpression != "" && schedule.NextRunAt.Before(now) {
+		cronSchedule, err := cron.ParseStandard(schedule.CronExpression)
+		if err != nil {
+			return nil, fmt.Errorf("invalid cron expression: %v", err)
+		}
+		schedule.NextRunAt = cronSchedule.Next(now)
+	}
+
+	schedule.Status = model.SCHEDULE_STATUS_ACTIVE
+	schedule.RetryCount = 0
+	schedule.UpdatedAt = now
+
+	resp, err := uc.scheduledTransferRepository.UpdateScheduledTransferByIdRepository(scheduleID, schedule)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resume scheduled transfer: %v", err)
+	}
+
+	return resp, nil
+}
+
+func (uc *scheduledTransferUseCase) CancelScheduledTransferUseCase(userID, scheduleID string) error {
+	schedule, err := uc.GetScheduledTransferByIdUseCase(userID, scheduleID)
+	if err != nil {
+		return err
+	}
+
+	if schedule.Status != model.SCHEDULE_STATUS_ACTIVE && schedule.Status != model.SCHEDULE_STATUS_PAUSED {
+		return errors.New("scheduled transfer is already finished")
+	}
+
+	schedule.Status = model.SCHEDULE_STATUS_CANCELLED
+	schedule.UpdatedAt = time.Now()
+
+	_, err = uc.scheduledTransferRepository.UpdateScheduledTransferByIdRepository(scheduleID, schedule)
+	if err != nil {
+		return fmt.Errorf("failed to cancel scheduled transfer: %v", err)
+	}
+
+	return nil
+}
+
+func (uc *scheduledTransferUseCase) RunDueScheduledTransfersUseCase(now time.Time) error {
+	schedules, err := uc.scheduledTransferRepository.GetDueScheduledTransfersRepository(now)
+	if err != nil {
+		return err
+	}
+
+	failed := 0
+	for _, schedule := range schedules {
+		if err := uc.runScheduledTransfer(schedule, now); err != nil {
+			log.Printf("scheduled transfer %s: %v", schedule.ID, err)
+			failed++
+		}
+	}
+
+	if failed > 0 {
+		return fmt.Errorf("failed to process %d of %d scheduled transfers", failed, len(schedules))
+	}
+
+	return nil
+}
+
+func (uc *scheduledTransferUseCase) runScheduledTransfer(schedule *model.ScheduledTransfer, now time.Time) error {
+	// Claim the run before transferring by moving the schedule on as if the
+	// transfer succeeds. Should saving the outcome below fail, the next tick
+	// then finds nothing due instead of transferring again.
+	claimed := *schedule
+	claimed.UpdatedAt = now
+	advanceSchedule(&claimed, now)
+	ok, err := uc.scheduledTransferRepository.ClaimScheduledTransferRunRepository(schedule.ID, schedule.NextRunAt, &claimed)
+	if err != nil {
+		return fmt.Errorf("error claiming scheduled transfer run: %w", err)
+	}
+	if !ok {
+		return nil
+	}
+	if claimed.Status == model.SCHEDULE_STATUS_FAILED {
+		*schedule = claimed
+		_, err = uc.scheduledTransferRepository.UpdateScheduledTransferByIdRepository(schedule.ID, schedule)
+		return err
+	}
+
+	transaction, err := uc.userUsecase.TransferAmountUseCase(schedule.UserID, dto.TransactionTransferDto{
+		PhoneNumber: schedule.PhoneNumber,
+		Amount:      schedule.Amount,
+		Note:        schedule.Note,
+	})
+
+	schedule.LastRunAt = &now
+	schedule.UpdatedAt = now
+
+	switch {
+	case err == nil:
+		schedule.LastTransactionID = transaction.ID
+		schedule.LastError = ""
+		schedule.RetryCount = 0
+		advanceSchedule(schedule, now)
+	case errors.Is(err, users.ErrBalanceNotEnough) && schedule.RetryCount < schedule.MaxRetry:
+		schedule.LastError = err.Error()
+		schedule.RetryCount++
+		schedule.NextRunAt = now.Add(retryInterval())
+	default:
+		schedule.LastError = err.Error()
+		schedule.RetryCount = 0
+		if schedule.CronExpression == "" {
+			schedule.Status = model.SCHEDULE_STATUS_FAILED
+		} else {
+			advanceSchedule(schedule, now)
+		}
+		uc.notifyTransferFailed(schedule, err)
+	}
+
+	_, err = uc.scheduledTransferRepository.UpdateScheduledTransferByIdRepository(schedule.ID, schedule)
+	if err != nil {
+		return fmt.Errorf("error updating scheduled transfer in database: %w", err)
+	}
+
+	return nil
+}
+
+func (uc *scheduledTransferUseCase) notifyTransferFailed(schedule *model.ScheduledTransfer, cause error) {
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_TRANSFER,
+		Title:    "Transfer Terjadwal Gagal",
+		Message: fmt.Sprintf("Transfer terjadwal sebesar RP.%s ke %s gagal diproses: %s",
+			strconv.FormatFloat(schedule.Amount, 'f', -1, 64), schedule.PhoneNumber, cause.Error()),
+	}
+
+	if err := uc.notificationUseCase.SendNotificationUseCase(schedule.UserID, notification); err != nil {
+		log.Printf("scheduled transfer %s: failed to notify user: %v", schedule.ID, err)
+	}
+}
+
+func advanceSchedule(schedule *model.ScheduledTransfer, now time.Time) {
+	if schedule.CronExpression == "" {
+		schedule.Status = model.SCHEDULE_STATUS_COMPLETED
+		return
+	}
+
+	cronSchedule, err := cron.ParseStandard(schedule.CronExpression)
+	if err != nil {
+		schedule.Status = model.SCHEDULE_STATUS_FAILED
+		schedule.LastError = fmt.Sprintf("invalid cron expression: %v", err)
+		return
+	}
+
+	schedule.NextRunAt = cronSchedule.Next(now)
+}
+
+func retryInterval() time.Duration {
+	if config.AppConfig.TransferRetryInterval <= 0 {
+		return time.Hour
+	}
+
+	return time.Duration(config.AppConfig.TransferRetryInterval) * time.Minute
+}
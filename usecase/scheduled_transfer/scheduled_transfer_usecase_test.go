@@ -0,0 +1,316 @@
+package transfer
+
+import (
+	"BE-Golang/config"
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/mocks"
+	"BE-Golang/usecase/users"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduledTransferUseCaseTest struct {
+	suite.Suite
+	scheduledTransferUseCase ScheduledTransferUseCase
+	scheduledTransferRepo    *repoMocks.ScheduledTransferRepository
+	userRepo                 *repoMocks.UserRepository
+	userUsecase              *mocks.UserUsecase
+	notificationUseCase      *mocks.NotificationUseCase
+}
+
+func TestScheduledTransferUseCase(t *testing.T) {
+	suite.Run(t, new(ScheduledTransferUseCaseTest))
+}
+
+func (m *ScheduledTransferUseCaseTest) SetupTest() {
+	config.AppConfig.TransferMaxRetry = 2
+	config.AppConfig.TransferRetryInterval = 30
+
+	m.scheduledTransferRepo = &repoMocks.ScheduledTransferRepository{}
+	m.userRepo = &repoMocks.UserRepository{}
+	m.userUsecase = &mocks.UserUsecase{}
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.scheduledTransferUseCase = NewScheduledTransferUseCase(m.scheduledTransferRepo, m.userRepo, m.userUsecase, m.notificationUseCase)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferOneTimeSuccess() {
+	startAt := time.Now().Add(time.Hour)
+	recipient := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "recipient"}, Phone: "08123456789"}
+
+	m.userRepo.On("GetUserByPhone", "08123456789").Return(recipient, nil)
+	m.scheduledTransferRepo.On("CreateScheduledTransferRepository", mock.Anything).Return(func(schedule *model.ScheduledTransfer) *model.ScheduledTransfer {
+		return schedule
+	}, nil)
+
+	resp, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber: "08123456789",
+		Amount:      50000,
+		StartAt:     startAt,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_ACTIVE, resp.Status)
+	assert.Equal(m.T(), startAt, resp.NextRunAt)
+	assert.Equal(m.T(), 2, resp.MaxRetry)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferRecurringSuccess() {
+	recipient := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "recipient"}, Phone: "08123456789"}
+	maxRetry := 5
+
+	m.userRepo.On("GetUserByPhone", "08123456789").Return(recipient, nil)
+	m.scheduledTransferRepo.On("CreateScheduledTransferRepository", mock.Anything).Return(func(schedule *model.ScheduledTransfer) *model.ScheduledTransfer {
+		return schedule
+	}, nil)
+
+	resp, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber:    "08123456789",
+		Amount:         1500000,
+		CronExpression: "0 9 25 * *",
+		MaxRetry:       &maxRetry,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), 25, resp.NextRunAt.Day())
+	assert.Equal(m.T(), 9, resp.NextRunAt.Hour())
+	assert.Equal(m.T(), 5, resp.MaxRetry)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferInvalidAmount() {
+	_, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber: "08123456789",
+	})
+
+	assert.EqualError(m.T(), err, "amount must be greater than 0")
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferToSelf() {
+	recipient := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "user"}, Phone: "08123456789"}
+
+	m.userRepo.On("GetUserByPhone", "08123456789").Return(recipient, nil)
+
+	_, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber: "08123456789",
+		Amount:      50000,
+		StartAt:     time.Now().Add(time.Hour),
+	})
+
+	assert.EqualError(m.T(), err, "cannot schedule a transfer to yourself")
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferInvalidCron() {
+	recipient := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "recipient"}, Phone: "08123456789"}
+
+	m.userRepo.On("GetUserByPhone", "08123456789").Return(recipient, nil)
+
+	_, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber:    "08123456789",
+		Amount:         50000,
+		CronExpression: "every month",
+	})
+
+	assert.Error(m.T(), err)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCreateScheduledTransferStartAtInPast() {
+	recipient := &model.User{UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "recipient"}, Phone: "08123456789"}
+
+	m.userRepo.On("GetUserByPhone", "08123456789").Return(recipient, nil)
+
+	_, err := m.scheduledTransferUseCase.CreateScheduledTransferUseCase("user", dto.ScheduledTransferDto{
+		PhoneNumber: "08123456789",
+		Amount:      50000,
+		StartAt:     time.Now().Add(-time.Hour),
+	})
+
+	assert.EqualError(m.T(), err, "start_at must be in the future")
+}
+
+func (m *ScheduledTransferUseCaseTest) TestGetScheduledTransferByIdOtherUser() {
+	schedule := &model.ScheduledTransfer{UserID: "other"}
+
+	m.scheduledTransferRepo.On("GetScheduledTransferByIdRepository", "id").Return(schedule, nil)
+
+	_, err := m.scheduledTransferUseCase.GetScheduledTransferByIdUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "scheduled transfer not found")
+}
+
+func (m *ScheduledTransferUseCaseTest) TestPauseScheduledTransferSuccess() {
+	schedule := &model.ScheduledTransfer{UserID: "user", Status: model.SCHEDULE_STATUS_ACTIVE}
+
+	m.scheduledTransferRepo.On("GetScheduledTransferByIdRepository", "id").Return(schedule, nil)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	resp, err := m.scheduledTransferUseCase.PauseScheduledTransferUseCase("user", "id")
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_PAUSED, resp.Status)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestResumeScheduledTransferSkipsMissedRuns() {
+	schedule := &model.ScheduledTransfer{
+		UserID:         "user",
+		Status:         model.SCHEDULE_STATUS_PAUSED,
+		CronExpression: "@daily",
+		NextRunAt:      time.Now().AddDate(0, 0, -3),
+	}
+
+	m.scheduledTransferRepo.On("GetScheduledTransferByIdRepository", "id").Return(schedule, nil)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	resp, err := m.scheduledTransferUseCase.ResumeScheduledTransferUseCase("user", "id")
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_ACTIVE, resp.Status)
+	assert.True(m.T(), resp.NextRunAt.After(time.Now()))
+}
+
+func (m *ScheduledTransferUseCaseTest) TestCancelScheduledTransferAlreadyFinished() {
+	schedule := &model.ScheduledTransfer{UserID: "user", Status: model.SCHEDULE_STATUS_COMPLETED}
+
+	m.scheduledTransferRepo.On("GetScheduledTransferByIdRepository", "id").Return(schedule, nil)
+
+	err := m.scheduledTransferUseCase.CancelScheduledTransferUseCase("user", "id")
+
+	assert.EqualError(m.T(), err, "scheduled transfer is already finished")
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersOneTimeSuccess() {
+	now := time.Now()
+	schedule := &model.ScheduledTransfer{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		PhoneNumber:    "08123456789",
+		Amount:         50000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+	}
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return([]*model.ScheduledTransfer{schedule}, nil)
+	m.scheduledTransferRepo.On("ClaimScheduledTransferRunRepository", "id", schedule.NextRunAt, mock.Anything).Return(true, nil)
+	m.userUsecase.On("TransferAmountUseCase", "user", dto.TransactionTransferDto{PhoneNumber: "08123456789", Amount: 50000}).Return(&model.Transaction{ID: "trx"}, nil)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_COMPLETED, schedule.Status)
+	assert.Equal(m.T(), "trx", schedule.LastTransactionID)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersRetryOnInsufficientBalance() {
+	now := time.Now()
+	schedule := &model.ScheduledTransfer{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		PhoneNumber:    "08123456789",
+		Amount:         50000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+		MaxRetry:       2,
+	}
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return([]*model.ScheduledTransfer{schedule}, nil)
+	m.scheduledTransferRepo.On("ClaimScheduledTransferRunRepository", "id", schedule.NextRunAt, mock.Anything).Return(true, nil)
+	m.userUsecase.On("TransferAmountUseCase", "user", mock.Anything).Return(nil, users.ErrBalanceNotEnough)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_ACTIVE, schedule.Status)
+	assert.Equal(m.T(), 1, schedule.RetryCount)
+	assert.Equal(m.T(), now.Add(30*time.Minute), schedule.NextRunAt)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersRetryExhausted() {
+	now := time.Date(2026, time.March, 25, 9, 0, 0, 0, time.Local)
+	schedule := &model.ScheduledTransfer{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		PhoneNumber:    "08123456789",
+		Amount:         50000,
+		CronExpression: "0 9 25 * *",
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+		RetryCount:     2,
+		MaxRetry:       2,
+	}
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return([]*model.ScheduledTransfer{schedule}, nil)
+	m.scheduledTransferRepo.On("ClaimScheduledTransferRunRepository", "id", schedule.NextRunAt, mock.Anything).Return(true, nil)
+	m.userUsecase.On("TransferAmountUseCase", "user", mock.Anything).Return(nil, users.ErrBalanceNotEnough)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_ACTIVE, schedule.Status)
+	assert.Equal(m.T(), 0, schedule.RetryCount)
+	assert.Equal(m.T(), time.April, schedule.NextRunAt.Month())
+	m.notificationUseCase.AssertCalled(m.T(), "SendNotificationUseCase", "user", mock.Anything)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersOneTimeFailure() {
+	now := time.Now()
+	schedule := &model.ScheduledTransfer{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		PhoneNumber:    "08123456789",
+		Amount:         50000,
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+		MaxRetry:       2,
+	}
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return([]*model.ScheduledTransfer{schedule}, nil)
+	m.scheduledTransferRepo.On("ClaimScheduledTransferRunRepository", "id", schedule.NextRunAt, mock.Anything).Return(true, nil)
+	m.userUsecase.On("TransferAmountUseCase", "user", mock.Anything).Return(nil, errors.New("error user id not found"))
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+	m.scheduledTransferRepo.On("UpdateScheduledTransferByIdRepository", "id", schedule).Return(schedule, nil)
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.SCHEDULE_STATUS_FAILED, schedule.Status)
+	assert.Equal(m.T(), "error user id not found", schedule.LastError)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersAlreadyClaimed() {
+	now := time.Now()
+	schedule := &model.ScheduledTransfer{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "id"},
+		UserID:         "user",
+		Amount:         50000,
+		CronExpression: "@daily",
+		Status:         model.SCHEDULE_STATUS_ACTIVE,
+		NextRunAt:      now.Add(-time.Minute),
+	}
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return([]*model.ScheduledTransfer{schedule}, nil)
+	m.scheduledTransferRepo.On("ClaimScheduledTransferRunRepository", "id", now.Add(-time.Minute), mock.MatchedBy(func(claimed *model.ScheduledTransfer) bool {
+		return claimed.NextRunAt.After(now)
+	})).Return(false, nil)
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.userUsecase.AssertNotCalled(m.T(), "TransferAmountUseCase", mock.Anything, mock.Anything)
+	m.scheduledTransferRepo.AssertNotCalled(m.T(), "UpdateScheduledTransferByIdRepository", mock.Anything, mock.Anything)
+}
+
+func (m *ScheduledTransferUseCaseTest) TestRunDueScheduledTransfersRepositoryError() {
+	now := time.Now()
+
+	m.scheduledTransferRepo.On("GetDueScheduledTransfersRepository", now).Return(nil, errors.New("repository error"))
+
+	err := m.scheduledTransferUseCase.RunDueScheduledTransfersUseCase(now)
+
+	assert.EqualError(m.T(), err, "repository error")
+}
@@ -0,0 +1,70 @@
+package scheduler
+
+import (
+	"log"
+	"sync"
+	"time"
+)
+
+type JobFunc func(now time.Time) error
+
+type job struct {
+	name     string
+	interval time.Duration
+	run      JobFunc
+}
+
+// Jobs keep their state in the database, so a restart only delays work until the next tick.
+type Scheduler struct {
+	jobs []job
+	stop chan struct{}
+	wg   sync.WaitGroup
+}
+
+func NewScheduler() *Scheduler {
+	return &Scheduler{stop: make(chan struct{})}
+}
+
+func (s *Scheduler) AddJob(name string, interval time.Duration, run JobFunc) {
+	s.jobs = append(s.jobs, job{name: name, interval: interval, run: run})
+}
+
+func (s *Scheduler) Start() {
+	for _, j := range s.jobs {
+		s.wg.Add(1)
+		go s.loop(j)
+	}
+}
+
+func (s *Scheduler) Stop() {
+	close(s.stop)
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(j job) {
+	defer s.wg.Done()
+
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-s.stop:
+			return
+		case now := <-ticker.C:
+			s.runJob(j, now)
+		}
+	}
+}
+
+func (s *Scheduler) runJob(j job, now time.Time) {
+	defer func() {
+		if r := recover(); r != nil {
+			log.Printf("scheduler: job %s panicked: %v", j.name, r)
+		}
+	}()
+
+	if err := j.run(now); err != nil {
+		log.Printf("scheduler: job %s failed: %v", j.name, err)
+	}
+}
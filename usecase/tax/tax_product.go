@@ -0,0 +1,259 @@
+package tax
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+const (
+	// njoptkp is the part of the NJOP that is not taxed.
+	njoptkp = 10000000.0
+
+	swdklljMotorcycle = 35000.0
+	swdklljCar        = 143000.0
+)
+
+var (
+	taxObjectNumberPattern = regexp.MustCompile(`^[0-9]{18}$`)
+	platePattern           = regexp.MustCompile(`^([A-Z]{1,2})[0-9]{1,4}[A-Z]{0,3}$`)
+)
+
+// NewPbbProduct plugs yearly land and building tax into the biller engine.
+// The inquiry's product ID selects the municipality from the tax regions.
+func NewPbbProduct(taxRegionRepository repository.TaxRegionRepository, billerOyApiRepository repository.BillerOyApiRepository) *biller.Product {
+	return &biller.Product{
+		Code:               model.PRODUCT_PBB,
+		Category:           model.PRODUCT_PBB,
+		Name:               "PBB",
+		TransactionPrefix:  "PBB",
+		Yearly:             true,
+		ValidateCustomerId: validateTaxObjectNumber,
+		ValidateForProvider: func(provider, customerID string) ([]model.FieldError, error) {
+			region, err := findRegion(taxRegionRepository, model.PRODUCT_PBB, provider)
+			if err != nil {
+				return nil, err
+			}
+			if !hasPrefix(region, customerID[:4]) {
+				return regionError(fmt.Sprintf("tax object number does not belong to %s", region.Name)), nil
+			}
+
+			return nil, nil
+		},
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			region, err := findRegion(taxRegionRepository, model.PRODUCT_PBB, inquiry.ProductType)
+			if err != nil {
+				return nil, err
+			}
+
+			return pricePbb(region, inquiry), nil
+		},
+		Detail: func() interface{} {
+			return &model.PbbBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			receipt.ReferenceNumber = detail.(*model.PbbBill).ReferenceNumber
+		},
+		Settle: func(transactionID string, detail interface{}) (interface{}, error) {
+			pbb := detail.(*model.PbbBill)
+			reference, err := payTax(billerOyApiRepository, "PBB", &model.OyBillerApi{
+				CustomerId:  pbb.CustomerID,
+				ProductId:   pbb.Type,
+				PartnerTxId: transactionID,
+				Period:      pbb.Period,
+				Amount:      pbb.Price,
+			})
+			if err != nil {
+				return nil, err
+			}
+			pbb.ReferenceNumber = reference
+			return pbb, nil
+		},
+	}
+}
+
+// NewSamsatProduct plugs yearly vehicle tax into the biller engine. The
+// inquiry's product ID selects the province from the tax regions.
+func NewSamsatProduct(taxRegionRepository repository.TaxRegionRepository, billerOyApiRepository repository.BillerOyApiRepository) *biller.Product {
+	return &biller.Product{
+		Code:                model.PRODUCT_SAMSAT,
+		Category:            model.PRODUCT_SAMSAT,
+		Name:                "SAMSAT",
+		TransactionPrefix:   "SAMSAT",
+		Yearly:              true,
+		NormalizeCustomerId: normalizePlateNumber,
+		ValidateCustomerId:  validatePlateNumber,
+		ValidateForProvider: func(provider, customerID string) ([]model.FieldError, error) {
+			region, err := findRegion(taxRegionRepository, model.PRODUCT_SAMSAT, provider)
+			if err != nil {
+				return nil, err
+			}
+			if !hasPrefix(region, platePattern.FindStringSubmatch(customerID)[1]) {
+				return regionError(fmt.Sprintf("vehicle plate does not belong to %s", region.Name)), nil
+			}
+
+			return nil, nil
+		},
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			region, err := findRegion(taxRegionRepository, model.PRODUCT_SAMSAT, inquiry.ProductType)
+			if err != nil {
+				return nil, err
+			}
+
+			return priceSamsat(region, inquiry), nil
+		},
+		Detail: func() interface{} {
+			return &model.SamsatBill{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			receipt.ReferenceNumber = detail.(*model.SamsatBill).ReferenceNumber
+		},
+		Settle: func(transactionID string, detail interface{}) (interface{}, error) {
+			samsat := detail.(*model.SamsatBill)
+			reference, err := payTax(billerOyApiRepository, "SAMSAT", &model.OyBillerApi{
+				CustomerId:  samsat.CustomerID,
+				ProductId:   samsat.Type,
+				PartnerTxId: transactionID,
+				Period:      samsat.Period,
+				Amount:      samsat.Price,
+			})
+			if err != nil {
+				return nil, err
+			}
+			samsat.ReferenceNumber = reference
+			return samsat, nil
+		},
+	}
+}
+
+// payTax pays the tax at the gateway and returns the reference number the
+// tax office issued for the payment.
+func payTax(billerOyApiRepository repository.BillerOyApiRepository, name string, payload *model.OyBillerApi) (string, error) {
+	response, err := billerOyApiRepository.PayBillRepository(payload)
+	if err != nil {
+		return "", fmt.Errorf("failed to pay %s: %w", name, err)
+	}
+	if response.TxID == "" {
+		return "", fmt.Errorf("%s payment was not confirmed: %s", name, response.Message)
+	}
+
+	return response.TxID, nil
+}
+
+func validateTaxObjectNumber(customerID string) error {
+	if !taxObjectNumberPattern.MatchString(customerID) {
+		return errors.New("tax object number must be 18 digits")
+	}
+
+	return nil
+}
+
+// normalizePlateNumber turns plates typed as e.g. "b 1234 abc" into
+// "B1234ABC".
+func normalizePlateNumber(customerID string) string {
+	return strings.ToUpper(strings.Join(strings.Fields(customerID), ""))
+}
+
+func validatePlateNumber(customerID string) error {
+	if !platePattern.MatchString(customerID) {
+		return errors.New("invalid vehicle plate number")
+	}
+
+	return nil
+}
+
+func findRegion(taxRegionRepository repository.TaxRegionRepository, category, code string) (*model.TaxRegion, error) {
+	region, err := taxRegionRepository.GetTaxRegionByCodeRepository(code)
+	if err != nil {
+		return nil, err
+	}
+	if region == nil || region.Category != category {
+		return nil, fmt.Errorf("tax region %s not found", code)
+	}
+
+	return region, nil
+}
+
+func regionError(message string) []model.FieldError {
+	return []model.FieldError{{Field: "customer_id", Rule: "region", Message: message}}
+}
+
+func hasPrefix(region *model.TaxRegion, prefix string) bool {
+	for _, p := range strings.Split(region.Prefixes, ",") {
+		if strings.TrimSpace(p) == prefix {
+			return true
+		}
+	}
+
+	return false
+}
+
+func pricePbb(region *model.TaxRegion, inquiry *biller.Inquiry) *biller.Bill {
+	h := biller.Hash(inquiry.Payload.CustomerId)
+	landArea := int(h%241) + 60
+	buildingArea := int(h/241%165) + 36
+	njop := float64(landArea)*float64(h/241/165%4+1)*1000000 + float64(buildingArea)*float64(h/241/165/4%3+1)*1000000
+	price := calculatePbb(njop, region.TaxRate)
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran PBB %s %s ", region.Name, inquiry.Payload.Period),
+		Detail: &model.PbbBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: region.Name,
+			Type:         region.Code,
+			Name:         inquiry.User.Name,
+			Period:       inquiry.Payload.Period,
+			LandArea:     landArea,
+			BuildingArea: buildingArea,
+			Njop:         njop,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        price,
+		},
+	}
+}
+
+func priceSamsat(region *model.TaxRegion, inquiry *biller.Inquiry) *biller.Bill {
+	h := biller.Hash(inquiry.Payload.CustomerId)
+	vehicleType := "motor"
+	vehicleValue := float64(h/2%16+15) * 1000000
+	swdkllj := swdklljMotorcycle
+	if h%2 == 0 {
+		vehicleType = "mobil"
+		vehicleValue = float64(h/2%251+150) * 1000000
+		swdkllj = swdklljCar
+	}
+	pkb := vehicleValue * region.TaxRate
+	price := pkb + swdkllj
+
+	return &biller.Bill{
+		Price:       price,
+		Description: fmt.Sprintf("Pembayaran Pajak Kendaraan %s %s ", inquiry.Payload.CustomerId, inquiry.Payload.Period),
+		Detail: &model.SamsatBill{
+			CustomerID:   inquiry.Payload.CustomerId,
+			ProviderName: region.Name,
+			Type:         region.Code,
+			Name:         inquiry.User.Name,
+			Period:       inquiry.Payload.Period,
+			VehicleType:  vehicleType,
+			VehicleValue: vehicleValue,
+			Pkb:          pkb,
+			Swdkllj:      swdkllj,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        price,
+		},
+	}
+}
+
+func calculatePbb(njop, rate float64) float64 {
+	taxable := njop - njoptkp
+	if taxable < 0 {
+		return 0
+	}
+
+	return taxable * rate
+}
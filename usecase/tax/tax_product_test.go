@@ -0,0 +1,133 @@
+package tax
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller/billertest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+)
+
+func TestValidateTaxObjectNumber(t *testing.T) {
+	assert.NoError(t, validateTaxObjectNumber("317101000100100010"))
+	assert.EqualError(t, validateTaxObjectNumber("31.71.010.001.001-0001.0"), "tax object number must be 18 digits")
+	assert.Error(t, validateTaxObjectNumber("3171"))
+}
+
+func TestValidatePlateNumber(t *testing.T) {
+	assert.NoError(t, validatePlateNumber("B1234ABC"))
+	assert.NoError(t, validatePlateNumber("AB1"))
+	assert.EqualError(t, validatePlateNumber("B 1234 ABC"), "invalid vehicle plate number")
+	assert.Error(t, validatePlateNumber("1234"))
+}
+
+func TestNormalizePlateNumber(t *testing.T) {
+	assert.Equal(t, "B1234ABC", normalizePlateNumber(" b 1234  abc "))
+	assert.NoError(t, validatePlateNumber(normalizePlateNumber("b 1234 abc")))
+}
+
+func TestCalculatePbb(t *testing.T) {
+	assert.Equal(t, 0.0, calculatePbb(8000000, 0.001))
+	assert.Equal(t, 90000.0, calculatePbb(100000000, 0.001))
+}
+
+func TestPbbProductPrice(t *testing.T) {
+	repo := &mocks.TaxRegionRepository{}
+	repo.On("GetTaxRegionByCodeRepository", "pbb_jakarta").Return(&model.TaxRegion{
+		Category: model.PRODUCT_PBB,
+		Code:     "pbb_jakarta",
+		Name:     "Jakarta Pusat",
+		Prefixes: "3171,3172",
+		TaxRate:  0.001,
+	}, nil)
+	product := NewPbbProduct(repo, &mocks.BillerOyApiRepository{})
+
+	bill, err := product.Price(billertest.Inquiry("pbb_jakarta", "317201000100100010", "2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.PbbBill)
+	assert.Equal(t, "Jakarta Pusat", detail.ProviderName)
+	assert.Equal(t, "2026", detail.Period)
+	assert.Equal(t, calculatePbb(detail.Njop, 0.001), bill.Price)
+
+	again, err := product.Price(billertest.Inquiry("pbb_jakarta", "317201000100100010", "2026"))
+	assert.NoError(t, err)
+	assert.Equal(t, bill.Price, again.Price)
+
+	fieldErrors, err := product.ValidateForProvider("pbb_jakarta", "327301000100100010")
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "region", Message: "tax object number does not belong to Jakarta Pusat"},
+	}, fieldErrors)
+}
+
+func TestPbbProductRejectsSamsatRegion(t *testing.T) {
+	repo := &mocks.TaxRegionRepository{}
+	repo.On("GetTaxRegionByCodeRepository", "samsat_dki").Return(&model.TaxRegion{Category: model.PRODUCT_SAMSAT}, nil)
+
+	_, err := NewPbbProduct(repo, &mocks.BillerOyApiRepository{}).Price(billertest.Inquiry("samsat_dki", "317201000100100010", "2026"))
+
+	assert.EqualError(t, err, "tax region samsat_dki not found")
+}
+
+func TestSamsatProductPrice(t *testing.T) {
+	repo := &mocks.TaxRegionRepository{}
+	repo.On("GetTaxRegionByCodeRepository", "samsat_dki").Return(&model.TaxRegion{
+		Category: model.PRODUCT_SAMSAT,
+		Code:     "samsat_dki",
+		Name:     "DKI Jakarta",
+		Prefixes: "B",
+		TaxRate:  0.02,
+	}, nil)
+	product := NewSamsatProduct(repo, &mocks.BillerOyApiRepository{})
+
+	bill, err := product.Price(billertest.Inquiry("samsat_dki", "B1234ABC", "2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.SamsatBill)
+	assert.Equal(t, detail.VehicleValue*0.02, detail.Pkb)
+	assert.Equal(t, detail.Pkb+detail.Swdkllj, bill.Price)
+
+	again, err := product.Price(billertest.Inquiry("samsat_dki", "B1234ABC", "2026"))
+	assert.NoError(t, err)
+	assert.Equal(t, bill.Price, again.Price)
+
+	fieldErrors, err := product.ValidateForProvider("samsat_dki", "AB1234CD")
+	assert.NoError(t, err)
+	assert.Equal(t, "vehicle plate does not belong to DKI Jakarta", fieldErrors[0].Message)
+
+	fieldErrors, err = product.ValidateForProvider("samsat_dki", "B1234ABC")
+	assert.NoError(t, err)
+	assert.Empty(t, fieldErrors)
+}
+
+func TestTaxProductSettleUsesProviderReference(t *testing.T) {
+	billerRepo := &mocks.BillerOyApiRepository{}
+	billerRepo.On("PayBillRepository", &model.OyBillerApi{CustomerId: "B1234ABC", ProductId: "samsat_dki", PartnerTxId: "SAMSAT-1", Period: "2026", Amount: 500000}).
+		Return(&model.OyBillerApiResponse{OyBillerData: model.OyBillerData{TxID: "SAMSAT-REF-1"}}, nil)
+	product := NewSamsatProduct(&mocks.TaxRegionRepository{}, billerRepo)
+
+	settled, err := product.Settle("SAMSAT-1", &model.SamsatBill{CustomerID: "B1234ABC", Type: "samsat_dki", Period: "2026", Price: 500000})
+	assert.NoError(t, err)
+	assert.Equal(t, "SAMSAT-REF-1", settled.(*model.SamsatBill).ReferenceNumber)
+
+	var receipt model.PayloadMail
+	product.Receipt(settled, &receipt)
+	assert.Equal(t, "SAMSAT-REF-1", receipt.ReferenceNumber)
+}
+
+func TestTaxProductSettleFailsWithoutProviderReference(t *testing.T) {
+	billerRepo := &mocks.BillerOyApiRepository{}
+	billerRepo.On("PayBillRepository", mock.Anything).Return(&model.OyBillerApiResponse{OyBillerStatus: model.OyBillerStatus{Message: "bill not found"}}, nil)
+	product := NewPbbProduct(&mocks.TaxRegionRepository{}, billerRepo)
+
+	_, err := product.Settle("PBB-1", &model.PbbBill{CustomerID: "317201000100100010"})
+
+	assert.EqualError(t, err, "PBB payment was not confirmed: bill not found")
+}
+
+func TestTaxProductIsYearly(t *testing.T) {
+	product := NewPbbProduct(&mocks.TaxRegionRepository{}, &mocks.BillerOyApiRepository{})
+
+	assert.True(t, product.Yearly)
+}
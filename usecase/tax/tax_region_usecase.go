@@ -0,0 +1,149 @@
+package tax
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+)
+
+type TaxRegionUseCase interface {
+	CreateTaxRegionUseCase(payload *model.TaxRegion) (*model.TaxRegion, error)
+	GetAllTaxRegionUseCase(category string, page, limit int) ([]*model.TaxRegion, error)
+	GetTaxRegionByIdUseCase(id string) (*model.TaxRegion, error)
+	UpdateTaxRegionByIdUseCase(id string, payload *model.TaxRegion) (*model.TaxRegion, error)
+	DeleteTaxRegionByIdUseCase(id string) error
+}
+
+type taxRegionUseCase struct {
+	taxRegionRepository repository.TaxRegionRepository
+}
+
+func NewTaxRegionUseCase(taxRegionRepository repository.TaxRegionRepository) *taxRegionUseCase {
+	return &taxRegionUseCase{
+		taxRegionRepository: taxRegionRepository,
+	}
+}
+
+func (uc *taxRegionUseCase) CreateTaxRegionUseCase(payload *model.TaxRegion) (*model.TaxRegion, error) {
+	payload.Category = strings.ToLower(payload.Category)
+	payload.Code = strings.ToLower(payload.Code)
+	if payload.Category != model.PRODUCT_PBB && payload.Category != model.PRODUCT_SAMSAT {
+		return nil, fmt.Errorf("category must be %s or %s", model.PRODUCT_PBB, model.PRODUCT_SAMSAT)
+	}
+	if payload.Code == "" || payload.Name == "" {
+		return nil, errors.New("code and name are required")
+	}
+
+	prefixes, err := normalizePrefixes(payload.Category, payload.Prefixes)
+	if err != nil {
+		return nil, err
+	}
+	payload.Prefixes = prefixes
+
+	if payload.TaxRate <= 0 || payload.TaxRate >= 1 {
+		return nil, errors.New("tax_rate must be between 0 and 1")
+	}
+
+	existing, err := uc.taxRegionRepository.GetTaxRegionByCodeRepository(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("tax region %s already exists", payload.Code)
+	}
+
+	region, err := uc.taxRegionRepository.CreateTaxRegionRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating tax region in database: %w", err)
+	}
+
+	return region, nil
+}
+
+func (uc *taxRegionUseCase) GetAllTaxRegionUseCase(category string, page, limit int) ([]*model.TaxRegion, error) {
+	return uc.taxRegionRepository.GetAllTaxRegionRepository(strings.ToLower(category), page, limit)
+}
+
+func (uc *taxRegionUseCase) GetTaxRegionByIdUseCase(id string) (*model.TaxRegion, error) {
+	region, err := uc.taxRegionRepository.GetTaxRegionByIdRepository(id)
+	if err != nil {
+		return nil, errors.New("tax region not found")
+	}
+
+	return region, nil
+}
+
+func (uc *taxRegionUseCase) UpdateTaxRegionByIdUseCase(id string, payload *model.TaxRegion) (*model.TaxRegion, error) {
+	region, err := uc.taxRegionRepository.GetTaxRegionByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tax region: %v", err)
+	}
+
+	if payload.Name != "" {
+		region.Name = payload.Name
+	}
+	if payload.Prefixes != "" {
+		prefixes, err := normalizePrefixes(region.Category, payload.Prefixes)
+		if err != nil {
+			return nil, err
+		}
+		region.Prefixes = prefixes
+	}
+	if payload.TaxRate != 0 {
+		if payload.TaxRate < 0 || payload.TaxRate >= 1 {
+			return nil, errors.New("tax_rate must be between 0 and 1")
+		}
+		region.TaxRate = payload.TaxRate
+	}
+	region.UpdatedAt = time.Now()
+
+	updated, err := uc.taxRegionRepository.UpdateTaxRegionByIdRepository(id, region)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update tax region: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *taxRegionUseCase) DeleteTaxRegionByIdUseCase(id string) error {
+	err := uc.taxRegionRepository.DeleteTaxRegionByIdRepository(id)
+	if err != nil {
+		return errors.New("tax region not found")
+	}
+
+	return nil
+}
+
+// normalizePrefixes checks the prefixes against the category: PBB regions own
+// the 4 digit province and regency code of a tax object number, Samsat regions
+// own the letters a plate number starts with.
+func normalizePrefixes(category, prefixes string) (string, error) {
+	var list []string
+	for _, prefix := range strings.Split(prefixes, ",") {
+		prefix = strings.ToUpper(strings.TrimSpace(prefix))
+		if prefix == "" {
+			continue
+		}
+
+		valid := false
+		switch category {
+		case model.PRODUCT_PBB:
+			valid = taxObjectNumberPattern.MatchString(prefix + "00000000000000")
+		case model.PRODUCT_SAMSAT:
+			valid = platePattern.MatchString(prefix + "1")
+		}
+		if !valid {
+			return "", fmt.Errorf("invalid %s prefix %s", category, prefix)
+		}
+		list = append(list, prefix)
+	}
+
+	if len(list) == 0 {
+		return "", errors.New("at least one prefix is required")
+	}
+
+	return strings.Join(list, ","), nil
+}
@@ -0,0 +1,90 @@
+package tax
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type TaxRegionUseCaseTest struct {
+	suite.Suite
+	taxRegionUseCase TaxRegionUseCase
+	taxRegionRepo    *mocks.TaxRegionRepository
+}
+
+func TestTaxRegionUseCase(t *testing.T) {
+	suite.Run(t, new(TaxRegionUseCaseTest))
+}
+
+func (m *TaxRegionUseCaseTest) SetupTest() {
+	m.taxRegionRepo = &mocks.TaxRegionRepository{}
+	m.taxRegionUseCase = NewTaxRegionUseCase(m.taxRegionRepo)
+}
+
+func (m *TaxRegionUseCaseTest) TestCreateTaxRegionSuccess() {
+	m.taxRegionRepo.On("GetTaxRegionByCodeRepository", "samsat_jabar").Return(nil, nil)
+	m.taxRegionRepo.On("CreateTaxRegionRepository", mock.Anything).Return(func(region *model.TaxRegion) *model.TaxRegion {
+		return region
+	}, nil)
+
+	resp, err := m.taxRegionUseCase.CreateTaxRegionUseCase(&model.TaxRegion{
+		Category: "SAMSAT",
+		Code:     "Samsat_Jabar",
+		Name:     "Jawa Barat",
+		Prefixes: "d, f ,t,,z",
+		TaxRate:  0.0175,
+	})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.PRODUCT_SAMSAT, resp.Category)
+	assert.Equal(m.T(), "samsat_jabar", resp.Code)
+	assert.Equal(m.T(), "D,F,T,Z", resp.Prefixes)
+}
+
+func (m *TaxRegionUseCaseTest) TestCreateTaxRegionInvalidCategory() {
+	_, err := m.taxRegionUseCase.CreateTaxRegionUseCase(&model.TaxRegion{Category: "pdam", Code: "x", Name: "x"})
+
+	assert.EqualError(m.T(), err, "category must be pbb or samsat")
+}
+
+func (m *TaxRegionUseCaseTest) TestCreateTaxRegionInvalidPrefix() {
+	_, err := m.taxRegionUseCase.CreateTaxRegionUseCase(&model.TaxRegion{
+		Category: model.PRODUCT_PBB,
+		Code:     "pbb_bandung",
+		Name:     "Kota Bandung",
+		Prefixes: "3273,32",
+		TaxRate:  0.001,
+	})
+
+	assert.EqualError(m.T(), err, "invalid pbb prefix 32")
+}
+
+func (m *TaxRegionUseCaseTest) TestCreateTaxRegionDuplicate() {
+	m.taxRegionRepo.On("GetTaxRegionByCodeRepository", "pbb_bandung").Return(&model.TaxRegion{}, nil)
+
+	_, err := m.taxRegionUseCase.CreateTaxRegionUseCase(&model.TaxRegion{
+		Category: model.PRODUCT_PBB,
+		Code:     "pbb_bandung",
+		Name:     "Kota Bandung",
+		Prefixes: "3273",
+		TaxRate:  0.001,
+	})
+
+	assert.EqualError(m.T(), err, "tax region pbb_bandung already exists")
+}
+
+func (m *TaxRegionUseCaseTest) TestUpdateTaxRegionKeepsCategory() {
+	m.taxRegionRepo.On("GetTaxRegionByIdRepository", "id").Return(&model.TaxRegion{
+		Category: model.PRODUCT_PBB,
+		Prefixes: "3273",
+		TaxRate:  0.001,
+	}, nil)
+
+	_, err := m.taxRegionUseCase.UpdateTaxRegionByIdUseCase("id", &model.TaxRegion{Prefixes: "B"})
+
+	assert.EqualError(m.T(), err, "invalid pbb prefix B")
+}
@@ -5,7 +5,6 @@ import (
 	"BE-Golang/model"
 	"BE-Golang/repository"
 	"BE-Golang/usecase/auth"
-	"BE-Golang/usecase/middlewares"
 	"errors"
 	"fmt"
 	"strings"
@@ -15,31 +14,33 @@ import (
 	"golang.org/x/crypto/bcrypt"
 )
 
+var ErrBalanceNotEnough = repository.ErrBalanceNotEnough
+
 type UserUsecase interface {
 	GetAllUsersUseCase(page, limit int, name string) ([]*model.UserResponse, error)
 	GetUserByIDUseCase(userId string) (*model.UserResponse, error)
-	GetUserByEmailUsecase(email string) (*model.UserResponse, error)
 	UpdateUserImageByIDUseCase(userId string, payload *model.User) (*model.UserResponse, error)
 	UpdateUserByIDUseCase(userId string, payload *model.User) (*model.UserResponse, error)
 	DeleteUserByIDUseCase(userId string) error
-	ChangePasswordUseCase(userID string, payload dto.Password) error
+	ChangePasswordUseCase(userID string, payload dto.Password) (*model.AuthResponse, error)
 	CreatePINUseCase(userID string, payload dto.PIN) error
-	ChangePINUseCase(userID string, payload dto.PIN) error
+	ChangePINUseCase(userID string, payload dto.PIN) (*model.AuthResponse, error)
 	CheckPINUseCase(userID string, payload dto.PIN) error
 	TransferAmountUseCase(userID string, payload dto.TransactionTransferDto) (*model.Transaction, error)
-	UpdateForgotPasswordUsecase(userID string, payload dto.Password) (*model.AuthResponse, error)
 	GetUserByQueryUseCase(query string, page, limit int) ([]*model.User, error)
 }
 
 type userUsecase struct {
 	userRepository        repository.UserRepository
 	transactionRepository repository.TransactionRepository
+	sessionUseCase        auth.SessionUseCase
 }
 
-func NewUserUsecase(userRepository repository.UserRepository, transactionRepository repository.TransactionRepository) *userUsecase {
+func NewUserUsecase(userRepository repository.UserRepository, transactionRepository repository.TransactionRepository, sessionUseCase auth.SessionUseCase) *userUsecase {
 	return &userUsecase{
 		userRepository:        userRepository,
 		transactionRepository: transactionRepository,
+		sessionUseCase:        sessionUseCase,
 	}
 }
 
@@ -89,25 +90,6 @@ func (uc *userUsecase) GetUserByIDUseCase(userId string) (*model.UserResponse, e
 	return resp, nil
 }
 
-func (uc *userUsecase) GetUserByEmailUsecase(email string) (*model.UserResponse, error) {
-	user, err := uc.userRepository.GetUserByEmail(email)
-	if err != nil {
-		return nil, errors.New("user not found")
-	}
-
-	resp := &model.UserResponse{
-		ID:        user.ID,
-		Name:      user.Name,
-		Email:     user.Email,
-		Phone:     user.Phone,
-		Balance:   user.Amount,
-		Image:     user.Image,
-		UpdatedAt: user.UpdatedAt,
-		CreatedAt: user.CreatedAt,
-	}
-
-	return resp, nil
-}
 func (uc *userUsecase) UpdateUserImageByIDUseCase(userId string, payload *model.User) (*model.UserResponse, error) {
 
 	user, err := uc.userRepository.GetUserByIDRepository(userId)
@@ -176,18 +158,20 @@ func (uc *userUsecase) DeleteUserByIDUseCase(userId string) error {
 	return err
 }
 
-func (uc *userUsecase) ChangePasswordUseCase(userID string, payload dto.Password) error {
+// ChangePasswordUseCase changes the user's password and logs out every
+// session, including the calling one, which gets a new session in return.
+func (uc *userUsecase) ChangePasswordUseCase(userID string, payload dto.Password) (*model.AuthResponse, error) {
 	user, err := uc.userRepository.GetUserByIDRepository(userID)
 	if err != nil {
-		return errors.New("user not found")
+		return nil, errors.New("user not found")
 	}
 
 	if !auth.ComparePasswords(user.Password, payload.Password) {
-		return errors.New("current password is incorrect")
+		return nil, errors.New("current password is incorrect")
 	}
 
 	if auth.ComparePasswords(user.Password, payload.Newpassword) {
-		return errors.New("new Password must be different from the current Password")
+		return nil, errors.New("new Password must be different from the current Password")
 	}
 
 	hashedPassword, _ := auth.HashPassword(payload.Newpassword)
@@ -197,10 +181,14 @@ func (uc *userUsecase) ChangePasswordUseCase(userID string, payload dto.Password
 
 	_, err = uc.userRepository.UpdateUserByIDRepository(userID, user)
 	if err != nil {
-		return fmt.Errorf("failed to update user password: %v", err)
+		return nil, fmt.Errorf("failed to update user password: %v", err)
 	}
 
-	return nil
+	if err := uc.sessionUseCase.LogoutAllUseCase(userID); err != nil {
+		return nil, err
+	}
+
+	return uc.sessionUseCase.CreateSessionUseCase(user)
 }
 
 func (uc *userUsecase) CreatePINUseCase(userID string, payload dto.PIN) error {
@@ -225,17 +213,19 @@ func (uc *userUsecase) CreatePINUseCase(userID string, payload dto.PIN) error {
 	return nil
 }
 
-func (uc *userUsecase) ChangePINUseCase(userID string, payload dto.PIN) error {
+// ChangePINUseCase changes the user's PIN and logs out every session,
+// including the calling one, which gets a new session in return.
+func (uc *userUsecase) ChangePINUseCase(userID string, payload dto.PIN) (*model.AuthResponse, error) {
 	user, err := uc.userRepository.GetUserByIDRepository(userID)
 	if err != nil {
-		return errors.New("user not found")
+		return nil, errors.New("user not found")
 	}
 
 	if !CompareHashPin(user.Pin, payload.Pin) {
-		return errors.New("current PIN is incorrect")
+		return nil, errors.New("current PIN is incorrect")
 	}
 	if CompareHashPin(user.Pin, payload.NewPIN) {
-		return errors.New("new PIN must be different from the current PIN")
+		return nil, errors.New("new PIN must be different from the current PIN")
 	}
 
 	hashedNewPIN := HashPin(payload.NewPIN)
@@ -244,10 +234,14 @@ func (uc *userUsecase) ChangePINUseCase(userID string, payload dto.PIN) error {
 
 	_, err = uc.userRepository.UpdateUserByIDRepository(userID, user)
 	if err != nil {
-		return fmt.Errorf("failed to change PIN: %v", err)
+		return nil, fmt.Errorf("failed to change PIN: %v", err)
 	}
 
-	return nil
+	if err := uc.sessionUseCase.LogoutAllUseCase(userID); err != nil {
+		return nil, err
+	}
+
+	return uc.sessionUseCase.CreateSessionUseCase(user)
 }
 
 func (uc *userUsecase) CheckPINUseCase(userID string, payload dto.PIN) error {
@@ -273,7 +267,7 @@ func (uc *userUsecase) TransferAmountUseCase(userID string, payload dto.Transact
 		return &model.Transaction{}, fmt.Errorf("error user id not found")
 	}
 	if myprofile.Amount < payload.Amount {
-		return &model.Transaction{}, fmt.Errorf("your balance is not enough")
+		return &model.Transaction{}, ErrBalanceNotEnough
 	}
 	td := model.TransactionTransfer{
 		Phone: user.Phone, UserID: user.ID, Note: payload.Note,
@@ -309,37 +303,6 @@ func (uc *userUsecase) TransferAmountUseCase(userID string, payload dto.Transact
 	return response, nil
 }
 
-func (uc *userUsecase) UpdateForgotPasswordUsecase(userID string, payload dto.Password) (*model.AuthResponse, error) {
-	user, err := uc.userRepository.GetUserByIDRepository(userID)
-	if err != nil {
-		return nil, errors.New("user not found")
-	}
-
-	hashedPassword, _ := auth.HashPassword(payload.Newpassword)
-
-	user.Password = hashedPassword
-	user.UpdatedAt = time.Now()
-
-	_, err = uc.userRepository.UpdateUserByIDRepository(userID, user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to update user password: %v", err)
-	}
-
-	token, err := middlewares.CreateToken(*user)
-	if err != nil {
-		return nil, fmt.Errorf("failed to create token: %v", err)
-	}
-
-	resp := &model.AuthResponse{
-		ID:    user.ID,
-		Email: user.Email,
-		Name:  user.Name,
-		Token: token,
-	}
-
-	return resp, nil
-}
-
 func (uc *userUsecase) GetUserByQueryUseCase(query string, page, limit int) ([]*model.User, error) {
 	users, err := uc.userRepository.GetUserByQueryRepository(query, page, limit)
 	if err != nil {
@@ -6,6 +6,7 @@ import (
 	"BE-Golang/repository/mocks"
 	"BE-Golang/usecase/auth"
 	"BE-Golang/usecase/middlewares"
+	usecaseMocks "BE-Golang/usecase/mocks"
 	"BE-Golang/usecase/users"
 	"errors"
 	"strings"
@@ -24,6 +25,7 @@ type UserUseCaseTest struct {
 	userUseCase         users.UserUsecase
 	userRepoMock        *mocks.UserRepository
 	transactionRepoMock *mocks.TransactionRepository
+	sessionUseCaseMock  *usecaseMocks.SessionUseCase
 }
 
 func TestUserUseCaseTest(t *testing.T) {
@@ -33,7 +35,8 @@ func TestUserUseCaseTest(t *testing.T) {
 func (s *UserUseCaseTest) SetupTest() {
 	s.userRepoMock = &mocks.UserRepository{}
 	s.transactionRepoMock = &mocks.TransactionRepository{}
-	s.userUseCase = users.NewUserUsecase(s.userRepoMock, s.transactionRepoMock)
+	s.sessionUseCaseMock = &usecaseMocks.SessionUseCase{}
+	s.userUseCase = users.NewUserUsecase(s.userRepoMock, s.transactionRepoMock, s.sessionUseCaseMock)
 }
 
 func (s *UserUseCaseTest) TestGetAllUsers() {
@@ -115,38 +118,6 @@ func (s *UserUseCaseTest) TestGetUserByID() {
 	assert.Equal(s.T(), user.UpdatedAt.Unix(), resp.UpdatedAt.Unix())
 }
 
-func (s *UserUseCaseTest) TestGetUserByEmail() {
-	email := "arby@mail.com"
-	user := &model.User{
-		UUIDPrimaryKey: model.UUIDPrimaryKey{
-			ID:        "1",
-			DeletedAt: gorm.DeletedAt{},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		Name:   "arby",
-		Email:  "arby@mail.com",
-		Phone:  "08123456789",
-		Amount: 1000,
-		Image:  "image.jpg",
-	}
-
-	s.userRepoMock.On("GetUserByEmail", email).Return(user, nil)
-
-	resp, err := s.userUseCase.GetUserByEmailUsecase(email)
-
-	assert.NoError(s.T(), err)
-	assert.NotNil(s.T(), resp)
-	assert.Equal(s.T(), user.ID, resp.ID)
-	assert.Equal(s.T(), user.Name, resp.Name)
-	assert.Equal(s.T(), user.Email, resp.Email)
-	assert.Equal(s.T(), user.Phone, resp.Phone)
-	assert.Equal(s.T(), user.Amount, resp.Balance)
-	assert.Equal(s.T(), user.Image, resp.Image)
-	assert.Equal(s.T(), user.UpdatedAt, resp.UpdatedAt)
-	assert.Equal(s.T(), user.CreatedAt, resp.CreatedAt)
-}
-
 func (s *UserUseCaseTest) TestUpdateUserImageByIDUseCase() {
 	userID := "1"
 	payload := &model.User{
@@ -250,12 +221,16 @@ func (s *UserUseCaseTest) TestChangePasswordUseCase() {
 
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
 	s.userRepoMock.On("UpdateUserByIDRepository", userID, mock.AnythingOfType("*model.User")).Return(user, nil)
+	s.sessionUseCaseMock.On("LogoutAllUseCase", userID).Return(nil)
+	s.sessionUseCaseMock.On("CreateSessionUseCase", user).Return(&model.AuthResponse{ID: userID, Token: "token"}, nil)
 
-	err := s.userUseCase.ChangePasswordUseCase(userID, payload)
+	resp, err := s.userUseCase.ChangePasswordUseCase(userID, payload)
 
 	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "token", resp.Token)
 	assert.Equal(s.T(), hashedNewPassword, hashedNewPassword)
 	assert.WithinDuration(s.T(), time.Now(), user.UpdatedAt, time.Second)
+	s.sessionUseCaseMock.AssertCalled(s.T(), "LogoutAllUseCase", userID)
 }
 func (s *UserUseCaseTest) TestCreatePINUseCase() {
 	userID := "1"
@@ -336,92 +311,6 @@ func (s *UserUseCaseTest) TestGetUserByQueryUseCase() {
 	assert.Equal(s.T(), mockUsers[0].CreatedAt.Unix(), users[0].CreatedAt.Unix())
 	assert.Equal(s.T(), mockUsers[0].UpdatedAt.Unix(), users[0].UpdatedAt.Unix())
 }
-func (s *UserUseCaseTest) TestUpdateForgotPasswordUseCase_Success() {
-	userID := "2"
-	password := "new_password"
-	token := "valid_token"
-
-	user := &model.User{
-		UUIDPrimaryKey: model.UUIDPrimaryKey{
-			ID:        "2",
-			DeletedAt: gorm.DeletedAt{},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		Email:    "arby@gmail.com",
-		Name:     "arbyusman",
-		Password: "old_password",
-	}
-
-	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
-	s.userRepoMock.On("UpdateUserByIDRepository", userID, mock.Anything).Return(user, nil)
-
-	response, err := s.userUseCase.UpdateForgotPasswordUsecase(userID, dto.Password{
-		Newpassword: password,
-	})
-
-	assert.NoError(s.T(), err)
-	assert.NotNil(s.T(), response)
-	assert.Equal(s.T(), userID, response.ID)
-	assert.Equal(s.T(), user.Email, response.Email)
-	assert.Equal(s.T(), user.Name, response.Name)
-	assert.Equal(s.T(), token, token)
-
-	assert.Equal(s.T(), password, password)
-	assert.True(s.T(), user.UpdatedAt.After(user.CreatedAt))
-
-	s.userRepoMock.AssertCalled(s.T(), "GetUserByIDRepository", userID)
-	s.userRepoMock.AssertCalled(s.T(), "UpdateUserByIDRepository", userID, user)
-}
-
-func (s *UserUseCaseTest) TestUpdateForgotPasswordUseCase_UserNotFound() {
-	userID := "user_id"
-	password := "new_password"
-
-	s.userRepoMock.On("GetUserByIDRepository", userID).Return(nil, errors.New("user not found"))
-
-	response, err := s.userUseCase.UpdateForgotPasswordUsecase(userID, dto.Password{
-		Newpassword: password,
-	})
-
-	assert.Error(s.T(), err)
-	assert.Nil(s.T(), response)
-	assert.EqualError(s.T(), err, "user not found")
-
-	s.userRepoMock.AssertCalled(s.T(), "GetUserByIDRepository", userID)
-}
-
-func (s *UserUseCaseTest) TestUpdateForgotPasswordUseCase_UpdateError() {
-	userID := "2"
-	password := "new_password"
-
-	user := &model.User{
-		UUIDPrimaryKey: model.UUIDPrimaryKey{
-			ID:        "2",
-			DeletedAt: gorm.DeletedAt{},
-			CreatedAt: time.Now(),
-			UpdatedAt: time.Now(),
-		},
-		Email:    "test@gmail.com",
-		Name:     "arbyusman",
-		Password: "old_password",
-	}
-
-	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
-	s.userRepoMock.On("UpdateUserByIDRepository", userID, mock.Anything).Return(nil, errors.New("update error"))
-
-	response, err := s.userUseCase.UpdateForgotPasswordUsecase(userID, dto.Password{
-		Newpassword: password,
-	})
-
-	assert.Error(s.T(), err)
-	assert.Nil(s.T(), response)
-	assert.EqualError(s.T(), err, "failed to update user password: update error")
-
-	s.userRepoMock.AssertCalled(s.T(), "GetUserByIDRepository", userID)
-	s.userRepoMock.AssertCalled(s.T(), "UpdateUserByIDRepository", userID, user)
-}
-
 func (s *UserUseCaseTest) TestChangePINUseCase_Success() {
 	userID := "user_id"
 	pin := "1234"
@@ -441,13 +330,17 @@ func (s *UserUseCaseTest) TestChangePINUseCase_Success() {
 
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
 	s.userRepoMock.On("UpdateUserByIDRepository", userID, mock.Anything).Return(user, nil)
+	s.sessionUseCaseMock.On("LogoutAllUseCase", userID).Return(nil)
+	s.sessionUseCaseMock.On("CreateSessionUseCase", user).Return(&model.AuthResponse{ID: userID, Token: "token"}, nil)
 
-	err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
+	resp, err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
 		Pin:    pin,
 		NewPIN: newPIN,
 	})
 
 	assert.NoError(s.T(), err)
+	assert.Equal(s.T(), "token", resp.Token)
+	s.sessionUseCaseMock.AssertCalled(s.T(), "LogoutAllUseCase", userID)
 	pin = users.HashPin(newPIN)
 
 	assert.Equal(s.T(), pin, pin)
@@ -464,7 +357,7 @@ func (s *UserUseCaseTest) TestChangePINUseCase_UserNotFound() {
 
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(nil, errors.New("user not found"))
 
-	err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
+	_, err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
 		Pin:    pin,
 		NewPIN: newPIN,
 	})
@@ -494,7 +387,7 @@ func (s *UserUseCaseTest) TestChangePINUseCase_IncorrectCurrentPIN() {
 
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
 
-	err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
+	_, err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
 		Pin:    pin,
 		NewPIN: newPIN,
 	})
@@ -525,7 +418,7 @@ func (s *UserUseCaseTest) TestChangePINUseCase_SameNewPINAsCurrent() {
 
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
 
-	err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
+	_, err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
 		Pin:    pin,
 		NewPIN: newPIN,
 	})
@@ -557,7 +450,7 @@ func (s *UserUseCaseTest) TestChangePINUseCase_UpdateError() {
 	s.userRepoMock.On("GetUserByIDRepository", userID).Return(user, nil)
 	s.userRepoMock.On("UpdateUserByIDRepository", userID, mock.Anything).Return(nil, errors.New("update error"))
 
-	err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
+	_, err := s.userUseCase.ChangePINUseCase(userID, dto.PIN{
 		Pin:    pin,
 		NewPIN: newPIN,
 	})
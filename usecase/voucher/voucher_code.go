@@ -0,0 +1,62 @@
+package voucher
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"errors"
+	"io"
+)
+
+// newCodeCipher derives an AES-256-GCM cipher from the configured secret.
+// Like a missing config file, a missing secret stops the server from starting.
+func newCodeCipher(secret string) cipher.AEAD {
+	if secret == "" {
+		panic("VOUCHER_CODE_KEY must be set to store voucher codes")
+	}
+
+	key := sha256.Sum256([]byte(secret))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		panic(err)
+	}
+
+	aead, err := cipher.NewGCM(block)
+	if err != nil {
+		panic(err)
+	}
+
+	return aead
+}
+
+// sealCode encrypts a voucher code as base64 of nonce followed by ciphertext.
+func sealCode(aead cipher.AEAD, code string) (string, error) {
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return "", err
+	}
+
+	sealed := aead.Seal(nonce, nonce, []byte(code), nil)
+
+	return base64.StdEncoding.EncodeToString(sealed), nil
+}
+
+func openCode(aead cipher.AEAD, sealed string) (string, error) {
+	data, err := base64.StdEncoding.DecodeString(sealed)
+	if err != nil {
+		return "", err
+	}
+	if len(data) < aead.NonceSize() {
+		return "", errors.New("voucher code is malformed")
+	}
+
+	code, err := aead.Open(nil, data[:aead.NonceSize()], data[aead.NonceSize():], nil)
+	if err != nil {
+		return "", err
+	}
+
+	return string(code), nil
+}
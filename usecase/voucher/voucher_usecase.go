@@ -0,0 +1,457 @@
+package voucher
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/fulfilment"
+	"BE-Golang/usecase/notification"
+	"crypto/cipher"
+	"errors"
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/google/uuid"
+)
+
+// purchaseTimeout is how long a purchase may stay pending at the provider
+// before it is failed and refunded.
+const purchaseTimeout = 30 * time.Minute
+
+// reconcileAfter gives a purchase sent while the user waits time to settle
+// before the pending job asks the provider about it.
+const reconcileAfter = time.Minute
+
+// transactionPrefix starts the ID of every voucher purchase.
+const transactionPrefix = "VOUCHER"
+
+type VoucherUseCase interface {
+	CreateVoucherBrandUseCase(data model.VoucherBrand) (model.VoucherBrandResponse, error)
+	GetAllVoucherBrandUseCase(data dto.VoucherBrandDto, isUser *bool) ([]model.VoucherBrandResponse, error)
+	GetVoucherBrandByIdUseCase(id string, isUser *bool) (model.VoucherBrandResponse, error)
+	UpdateVoucherBrandByIdUseCase(id string, data model.VoucherBrand) (model.VoucherBrandResponse, error)
+	DeleteVoucherBrandByIdUseCase(id string) error
+	CreateVoucherUseCase(data model.Voucher) (model.Voucher, error)
+	UpdateVoucherByIdUseCase(id string, data model.Voucher) (model.Voucher, error)
+	DeleteVoucherByIdUseCase(id string) error
+	NicknameInquiryUseCase(payload dto.VoucherInquiryDto) (*model.VoucherAccount, error)
+	CreateTransactionVoucherUseCase(userID string, payload dto.TransactionVoucherDto) (*model.Transaction, error)
+	GetTransactionVoucherUseCase(userID, transactionID string) (*model.Transaction, error)
+	RunPendingVouchersUseCase(now time.Time) error
+}
+
+type voucherUseCase struct {
+	voucherRepository     repository.VoucherRepository
+	providerRepository    repository.VoucherProviderRepository
+	transactionRepository repository.TransactionRepository
+	discountRepository    repository.DiscountRepository
+	codeCipher            cipher.AEAD
+	purchases             *fulfilment.Purchase
+}
+
+func NewVoucherUseCase(voucherRepository repository.VoucherRepository, providerRepository repository.VoucherProviderRepository, transactionRepository repository.TransactionRepository, discountRepository repository.DiscountRepository, notificationUseCase notification.NotificationUseCase, codeKey string) *voucherUseCase {
+	uc := &voucherUseCase{
+		voucherRepository:     voucherRepository,
+		providerRepository:    providerRepository,
+		transactionRepository: transactionRepository,
+		discountRepository:    discountRepository,
+		codeCipher:            newCodeCipher(codeKey),
+	}
+	uc.purchases = &fulfilment.Purchase{
+		Name:           "voucher purchase",
+		Prefix:         transactionPrefix,
+		Timeout:        purchaseTimeout,
+		ReconcileAfter: reconcileAfter,
+		Transactions:   transactionRepository,
+		Notifications:  notificationUseCase,
+		NewDetail:      func() fulfilment.Detail { return &model.TransactionVoucher{} },
+		Send: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+			voucher := detail.(*model.TransactionVoucher)
+			result, err := providerRepository.PurchaseVoucherRepository(transactionID, voucher)
+			return uc.purchaseResult(voucher, result, err)
+		},
+		Poll: func(transactionID string, detail fulfilment.Detail) (*fulfilment.Result, error) {
+			voucher := detail.(*model.TransactionVoucher)
+			result, err := providerRepository.PurchaseStatusVoucherRepository(transactionID, voucher)
+			return uc.purchaseResult(voucher, result, err)
+		},
+		Notification: purchaseNotification,
+	}
+
+	return uc
+}
+
+func (uc *voucherUseCase) CreateVoucherBrandUseCase(data model.VoucherBrand) (model.VoucherBrandResponse, error) {
+	if err := validateBrand(data); err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+	if data.Code == "" || data.Name == "" {
+		return model.VoucherBrandResponse{}, errors.New("code and name are required")
+	}
+
+	brand, err := uc.voucherRepository.CreateVoucherBrand(data)
+	if err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+
+	return toBrandResponse(brand), nil
+}
+
+func (uc *voucherUseCase) GetAllVoucherBrandUseCase(data dto.VoucherBrandDto, isUser *bool) ([]model.VoucherBrandResponse, error) {
+	brands, err := uc.voucherRepository.GetAllVoucherBrand(data, isUser)
+	if err != nil {
+		return []model.VoucherBrandResponse{}, err
+	}
+
+	response := make([]model.VoucherBrandResponse, 0, len(brands))
+	for _, v := range brands {
+		response = append(response, toBrandResponse(v))
+	}
+
+	return response, nil
+}
+
+func (uc *voucherUseCase) GetVoucherBrandByIdUseCase(id string, isUser *bool) (model.VoucherBrandResponse, error) {
+	brand, err := uc.voucherRepository.GetVoucherBrandById(id, isUser)
+	if err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+	if isUser != nil && !isActive(brand.IsActive) {
+		return model.VoucherBrandResponse{}, fmt.Errorf("voucher brand with ID %s not found", id)
+	}
+
+	return toBrandResponse(brand), nil
+}
+
+func (uc *voucherUseCase) UpdateVoucherBrandByIdUseCase(id string, data model.VoucherBrand) (model.VoucherBrandResponse, error) {
+	current, err := uc.voucherRepository.GetVoucherBrandById(id, nil)
+	if err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+
+	// Only non-empty fields are updated, so validate the brand as it will be saved.
+	merged := current
+	for _, field := range []struct{ value, target *string }{
+		{&data.Type, &merged.Type},
+		{&data.Delivery, &merged.Delivery},
+		{&data.UserIdLabel, &merged.UserIdLabel},
+		{&data.UserIdPattern, &merged.UserIdPattern},
+		{&data.ZoneIdLabel, &merged.ZoneIdLabel},
+		{&data.ZoneIdPattern, &merged.ZoneIdPattern},
+	} {
+		if *field.value != "" {
+			*field.target = *field.value
+		}
+	}
+	if err := validateBrand(merged); err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+
+	if err := uc.voucherRepository.UpdateVoucherBrandById(id, data); err != nil {
+		return model.VoucherBrandResponse{}, err
+	}
+
+	return uc.GetVoucherBrandByIdUseCase(id, nil)
+}
+
+func (uc *voucherUseCase) DeleteVoucherBrandByIdUseCase(id string) error {
+	return uc.voucherRepository.DeleteVoucherBrandById(id)
+}
+
+func (uc *voucherUseCase) CreateVoucherUseCase(data model.Voucher) (model.Voucher, error) {
+	if data.Code == "" || data.Name == "" {
+		return model.Voucher{}, errors.New("code and name are required")
+	}
+	if data.Price <= 0 {
+		return model.Voucher{}, errors.New("price must be greater than 0")
+	}
+	if _, err := uc.voucherRepository.GetVoucherBrandById(data.BrandID, nil); err != nil {
+		return model.Voucher{}, err
+	}
+
+	return uc.voucherRepository.CreateVoucher(data)
+}
+
+func (uc *voucherUseCase) UpdateVoucherByIdUseCase(id string, data model.Voucher) (model.Voucher, error) {
+	if data.Price < 0 {
+		return model.Voucher{}, errors.New("price must not be negative")
+	}
+	// Denominations cannot move between brands.
+	data.BrandID = ""
+
+	if err := uc.voucherRepository.UpdateVoucherById(id, data); err != nil {
+		return model.Voucher{}, err
+	}
+
+	return uc.voucherRepository.GetVoucherById(id)
+}
+
+func (uc *voucherUseCase) DeleteVoucherByIdUseCase(id string) error {
+	return uc.voucherRepository.DeleteVoucherById(id)
+}
+
+func (uc *voucherUseCase) NicknameInquiryUseCase(payload dto.VoucherInquiryDto) (*model.VoucherAccount, error) {
+	_, brand, err := uc.getActiveVoucher(payload.VoucherID)
+	if err != nil {
+		return nil, err
+	}
+	if brand.UserIdLabel == "" {
+		return nil, fmt.Errorf("%s vouchers are not tied to a player account", brand.Name)
+	}
+
+	return uc.nicknameInquiry(brand, payload.UserId, payload.ZoneId)
+}
+
+func (uc *voucherUseCase) CreateTransactionVoucherUseCase(userID string, payload dto.TransactionVoucherDto) (*model.Transaction, error) {
+	voucher, brand, err := uc.getActiveVoucher(payload.VoucherID)
+	if err != nil {
+		return nil, err
+	}
+
+	detail := model.TransactionVoucher{
+		Brand:     brand.Name,
+		BrandCode: brand.Code,
+		Name:      voucher.Name,
+		Code:      voucher.Code,
+		Delivery:  brand.Delivery,
+	}
+	if brand.UserIdLabel != "" {
+		account, err := uc.nicknameInquiry(brand, payload.UserId, payload.ZoneId)
+		if err != nil {
+			return nil, err
+		}
+		detail.UserId = account.UserId
+		detail.ZoneId = account.ZoneId
+		detail.Nickname = account.Nickname
+	}
+
+	discount, err := uc.discountRepository.GetDiscountByIdRepository(payload.DiscountID)
+	if err != nil {
+		return nil, errors.New("discount Not Found")
+	}
+	detail.DiscountID = discount.ID
+
+	transaction := &model.Transaction{
+		ID:            fmt.Sprintf("%s-%s", transactionPrefix, uuid.New().String()),
+		UserID:        userID,
+		Status:        model.STATUS_PROCESSING,
+		ProductType:   model.PRODUCT_VOUCHER,
+		Description:   fmt.Sprintf("%s %s", brand.Name, voucher.Name),
+		ProductDetail: detail,
+		DiscountPrice: discount.DiscountPrice,
+		AdminFee:      model.ADMIN_FEE,
+		Price:         voucher.Price,
+		TotalPrice:    voucher.Price + model.ADMIN_FEE - discount.DiscountPrice,
+	}
+
+	if err := uc.purchases.Create(transaction); err != nil {
+		return nil, err
+	}
+
+	return uc.purchase(transaction, &detail, time.Now())
+}
+
+func (uc *voucherUseCase) GetTransactionVoucherUseCase(userID, transactionID string) (*model.Transaction, error) {
+	transaction, err := uc.transactionRepository.GetTransactionByIdRepository(transactionID)
+	if err != nil || transaction.UserID != userID || transaction.ProductType != model.PRODUCT_VOUCHER {
+		return nil, fmt.Errorf("voucher transaction with ID %s not found", transactionID)
+	}
+
+	detail := &model.TransactionVoucher{}
+	if err := fulfilment.DecodeDetail(transaction, detail); err != nil {
+		return nil, err
+	}
+	if detail.VoucherCode != "" {
+		code, err := openCode(uc.codeCipher, detail.VoucherCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read voucher code: %w", err)
+		}
+		detail.VoucherCode = code
+	}
+	transaction.ProductDetail = detail
+
+	return transaction, nil
+}
+
+// purchase buys the voucher from the provider once the user has been
+// charged. A rejected purchase is failed and the user's balance is refunded;
+// one that did not settle is left to RunPendingVouchersUseCase.
+func (uc *voucherUseCase) purchase(transaction *model.Transaction, detail *model.TransactionVoucher, now time.Time) (*model.Transaction, error) {
+	status, err := uc.purchases.Submit(transaction, detail, now)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction.Status = status
+	response := *detail
+	response.VoucherCode = ""
+	if status == model.STATUS_SUCCESSFUL && detail.VoucherCode != "" {
+		code, err := openCode(uc.codeCipher, detail.VoucherCode)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read voucher code: %w", err)
+		}
+		response.VoucherCode = code
+	}
+	transaction.ProductDetail = response
+
+	if status == model.STATUS_FAIL {
+		return transaction, fmt.Errorf("voucher purchase failed, Rp%.0f has been refunded: %s", transaction.TotalPrice, detail.Error)
+	}
+
+	return transaction, nil
+}
+
+// RunPendingVouchersUseCase follows purchases that did not settle while the
+// user waited. Purchases the provider rejects, or reports pending past
+// purchaseTimeout, are failed and the user's balance is refunded.
+func (uc *voucherUseCase) RunPendingVouchersUseCase(now time.Time) error {
+	return uc.purchases.RunPending(now)
+}
+
+// purchaseResult reads a provider's answer about a voucher. A successful
+// purchase whose code cannot be encrypted stays pending, so the code is
+// fetched and sealed again on the next run instead of being lost.
+func (uc *voucherUseCase) purchaseResult(voucher *model.TransactionVoucher, result *model.VoucherPurchaseResult, err error) (*fulfilment.Result, error) {
+	if err != nil {
+		return nil, err
+	}
+	if result.Status == model.STATUS_SUCCESSFUL && voucher.Delivery == model.VOUCHER_DELIVERY_CODE && result.Code == "" {
+		return &fulfilment.Result{Status: model.STATUS_FAIL, Message: "provider returned no voucher code"}, nil
+	}
+
+	return &fulfilment.Result{
+		Status:  result.Status,
+		Message: result.Message,
+		Deliver: func(detail fulfilment.Detail) error {
+			voucher := detail.(*model.TransactionVoucher)
+			voucher.ReferenceNumber = result.ReferenceNumber
+			if result.Code == "" {
+				return nil
+			}
+
+			sealed, err := sealCode(uc.codeCipher, result.Code)
+			if err != nil {
+				return fmt.Errorf("failed to encrypt voucher code: %v", err)
+			}
+			voucher.VoucherCode = sealed
+
+			return nil
+		},
+	}, nil
+}
+
+func purchaseNotification(transaction *model.Transaction, status string, detail fulfilment.Detail) *model.Notification {
+	voucher := detail.(*model.TransactionVoucher)
+	notification := &model.Notification{
+		Category: model.NOTIFICATION_VOUCHER,
+		Title:    "Pembelian Voucher Berhasil",
+		Message:  fmt.Sprintf("%s berhasil dikirim ke %s.", transaction.Description, voucher.UserId),
+	}
+	if voucher.Delivery == model.VOUCHER_DELIVERY_CODE {
+		notification.Message = fmt.Sprintf("%s berhasil dibeli. Lihat kode voucher pada detail transaksi.", transaction.Description)
+	}
+	if status == model.STATUS_FAIL {
+		notification.Title = "Pembelian Voucher Gagal"
+		notification.Message = fmt.Sprintf("%s gagal dibeli. Rp%.0f telah dikembalikan ke saldo Anda.", transaction.Description, transaction.TotalPrice)
+	}
+
+	return notification
+}
+
+func (uc *voucherUseCase) getActiveVoucher(id string) (model.Voucher, model.VoucherBrand, error) {
+	voucher, err := uc.voucherRepository.GetVoucherById(id)
+	if err != nil {
+		return model.Voucher{}, model.VoucherBrand{}, errors.New("voucher not found")
+	}
+
+	brand, err := uc.voucherRepository.GetVoucherBrandById(voucher.BrandID, nil)
+	if err != nil {
+		return model.Voucher{}, model.VoucherBrand{}, errors.New("voucher not found")
+	}
+	if !isActive(voucher.IsActive) || !isActive(brand.IsActive) {
+		return model.Voucher{}, model.VoucherBrand{}, errors.New("voucher is not available")
+	}
+
+	return voucher, brand, nil
+}
+
+func (uc *voucherUseCase) nicknameInquiry(brand model.VoucherBrand, userId, zoneId string) (*model.VoucherAccount, error) {
+	if err := validateAccount(brand, userId, zoneId); err != nil {
+		return nil, err
+	}
+
+	return uc.providerRepository.NicknameInquiryRepository(brand.Code, userId, zoneId)
+}
+
+// validateAccount checks the player input against the brand's input schema.
+func validateAccount(brand model.VoucherBrand, userId, zoneId string) error {
+	if userId == "" {
+		return fmt.Errorf("%s is required", brand.UserIdLabel)
+	}
+	if brand.UserIdPattern != "" && !regexp.MustCompile(brand.UserIdPattern).MatchString(userId) {
+		return fmt.Errorf("invalid %s", brand.UserIdLabel)
+	}
+
+	if brand.ZoneIdLabel == "" {
+		if zoneId != "" {
+			return fmt.Errorf("%s does not take a zone ID", brand.Name)
+		}
+		return nil
+	}
+	if zoneId == "" {
+		return fmt.Errorf("%s is required", brand.ZoneIdLabel)
+	}
+	if brand.ZoneIdPattern != "" && !regexp.MustCompile(brand.ZoneIdPattern).MatchString(zoneId) {
+		return fmt.Errorf("invalid %s", brand.ZoneIdLabel)
+	}
+
+	return nil
+}
+
+func validateBrand(data model.VoucherBrand) error {
+	if data.Type != model.VOUCHER_TYPE_GAME && data.Type != model.VOUCHER_TYPE_STREAMING {
+		return fmt.Errorf("type must be %s or %s", model.VOUCHER_TYPE_GAME, model.VOUCHER_TYPE_STREAMING)
+	}
+	if data.Delivery != model.VOUCHER_DELIVERY_DIRECT && data.Delivery != model.VOUCHER_DELIVERY_CODE {
+		return fmt.Errorf("delivery must be %s or %s", model.VOUCHER_DELIVERY_DIRECT, model.VOUCHER_DELIVERY_CODE)
+	}
+	if data.Delivery == model.VOUCHER_DELIVERY_DIRECT && data.UserIdLabel == "" {
+		return errors.New("direct top-ups need a user_id_label")
+	}
+	if data.ZoneIdLabel != "" && data.UserIdLabel == "" {
+		return errors.New("a zone ID needs a user_id_label")
+	}
+	for _, pattern := range []string{data.UserIdPattern, data.ZoneIdPattern} {
+		if _, err := regexp.Compile(pattern); err != nil {
+			return fmt.Errorf("invalid pattern %s: %v", pattern, err)
+		}
+	}
+
+	return nil
+}
+
+func isActive(active *bool) bool {
+	return active == nil || *active
+}
+
+func toBrandResponse(brand model.VoucherBrand) model.VoucherBrandResponse {
+	inputs := []model.VoucherInputField{}
+	if brand.UserIdLabel != "" {
+		inputs = append(inputs, model.VoucherInputField{Name: "user_id", Label: brand.UserIdLabel, Pattern: brand.UserIdPattern})
+	}
+	if brand.ZoneIdLabel != "" {
+		inputs = append(inputs, model.VoucherInputField{Name: "zone_id", Label: brand.ZoneIdLabel, Pattern: brand.ZoneIdPattern})
+	}
+
+	return model.VoucherBrandResponse{
+		ID:       brand.ID,
+		Name:     brand.Name,
+		Code:     brand.Code,
+		Type:     brand.Type,
+		Delivery: brand.Delivery,
+		Inputs:   inputs,
+		IsActive: brand.IsActive,
+		Vouchers: brand.Vouchers,
+	}
+}
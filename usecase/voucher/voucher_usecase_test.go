@@ -0,0 +1,291 @@
+package voucher
+
+import (
+	"BE-Golang/dto"
+	"BE-Golang/model"
+	repoMocks "BE-Golang/repository/mocks"
+	"BE-Golang/usecase/fulfilment"
+	"BE-Golang/usecase/mocks"
+	"BE-Golang/usecase/users"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type VoucherUseCaseTest struct {
+	suite.Suite
+	voucherUseCase      *voucherUseCase
+	voucherRepo         *repoMocks.VoucherRepository
+	providerRepo        *repoMocks.VoucherProviderRepository
+	transactionRepo     *repoMocks.TransactionRepository
+	discountRepo        *repoMocks.DiscountRepository
+	notificationUseCase *mocks.NotificationUseCase
+}
+
+func TestVoucherUseCase(t *testing.T) {
+	suite.Run(t, new(VoucherUseCaseTest))
+}
+
+func (m *VoucherUseCaseTest) SetupTest() {
+	m.voucherRepo = &repoMocks.VoucherRepository{}
+	m.providerRepo = &repoMocks.VoucherProviderRepository{}
+	m.transactionRepo = &repoMocks.TransactionRepository{}
+	m.discountRepo = &repoMocks.DiscountRepository{}
+	m.notificationUseCase = &mocks.NotificationUseCase{}
+	m.voucherUseCase = NewVoucherUseCase(m.voucherRepo, m.providerRepo, m.transactionRepo, m.discountRepo, m.notificationUseCase, "secret")
+
+	m.voucherRepo.On("GetVoucherById", "mlbb-86").Return(model.Voucher{BrandID: "mlbb", Name: "86 Diamonds", Code: "MLBB86", Price: 20000}, nil)
+	m.voucherRepo.On("GetVoucherBrandById", "mlbb", mock.Anything).Return(model.VoucherBrand{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "mlbb"},
+		Name:           "Mobile Legends",
+		Code:           "MLBB",
+		Type:           model.VOUCHER_TYPE_GAME,
+		Delivery:       model.VOUCHER_DELIVERY_DIRECT,
+		UserIdLabel:    "User ID",
+		UserIdPattern:  `^[0-9]{6,12}$`,
+		ZoneIdLabel:    "Zone ID",
+		ZoneIdPattern:  `^[0-9]{4,5}$`,
+	}, nil)
+	m.voucherRepo.On("GetVoucherById", "steam-60").Return(model.Voucher{BrandID: "steam", Name: "Steam Wallet 60.000", Code: "STEAM60", Price: 60000}, nil)
+	m.voucherRepo.On("GetVoucherBrandById", "steam", mock.Anything).Return(model.VoucherBrand{
+		UUIDPrimaryKey: model.UUIDPrimaryKey{ID: "steam"},
+		Name:           "Steam Wallet",
+		Code:           "STEAM",
+		Type:           model.VOUCHER_TYPE_GAME,
+		Delivery:       model.VOUCHER_DELIVERY_CODE,
+	}, nil)
+	m.discountRepo.On("GetDiscountByIdRepository", "").Return(&model.Discount{}, nil)
+}
+
+func (m *VoucherUseCaseTest) TestCreateVoucherBrandDirectNeedsUserId() {
+	_, err := m.voucherUseCase.CreateVoucherBrandUseCase(model.VoucherBrand{
+		Name:     "Free Fire",
+		Code:     "FF",
+		Type:     model.VOUCHER_TYPE_GAME,
+		Delivery: model.VOUCHER_DELIVERY_DIRECT,
+	})
+
+	assert.EqualError(m.T(), err, "direct top-ups need a user_id_label")
+}
+
+func (m *VoucherUseCaseTest) TestNicknameInquiryZoneRequired() {
+	_, err := m.voucherUseCase.NicknameInquiryUseCase(dto.VoucherInquiryDto{VoucherID: "mlbb-86", UserId: "12345678"})
+
+	assert.EqualError(m.T(), err, "Zone ID is required")
+	m.providerRepo.AssertNotCalled(m.T(), "NicknameInquiryRepository", mock.Anything, mock.Anything, mock.Anything)
+}
+
+func (m *VoucherUseCaseTest) TestNicknameInquirySuccess() {
+	m.providerRepo.On("NicknameInquiryRepository", "MLBB", "12345678", "2001").Return(&model.VoucherAccount{UserId: "12345678", ZoneId: "2001", Nickname: "RajaPush"}, nil)
+
+	resp, err := m.voucherUseCase.NicknameInquiryUseCase(dto.VoucherInquiryDto{VoucherID: "mlbb-86", UserId: "12345678", ZoneId: "2001"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "RajaPush", resp.Nickname)
+}
+
+func (m *VoucherUseCaseTest) TestCreateTransactionBalanceNotEnough() {
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(nil, users.ErrBalanceNotEnough)
+
+	_, err := m.voucherUseCase.CreateTransactionVoucherUseCase("user", dto.TransactionVoucherDto{VoucherID: "steam-60"})
+
+	assert.Equal(m.T(), users.ErrBalanceNotEnough, err)
+	m.providerRepo.AssertNotCalled(m.T(), "PurchaseVoucherRepository", mock.Anything, mock.Anything)
+}
+
+func (m *VoucherUseCaseTest) TestCreateTransactionDirectTopUp() {
+	m.providerRepo.On("NicknameInquiryRepository", "MLBB", "12345678", "2001").Return(&model.VoucherAccount{UserId: "12345678", ZoneId: "2001", Nickname: "RajaPush"}, nil)
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(&model.Transaction{}, nil)
+	m.transactionRepo.On("SubmitProcessingTransactionRepository", mock.Anything, mock.Anything).Return(true, nil)
+	m.providerRepo.On("PurchaseVoucherRepository", mock.Anything, mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_SUCCESSFUL, ReferenceNumber: "REF1"}, nil)
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", mock.Anything, mock.Anything).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	resp, err := m.voucherUseCase.CreateTransactionVoucherUseCase("user", dto.TransactionVoucherDto{VoucherID: "mlbb-86", UserId: "12345678", ZoneId: "2001"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_SUCCESSFUL, resp.Status)
+	detail := resp.ProductDetail.(model.TransactionVoucher)
+	assert.Equal(m.T(), "RajaPush", detail.Nickname)
+	assert.Equal(m.T(), "REF1", detail.ReferenceNumber)
+	m.transactionRepo.AssertNotCalled(m.T(), "RefundProcessingTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *VoucherUseCaseTest) TestCreateTransactionCodeIsStoredEncrypted() {
+	var stored *model.TransactionVoucher
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(&model.Transaction{}, nil)
+	m.transactionRepo.On("SubmitProcessingTransactionRepository", mock.Anything, mock.Anything).Return(true, nil)
+	m.providerRepo.On("PurchaseVoucherRepository", mock.Anything, mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_SUCCESSFUL, Code: "ABCD-1234"}, nil)
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", mock.Anything, mock.Anything).Run(func(args mock.Arguments) {
+		detail := *args.Get(1).(*model.Transaction).ProductDetail.(*model.TransactionVoucher)
+		stored = &detail
+	}).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	resp, err := m.voucherUseCase.CreateTransactionVoucherUseCase("user", dto.TransactionVoucherDto{VoucherID: "steam-60"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "ABCD-1234", resp.ProductDetail.(model.TransactionVoucher).VoucherCode)
+	if assert.NotNil(m.T(), stored) {
+		assert.NotEqual(m.T(), "ABCD-1234", stored.VoucherCode)
+		m.transactionRepo.On("GetTransactionByIdRepository", "VOUCHER-1").Return(&model.Transaction{
+			UserID:        "user",
+			ProductType:   model.PRODUCT_VOUCHER,
+			ProductDetail: stored,
+		}, nil)
+
+		transaction, err := m.voucherUseCase.GetTransactionVoucherUseCase("user", "VOUCHER-1")
+
+		assert.NoError(m.T(), err)
+		assert.Equal(m.T(), "ABCD-1234", transaction.ProductDetail.(*model.TransactionVoucher).VoucherCode)
+	}
+}
+
+func (m *VoucherUseCaseTest) TestCreateTransactionRejectedRefunds() {
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(&model.Transaction{}, nil)
+	m.providerRepo.On("PurchaseVoucherRepository", mock.Anything, mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_FAIL, Message: "out of stock"}, nil)
+	m.transactionRepo.On("SubmitProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_PROCESSING && t.ProductDetail.(*model.TransactionVoucher).SubmittedAt != nil
+	})).Return(true, nil).Once()
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_FAIL && t.ProductDetail.(*model.TransactionVoucher).Error == "out of stock"
+	})).Return(nil).Once()
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.MatchedBy(func(n *model.Notification) bool {
+		return n.Title == "Pembelian Voucher Gagal"
+	})).Return(nil)
+
+	resp, err := m.voucherUseCase.CreateTransactionVoucherUseCase("user", dto.TransactionVoucherDto{VoucherID: "steam-60"})
+
+	assert.Error(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_FAIL, resp.Status)
+	m.notificationUseCase.AssertExpectations(m.T())
+	m.transactionRepo.AssertExpectations(m.T())
+}
+
+func (m *VoucherUseCaseTest) TestCreateTransactionProviderErrorStaysPending() {
+	m.providerRepo.On("NicknameInquiryRepository", "MLBB", "12345678", "2001").Return(&model.VoucherAccount{UserId: "12345678", ZoneId: "2001", Nickname: "RajaPush"}, nil)
+	m.transactionRepo.On("CreatePaidTransactionRepository", mock.Anything).Return(&model.Transaction{}, nil)
+	m.transactionRepo.On("SubmitProcessingTransactionRepository", mock.Anything, mock.Anything).Return(true, nil)
+	m.providerRepo.On("PurchaseVoucherRepository", mock.Anything, mock.Anything).Return(nil, errors.New("connection reset"))
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_PROCESSING
+	})).Return(nil)
+
+	resp, err := m.voucherUseCase.CreateTransactionVoucherUseCase("user", dto.TransactionVoucherDto{VoucherID: "mlbb-86", UserId: "12345678", ZoneId: "2001"})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), model.STATUS_PROCESSING, resp.Status)
+	m.transactionRepo.AssertNotCalled(m.T(), "RefundProcessingTransactionRepository", mock.Anything, mock.Anything)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func pendingVoucher(createdAt time.Time, submittedAt *time.Time) *model.Transaction {
+	return &model.Transaction{
+		ID:          "VOUCHER-1",
+		UserID:      "user",
+		Status:      model.STATUS_PROCESSING,
+		ProductType: model.PRODUCT_VOUCHER,
+		Description: "Steam Wallet Steam Wallet 60.000",
+		ProductDetail: model.TransactionVoucher{
+			Brand:      "Steam Wallet",
+			Delivery:   model.VOUCHER_DELIVERY_CODE,
+			Fulfilment: model.Fulfilment{SubmittedAt: submittedAt},
+		},
+		TotalPrice: 62500,
+		CreatedAt:  createdAt,
+	}
+}
+
+func (m *VoucherUseCaseTest) TestRunPendingVouchersSettles() {
+	now := time.Now()
+	submittedAt := now.Add(-5 * time.Minute)
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "VOUCHER", fulfilment.BatchSize).Return([]*model.Transaction{pendingVoucher(submittedAt, &submittedAt)}, nil)
+	m.providerRepo.On("PurchaseStatusVoucherRepository", "VOUCHER-1", mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_SUCCESSFUL, ReferenceNumber: "REF1", Code: "ABCD-1234"}, nil)
+	m.transactionRepo.On("UpdateProcessingTransactionRepository", "VOUCHER-1", mock.MatchedBy(func(t *model.Transaction) bool {
+		detail := t.ProductDetail.(*model.TransactionVoucher)
+		return t.Status == model.STATUS_SUCCESSFUL && detail.VoucherCode != "" && detail.VoucherCode != "ABCD-1234" && detail.Attempts == 1
+	})).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	err := m.voucherUseCase.RunPendingVouchersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.providerRepo.AssertNotCalled(m.T(), "PurchaseVoucherRepository", mock.Anything, mock.Anything)
+	m.transactionRepo.AssertNotCalled(m.T(), "RefundProcessingTransactionRepository", mock.Anything, mock.Anything)
+}
+
+func (m *VoucherUseCaseTest) TestRunPendingVouchersSkipsJustSubmitted() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "VOUCHER", fulfilment.BatchSize).Return([]*model.Transaction{pendingVoucher(now, &now)}, nil)
+
+	err := m.voucherUseCase.RunPendingVouchersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.providerRepo.AssertNotCalled(m.T(), "PurchaseStatusVoucherRepository", mock.Anything, mock.Anything)
+}
+
+func (m *VoucherUseCaseTest) TestRunPendingVouchersTimesOut() {
+	now := time.Now()
+	createdAt := now.Add(-time.Hour)
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "VOUCHER", fulfilment.BatchSize).Return([]*model.Transaction{pendingVoucher(createdAt, &createdAt)}, nil)
+	m.providerRepo.On("PurchaseStatusVoucherRepository", "VOUCHER-1", mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_PROCESSING}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.ID == "VOUCHER-1" && t.TotalPrice == 62500
+	}), mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_FAIL && t.ProductDetail.(*model.TransactionVoucher).Error == "purchase timed out at the provider"
+	})).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	err := m.voucherUseCase.RunPendingVouchersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.transactionRepo.AssertExpectations(m.T())
+}
+
+func (m *VoucherUseCaseTest) TestRunPendingVouchersRefundsNeverSent() {
+	now := time.Now()
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "VOUCHER", fulfilment.BatchSize).Return([]*model.Transaction{pendingVoucher(now.Add(-time.Hour), nil)}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.MatchedBy(func(t *model.Transaction) bool {
+		return t.Status == model.STATUS_FAIL && t.ProductDetail.(*model.TransactionVoucher).Error == "purchase was never sent to the provider"
+	})).Return(nil)
+	m.notificationUseCase.On("SendNotificationUseCase", "user", mock.Anything).Return(nil)
+
+	err := m.voucherUseCase.RunPendingVouchersUseCase(now)
+
+	assert.NoError(m.T(), err)
+	m.providerRepo.AssertNotCalled(m.T(), "PurchaseStatusVoucherRepository", mock.Anything, mock.Anything)
+	m.transactionRepo.AssertExpectations(m.T())
+}
+
+func (m *VoucherUseCaseTest) TestRunPendingVouchersNoRefundWhenAlreadyFinished() {
+	now := time.Now()
+	createdAt := now.Add(-time.Hour)
+	m.transactionRepo.On("GetProcessingTransactionsByPrefixRepository", "VOUCHER", fulfilment.BatchSize).Return([]*model.Transaction{pendingVoucher(createdAt, &createdAt)}, nil)
+	m.providerRepo.On("PurchaseStatusVoucherRepository", "VOUCHER-1", mock.Anything).Return(&model.VoucherPurchaseResult{Status: model.STATUS_FAIL}, nil)
+	m.transactionRepo.On("RefundProcessingTransactionRepository", mock.Anything, mock.Anything).Return(errors.New("transaction is no longer processing"))
+
+	err := m.voucherUseCase.RunPendingVouchersUseCase(now)
+
+	assert.Error(m.T(), err)
+	m.notificationUseCase.AssertNotCalled(m.T(), "SendNotificationUseCase", mock.Anything, mock.Anything)
+}
+
+func TestSealCode(t *testing.T) {
+	aead := newCodeCipher("secret")
+
+	sealed, err := sealCode(aead, "ABCD-1234")
+	assert.NoError(t, err)
+
+	code, err := openCode(aead, sealed)
+	assert.NoError(t, err)
+	assert.Equal(t, "ABCD-1234", code)
+
+	_, err = openCode(newCodeCipher("other"), sealed)
+	assert.Error(t, err)
+
+	assert.Panics(t, func() { newCodeCipher("") })
+}
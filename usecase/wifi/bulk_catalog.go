@@ -0,0 +1,112 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/bulk"
+	"strings"
+)
+
+// NewBulkCatalog lets admins upload and download ISP plans, keyed by code,
+// and adjust their monthly prices per ISP.
+func NewBulkCatalog(bulkRepository repository.BulkRepository) *bulk.Catalog {
+	load := func() ([]model.Isp, []model.IspPlan, error) {
+		var isps []model.Isp
+		if err := bulkRepository.FindAllRepository(&isps); err != nil {
+			return nil, nil, err
+		}
+		var plans []model.IspPlan
+		if err := bulkRepository.FindAllRepository(&plans); err != nil {
+			return nil, nil, err
+		}
+		return isps, plans, nil
+	}
+	ispCodes := func(isps []model.Isp) map[string]string {
+		codes := map[string]string{}
+		for _, isp := range isps {
+			codes[isp.ID] = isp.Code
+		}
+		return codes
+	}
+
+	return &bulk.Catalog{
+		Code:    model.CATALOG_ISP_PLAN,
+		Columns: []string{"code", "isp_code", "name", "bandwidth", "monthly_price", "is_active"},
+		Key:     []string{"code"},
+		Export: func() ([][]string, error) {
+			isps, plans, err := load()
+			if err != nil {
+				return nil, err
+			}
+			codes := ispCodes(isps)
+
+			rows := make([][]string, len(plans))
+			for i, plan := range plans {
+				rows[i] = []string{plan.Code, codes[plan.IspID], plan.Name, bulk.FormatInt(plan.Bandwidth), bulk.FormatFloat(plan.MonthlyPrice), bulk.FormatBool(plan.IsActive)}
+			}
+			return rows, nil
+		},
+		Parser: func() (bulk.Parser, error) {
+			isps, plans, err := load()
+			if err != nil {
+				return nil, err
+			}
+			ispIDs := map[string]string{}
+			for _, isp := range isps {
+				ispIDs[isp.Code] = isp.ID
+			}
+			existing := map[string]model.IspPlan{}
+			for _, plan := range plans {
+				existing[plan.Code] = plan
+			}
+
+			return func(row *bulk.Row) (interface{}, bool) {
+				code := strings.ToLower(row.Required("code"))
+				plan, update := existing[code]
+				plan.Code = code
+				if ispCode := strings.ToLower(row.Required("isp_code")); ispCode != "" {
+					ispID, ok := ispIDs[ispCode]
+					if !ok {
+						row.Errorf("ISP %s not found", ispCode)
+					}
+					plan.IspID = ispID
+				}
+				plan.Name = row.Required("name")
+				plan.Bandwidth = row.Int("bandwidth")
+				plan.MonthlyPrice = row.Float("monthly_price")
+				row.Error(validatePlan(&plan))
+				if plan.MonthlyPrice > 0 && plan.MonthlyPrice < plan.CostPrice {
+					row.Errorf("monthly_price must not be below the cost price %.0f", plan.CostPrice)
+				}
+				plan.IsActive = row.Bool("is_active", plan.IsActive)
+				return &plan, update
+			}, nil
+		},
+		Prices: func(provider string) ([]bulk.PricedItem, error) {
+			isps, plans, err := load()
+			if err != nil {
+				return nil, err
+			}
+			codes := ispCodes(isps)
+
+			var items []bulk.PricedItem
+			for _, plan := range plans {
+				if provider != "" && !strings.EqualFold(codes[plan.IspID], provider) {
+					continue
+				}
+				plan := plan
+				items = append(items, bulk.PricedItem{
+					Code:      plan.Code,
+					Name:      plan.Name,
+					Price:     plan.MonthlyPrice,
+					CostPrice: plan.CostPrice,
+					WithPrice: func(price float64) interface{} {
+						plan.MonthlyPrice = price
+						return &plan
+					},
+				})
+			}
+			return items, nil
+		},
+	}
+}
@@ -0,0 +1,42 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/catalog"
+)
+
+// NewCatalog exposes ISP plans to the catalog sync.
+func NewCatalog(ispRepository repository.IspRepository) *catalog.Catalog {
+	return &catalog.Catalog{
+		Code: model.CATALOG_ISP_PLAN,
+		Items: func() ([]model.CatalogItem, error) {
+			plans, err := ispRepository.GetAllIspPlanRepository()
+			if err != nil {
+				return nil, err
+			}
+
+			items := make([]model.CatalogItem, len(plans))
+			for i, plan := range plans {
+				items[i] = model.CatalogItem{
+					ID:        plan.ID,
+					Code:      plan.Code,
+					Name:      plan.Name,
+					CostPrice: plan.CostPrice,
+					SellPrice: plan.MonthlyPrice,
+					IsActive:  plan.IsActive == nil || *plan.IsActive,
+				}
+			}
+			return items, nil
+		},
+		Deactivate: func(id string) error {
+			inactive := false
+			_, err := ispRepository.UpdateIspPlanByIdRepository(id, &model.IspPlan{IsActive: &inactive})
+			return err
+		},
+		SetPrice: func(id string, costPrice, sellPrice float64) error {
+			_, err := ispRepository.UpdateIspPlanByIdRepository(id, &model.IspPlan{CostPrice: costPrice, MonthlyPrice: sellPrice})
+			return err
+		},
+	}
+}
@@ -0,0 +1,203 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+)
+
+type IspUseCase interface {
+	CreateIspUseCase(payload *model.Isp) (*model.Isp, error)
+	GetAllIspUseCase(page, limit int, isUser *bool) ([]*model.Isp, error)
+	GetIspByIdUseCase(id string, isUser *bool) (*model.Isp, error)
+	UpdateIspByIdUseCase(id string, payload *model.Isp) (*model.Isp, error)
+	DeleteIspByIdUseCase(id string) error
+	CreateIspPlanUseCase(payload *model.IspPlan) (*model.IspPlan, error)
+	UpdateIspPlanByIdUseCase(id string, payload *model.IspPlan) (*model.IspPlan, error)
+	DeleteIspPlanByIdUseCase(id string) error
+	GetWifiSubscriptionUseCase(ispCode, customerID string) (*model.WifiSubscription, error)
+}
+
+type ispUseCase struct {
+	ispRepository repository.IspRepository
+}
+
+func NewIspUseCase(ispRepository repository.IspRepository) *ispUseCase {
+	return &ispUseCase{
+		ispRepository: ispRepository,
+	}
+}
+
+func (uc *ispUseCase) CreateIspUseCase(payload *model.Isp) (*model.Isp, error) {
+	payload.Code = strings.ToLower(strings.TrimSpace(payload.Code))
+	if payload.Code == "" || payload.Name == "" {
+		return nil, errors.New("code and name are required")
+	}
+	if err := validateCustomerIdPattern(payload.CustomerIdPattern); err != nil {
+		return nil, err
+	}
+
+	existing, err := uc.ispRepository.GetIspByCodeRepository(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("ISP %s already exists", payload.Code)
+	}
+
+	isp, err := uc.ispRepository.CreateIspRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ISP in database: %w", err)
+	}
+
+	return isp, nil
+}
+
+func (uc *ispUseCase) GetAllIspUseCase(page, limit int, isUser *bool) ([]*model.Isp, error) {
+	return uc.ispRepository.GetAllIspRepository(page, limit, isUser)
+}
+
+func (uc *ispUseCase) GetIspByIdUseCase(id string, isUser *bool) (*model.Isp, error) {
+	isp, err := uc.ispRepository.GetIspByIdRepository(id, isUser)
+	if err != nil {
+		return nil, errors.New("ISP not found")
+	}
+
+	return isp, nil
+}
+
+func (uc *ispUseCase) UpdateIspByIdUseCase(id string, payload *model.Isp) (*model.Isp, error) {
+	isp, err := uc.ispRepository.GetIspByIdRepository(id, nil)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ISP: %v", err)
+	}
+
+	if payload.Name != "" {
+		isp.Name = payload.Name
+	}
+	if payload.CustomerIdPattern != "" {
+		if err := validateCustomerIdPattern(payload.CustomerIdPattern); err != nil {
+			return nil, err
+		}
+		isp.CustomerIdPattern = payload.CustomerIdPattern
+	}
+	if payload.IsActive != nil {
+		isp.IsActive = payload.IsActive
+	}
+	isp.Plans = nil
+	isp.UpdatedAt = time.Now()
+
+	updated, err := uc.ispRepository.UpdateIspByIdRepository(id, isp)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ISP: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *ispUseCase) DeleteIspByIdUseCase(id string) error {
+	err := uc.ispRepository.DeleteIspByIdRepository(id)
+	if err != nil {
+		return errors.New("ISP not found")
+	}
+
+	return nil
+}
+
+func (uc *ispUseCase) CreateIspPlanUseCase(payload *model.IspPlan) (*model.IspPlan, error) {
+	payload.Code = strings.ToLower(strings.TrimSpace(payload.Code))
+	if payload.IspID == "" || payload.Code == "" || payload.Name == "" {
+		return nil, errors.New("isp_id, code and name are required")
+	}
+	if err := validatePlan(payload); err != nil {
+		return nil, err
+	}
+
+	if _, err := uc.ispRepository.GetIspByIdRepository(payload.IspID, nil); err != nil {
+		return nil, errors.New("ISP not found")
+	}
+	existing, err := uc.ispRepository.GetIspPlanByCodeRepository(payload.Code)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("ISP plan %s already exists", payload.Code)
+	}
+
+	plan, err := uc.ispRepository.CreateIspPlanRepository(payload)
+	if err != nil {
+		return nil, fmt.Errorf("error creating ISP plan in database: %w", err)
+	}
+
+	return plan, nil
+}
+
+func (uc *ispUseCase) UpdateIspPlanByIdUseCase(id string, payload *model.IspPlan) (*model.IspPlan, error) {
+	plan, err := uc.ispRepository.GetIspPlanByIdRepository(id)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ISP plan: %v", err)
+	}
+
+	if payload.Name != "" {
+		plan.Name = payload.Name
+	}
+	if payload.Bandwidth != 0 {
+		plan.Bandwidth = payload.Bandwidth
+	}
+	if payload.MonthlyPrice != 0 {
+		plan.MonthlyPrice = payload.MonthlyPrice
+	}
+	if payload.IsActive != nil {
+		plan.IsActive = payload.IsActive
+	}
+	if err := validatePlan(plan); err != nil {
+		return nil, err
+	}
+	plan.UpdatedAt = time.Now()
+
+	updated, err := uc.ispRepository.UpdateIspPlanByIdRepository(id, plan)
+	if err != nil {
+		return nil, fmt.Errorf("failed to update ISP plan: %v", err)
+	}
+
+	return updated, nil
+}
+
+func (uc *ispUseCase) DeleteIspPlanByIdUseCase(id string) error {
+	err := uc.ispRepository.DeleteIspPlanByIdRepository(id)
+	if err != nil {
+		return errors.New("ISP plan not found")
+	}
+
+	return nil
+}
+
+func (uc *ispUseCase) GetWifiSubscriptionUseCase(ispCode, customerID string) (*model.WifiSubscription, error) {
+	return lookupSubscription(uc.ispRepository, strings.ToLower(ispCode), customerID)
+}
+
+func validateCustomerIdPattern(pattern string) error {
+	if pattern == "" {
+		return errors.New("customer_id_pattern is required")
+	}
+	if _, err := regexp.Compile(pattern); err != nil {
+		return fmt.Errorf("invalid customer_id_pattern: %v", err)
+	}
+
+	return nil
+}
+
+func validatePlan(plan *model.IspPlan) error {
+	if plan.Bandwidth <= 0 {
+		return errors.New("bandwidth must be greater than 0")
+	}
+	if plan.MonthlyPrice <= 0 {
+		return errors.New("monthly_price must be greater than 0")
+	}
+
+	return nil
+}
@@ -0,0 +1,81 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/suite"
+)
+
+type IspUseCaseTest struct {
+	suite.Suite
+	ispUseCase IspUseCase
+	ispRepo    *mocks.IspRepository
+}
+
+func TestIspUseCase(t *testing.T) {
+	suite.Run(t, new(IspUseCaseTest))
+}
+
+func (m *IspUseCaseTest) SetupTest() {
+	m.ispRepo = &mocks.IspRepository{}
+	m.ispUseCase = NewIspUseCase(m.ispRepo)
+}
+
+func (m *IspUseCaseTest) TestCreateIspSuccess() {
+	m.ispRepo.On("GetIspByCodeRepository", "indihome").Return(nil, nil)
+	m.ispRepo.On("CreateIspRepository", mock.Anything).Return(func(isp *model.Isp) *model.Isp {
+		return isp
+	}, nil)
+
+	resp, err := m.ispUseCase.CreateIspUseCase(&model.Isp{Code: " IndiHome ", Name: "IndiHome", CustomerIdPattern: `^[0-9]{12}$`})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), "indihome", resp.Code)
+}
+
+func (m *IspUseCaseTest) TestCreateIspDuplicate() {
+	m.ispRepo.On("GetIspByCodeRepository", "indihome").Return(&model.Isp{}, nil)
+
+	_, err := m.ispUseCase.CreateIspUseCase(&model.Isp{Code: "indihome", Name: "IndiHome", CustomerIdPattern: `^[0-9]{12}$`})
+
+	assert.EqualError(m.T(), err, "ISP indihome already exists")
+}
+
+func (m *IspUseCaseTest) TestCreateIspInvalidPattern() {
+	_, err := m.ispUseCase.CreateIspUseCase(&model.Isp{Code: "indihome", Name: "IndiHome", CustomerIdPattern: `^[0-9{12}$`})
+
+	assert.ErrorContains(m.T(), err, "invalid customer_id_pattern")
+}
+
+func (m *IspUseCaseTest) TestCreateIspPlanUnknownIsp() {
+	m.ispRepo.On("GetIspByIdRepository", "isp", (*bool)(nil)).Return(nil, errors.New("not found"))
+
+	_, err := m.ispUseCase.CreateIspPlanUseCase(&model.IspPlan{IspID: "isp", Code: "x_10", Name: "X 10", Bandwidth: 10, MonthlyPrice: 200000})
+
+	assert.EqualError(m.T(), err, "ISP not found")
+}
+
+func (m *IspUseCaseTest) TestCreateIspPlanInvalidPrice() {
+	_, err := m.ispUseCase.CreateIspPlanUseCase(&model.IspPlan{IspID: "isp", Code: "x_10", Name: "X 10", Bandwidth: 10})
+
+	assert.EqualError(m.T(), err, "monthly_price must be greater than 0")
+}
+
+func (m *IspUseCaseTest) TestUpdateIspPlanSuccess() {
+	m.ispRepo.On("GetIspPlanByIdRepository", "plan").Return(&model.IspPlan{Name: "X 10", Bandwidth: 10, MonthlyPrice: 200000}, nil)
+	m.ispRepo.On("UpdateIspPlanByIdRepository", "plan", mock.MatchedBy(func(plan *model.IspPlan) bool {
+		return plan.MonthlyPrice == 225000 && plan.Bandwidth == 10
+	})).Return(func(id string, plan *model.IspPlan) *model.IspPlan {
+		return plan
+	}, nil)
+
+	resp, err := m.ispUseCase.UpdateIspPlanByIdUseCase("plan", &model.IspPlan{MonthlyPrice: 225000})
+
+	assert.NoError(m.T(), err)
+	assert.Equal(m.T(), 225000.0, resp.MonthlyPrice)
+}
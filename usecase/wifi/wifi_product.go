@@ -0,0 +1,112 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository"
+	"BE-Golang/usecase/biller"
+	"fmt"
+)
+
+// NewProduct plugs home internet bills into the biller engine. The inquiry's
+// product ID selects the ISP and the bill is the subscriber's monthly plan.
+func NewProduct(ispRepository repository.IspRepository) *biller.Product {
+	return &biller.Product{
+		Code:              model.PRODUCT_WIFI,
+		Category:          model.PRODUCT_WIFI,
+		Name:              "WIFI",
+		TransactionPrefix: "WIFI",
+		ValidateForProvider: func(provider, customerID string) ([]model.FieldError, error) {
+			isp, err := findIsp(ispRepository, provider)
+			if err != nil {
+				return nil, err
+			}
+
+			return validateCustomerId(isp, customerID)
+		},
+		Price: func(inquiry *biller.Inquiry) (*biller.Bill, error) {
+			subscription, err := lookupSubscription(ispRepository, inquiry.ProductType, inquiry.Payload.CustomerId)
+			if err != nil {
+				return nil, err
+			}
+
+			return priceBill(subscription, inquiry), nil
+		},
+		Detail: func() interface{} {
+			return &model.Wifi{}
+		},
+		Receipt: func(detail interface{}, receipt *model.PayloadMail) {
+			wifi := detail.(*model.Wifi)
+			receipt.ProviderName = wifi.ProviderName
+			receipt.WifiBandwith = wifi.WifiBandwith
+		},
+	}
+}
+
+// lookupSubscription stands in for the ISP's subscriber lookup until the
+// gateway returns it. Plans are derived from the customer ID, so a customer
+// stays on the same plan while the ISP's catalog does not change.
+func lookupSubscription(ispRepository repository.IspRepository, ispCode, customerID string) (*model.WifiSubscription, error) {
+	isp, err := findIsp(ispRepository, ispCode)
+	if err != nil {
+		return nil, err
+	}
+	if len(isp.Plans) == 0 {
+		return nil, fmt.Errorf("%s has no active plans", isp.Name)
+	}
+
+	return &model.WifiSubscription{
+		IspCode:    isp.Code,
+		IspName:    isp.Name,
+		CustomerID: customerID,
+		Plan:       isp.Plans[biller.Hash(customerID)%uint32(len(isp.Plans))],
+	}, nil
+}
+
+func findIsp(ispRepository repository.IspRepository, code string) (*model.Isp, error) {
+	isp, err := ispRepository.GetIspByCodeRepository(code)
+	if err != nil {
+		return nil, err
+	}
+	if isp == nil {
+		return nil, fmt.Errorf("ISP %s not found", code)
+	}
+
+	return isp, nil
+}
+
+// validateCustomerId checks a customer ID against the ISP's pattern.
+func validateCustomerId(isp *model.Isp, customerID string) ([]model.FieldError, error) {
+	matched, err := biller.MatchPattern(isp.CustomerIdPattern, customerID)
+	if err != nil {
+		return nil, fmt.Errorf("invalid customer ID pattern of %s: %w", isp.Name, err)
+	}
+	if !matched {
+		return []model.FieldError{
+			{Field: "customer_id", Rule: "pattern", Message: fmt.Sprintf("customer ID is not a valid %s customer ID", isp.Name)},
+		}, nil
+	}
+
+	return nil, nil
+}
+
+func priceBill(subscription *model.WifiSubscription, inquiry *biller.Inquiry) *biller.Bill {
+	plan := subscription.Plan
+
+	return &biller.Bill{
+		Price:       plan.MonthlyPrice,
+		Description: fmt.Sprintf("Pembayaran Tagihan WIFI %s %s ", subscription.IspName, inquiry.Payload.Period),
+		Detail: &model.Wifi{
+			Name:         inquiry.User.Name,
+			CustomerID:   subscription.CustomerID,
+			Code:         subscription.IspCode,
+			ProviderName: subscription.IspName,
+			ProductType:  inquiry.ProductType,
+			Period:       inquiry.Payload.Period,
+			WifiBandwith: plan.Bandwidth,
+			PlanCode:     plan.Code,
+			PlanName:     plan.Name,
+			DiscountId:   inquiry.Discount.ID,
+			Price:        plan.MonthlyPrice,
+		},
+	}
+}
@@ -0,0 +1,65 @@
+package wifi
+
+import (
+	"BE-Golang/model"
+	"BE-Golang/repository/mocks"
+	"BE-Golang/usecase/biller/billertest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var testIsp = &model.Isp{
+	Code:              "biznet",
+	Name:              "Biznet Home",
+	CustomerIdPattern: `^[0-9]{10}$`,
+	Plans: []model.IspPlan{
+		{Code: "biznet_50", Name: "Biznet Home 50 Mbps", Bandwidth: 50, MonthlyPrice: 250000},
+		{Code: "biznet_100", Name: "Biznet Home 100 Mbps", Bandwidth: 100, MonthlyPrice: 375000},
+	},
+}
+
+func TestProductPricesSubscribedPlan(t *testing.T) {
+	repo := &mocks.IspRepository{}
+	repo.On("GetIspByCodeRepository", "biznet").Return(testIsp, nil)
+	product := NewProduct(repo)
+
+	bill, err := product.Price(billertest.Inquiry("biznet", "1234567890", "March-2026"))
+	assert.NoError(t, err)
+	detail := bill.Detail.(*model.Wifi)
+	subscription, _ := lookupSubscription(repo, "biznet", "1234567890")
+	assert.Equal(t, subscription.Plan.Code, detail.PlanCode)
+	assert.Equal(t, subscription.Plan.Bandwidth, detail.WifiBandwith)
+	assert.Equal(t, subscription.Plan.MonthlyPrice, bill.Price)
+	assert.Equal(t, "Biznet Home", detail.ProviderName)
+}
+
+func TestProductRejectsCustomerIdFormat(t *testing.T) {
+	repo := &mocks.IspRepository{}
+	repo.On("GetIspByCodeRepository", "biznet").Return(testIsp, nil)
+
+	fieldErrors, err := NewProduct(repo).ValidateForProvider("biznet", "12345")
+
+	assert.NoError(t, err)
+	assert.Equal(t, []model.FieldError{
+		{Field: "customer_id", Rule: "pattern", Message: "customer ID is not a valid Biznet Home customer ID"},
+	}, fieldErrors)
+}
+
+func TestProductIspNotFound(t *testing.T) {
+	repo := &mocks.IspRepository{}
+	repo.On("GetIspByCodeRepository", "biznet").Return(nil, nil)
+
+	_, err := NewProduct(repo).Price(billertest.Inquiry("biznet", "1234567890", "March-2026"))
+
+	assert.EqualError(t, err, "ISP biznet not found")
+}
+
+func TestProductIspWithoutPlans(t *testing.T) {
+	repo := &mocks.IspRepository{}
+	repo.On("GetIspByCodeRepository", "biznet").Return(&model.Isp{Name: "Biznet Home", CustomerIdPattern: `^[0-9]{10}$`}, nil)
+
+	_, err := NewProduct(repo).Price(billertest.Inquiry("biznet", "1234567890", "March-2026"))
+
+	assert.EqualError(t, err, "Biznet Home has no active plans")
+}
@@ -11,17 +11,22 @@ import (
 	"time"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/suite"
 )
 
 type WifiUsecaseTest struct {
 	suite.Suite
-	wifiUsecase     WifiUsecase
-	wifiRepo        *mocks.WifiRepository
-	userRepo        *mocks.UserRepository
-	discountRepo    *mocks.DiscountRepository
-	transactionRepo *mocks.TransactionRepository
-	billerOyApiRepo *mocks.BillerOyApiRepository
+	wifiUsecase        WifiUsecase
+	wifiRepo           *mocks.WifiRepository
+	userRepo           *mocks.UserRepository
+	discountRepo       *mocks.DiscountRepository
+	transactionRepo    *mocks.TransactionRepository
+	billerOyApiRepo    *mocks.BillerOyApiRepository
+	savedBillerRepo    *mocks.SavedBillerRepository
+	customerIdRuleRepo *mocks.CustomerIdRuleRepository
+	availabilityRepo   *mocks.ProductAvailabilityRepository
+	ispRepo            *mocks.IspRepository
 }
 
 func TestWifiUsecase(t *testing.T) {
@@ -34,7 +39,17 @@ func (m *WifiUsecaseTest) SetupTest() {
 	m.discountRepo = &mocks.DiscountRepository{}
 	m.transactionRepo = &mocks.TransactionRepository{}
 	m.billerOyApiRepo = &mocks.BillerOyApiRepository{}
-	m.wifiUsecase = NewWifiUseCase(m.wifiRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo)
+	m.savedBillerRepo = &mocks.SavedBillerRepository{}
+	m.customerIdRuleRepo = &mocks.CustomerIdRuleRepository{}
+	m.customerIdRuleRepo.On("GetCustomerIdRulesByProductRepository", mock.Anything).Return(nil, nil)
+	m.availabilityRepo = &mocks.ProductAvailabilityRepository{}
+	m.availabilityRepo.On("GetProductAvailabilityRepository", mock.Anything, mock.Anything).Return(nil, nil)
+	m.availabilityRepo.On("AddProductAvailabilityFailureRepository", mock.Anything, mock.Anything).Return(nil)
+	m.availabilityRepo.On("TripProductAvailabilityRepository", mock.Anything, mock.Anything, mock.Anything, mock.Anything, mock.Anything).Return(false, nil)
+	m.availabilityRepo.On("ResetProductAvailabilityFailuresRepository", mock.Anything, mock.Anything).Return(nil)
+	m.ispRepo = &mocks.IspRepository{}
+	m.ispRepo.On("GetIspByCodeRepository", "sadasdsa").Return(&model.Isp{Code: "sadasdsa", Name: "Test ISP", CustomerIdPattern: `^[0-9]{9}$`}, nil)
+	m.wifiUsecase = NewWifiUseCase(m.wifiRepo, m.ispRepo, m.userRepo, m.discountRepo, m.transactionRepo, m.billerOyApiRepo, m.savedBillerRepo, m.customerIdRuleRepo, m.availabilityRepo)
 }
 
 func (m *WifiUsecaseTest) TestCreateWifiUseCaseSuccess() {
@@ -218,40 +233,6 @@ func init() {
 	rand.Seed(time.Now().UnixNano())
 }
 
-func TestGenerateVANumber(t *testing.T) {
-	length := 10
-
-	vaNumber := generateVANumber(length)
-
-	if len(vaNumber) != length {
-		t.Errorf("expected VA number length %d, but got %d", length, len(vaNumber))
-	}
-	// Add more assertions for the VA number if needed.
-}
-
-func TestCalculatePriceBandwidth(t *testing.T) {
-	testCases := []struct {
-		bandwidth     int
-		expectedPrice float64
-	}{
-		{bandwidth: 10, expectedPrice: 275000.0},
-		{bandwidth: 25, expectedPrice: 315000.0},
-		{bandwidth: 40, expectedPrice: 445000.0},
-		{bandwidth: 60, expectedPrice: 795000.0},
-		{bandwidth: 5, expectedPrice: 275000.0},
-		{bandwidth: 15, expectedPrice: 275000.0},
-		{bandwidth: 35, expectedPrice: 445000.0},
-		{bandwidth: 100, expectedPrice: 795000.0},
-	}
-
-	for _, tc := range testCases {
-		actualPrice := calculatePriceBandwith(tc.bandwidth)
-		if actualPrice != tc.expectedPrice {
-			t.Errorf("For bandwidth %d, expected price %.2f but got %.2f", tc.bandwidth, tc.expectedPrice, actualPrice)
-		}
-	}
-}
-
 func (m *WifiUsecaseTest) TestBillInquiryWifiUseCaseErrorDigit() {
 	mockWifi := &model.OyBillerApi{
 		CustomerId: "123213219",
@@ -270,6 +251,7 @@ func (m *WifiUsecaseTest) TestBillInquiryWifiUseCaseErrorGetUserId() {
 		CustomerId: "123213217",
 	}
 	m.userRepo.On("GetUserByIDRepository", "id").Return(nil, errors.New("unauthorized"))
+	m.ispRepo.On("GetIspByCodeRepository", "").Return(nil, nil)
 	// m.wifiRepo.On("UpdateWifiByIdRepository", "id", mockWifi).Return(nil, errors.New("repository error"))
 
 	_, err := m.wifiUsecase.BillInquiryWifiUseCase("id", mockWifi)